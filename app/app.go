@@ -37,6 +37,7 @@ import (
 	servicemetrics "github.com/skip-mev/slinky/service/metrics"
 
 	v401 "github.com/neutron-org/neutron/v4/app/upgrades/v4.0.1"
+	v410 "github.com/neutron-org/neutron/v4/app/upgrades/v4.1.0"
 	"github.com/neutron-org/neutron/v4/x/globalfee"
 	globalfeetypes "github.com/neutron-org/neutron/v4/x/globalfee/types"
 
@@ -191,6 +192,9 @@ import (
 	"github.com/neutron-org/neutron/v4/x/dex"
 	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
 	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	"github.com/neutron-org/neutron/v4/x/incentives"
+	incentiveskeeper "github.com/neutron-org/neutron/v4/x/incentives/keeper"
+	incentivestypes "github.com/neutron-org/neutron/v4/x/incentives/types"
 
 	"github.com/neutron-org/neutron/v4/x/ibcswap"
 	ibcswapkeeper "github.com/neutron-org/neutron/v4/x/ibcswap/keeper"
@@ -225,7 +229,7 @@ const (
 )
 
 var (
-	Upgrades = []upgrades.Upgrade{v401.Upgrade}
+	Upgrades = []upgrades.Upgrade{v401.Upgrade, v410.Upgrade}
 
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -276,6 +280,7 @@ var (
 		globalfee.AppModule{},
 		feemarket.AppModuleBasic{},
 		dex.AppModuleBasic{},
+		incentives.AppModuleBasic{},
 		ibcswap.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		marketmap.AppModuleBasic{},
@@ -297,6 +302,7 @@ var (
 		tokenfactorytypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
 		crontypes.ModuleName:                          nil,
 		dextypes.ModuleName:                           {authtypes.Minter, authtypes.Burner},
+		incentivestypes.ModuleName:                    nil,
 		ibcswaptypes.ModuleName:                       {authtypes.Burner},
 		oracletypes.ModuleName:                        nil,
 		marketmaptypes.ModuleName:                     nil,
@@ -369,6 +375,7 @@ type App struct {
 	CronKeeper          cronkeeper.Keeper
 	PFMKeeper           *pfmkeeper.Keeper
 	DexKeeper           dexkeeper.Keeper
+	IncentivesKeeper    incentiveskeeper.Keeper
 	SwapKeeper          ibcswapkeeper.Keeper
 	GlobalFeeKeeper     globalfeekeeper.Keeper
 
@@ -489,6 +496,7 @@ func New(
 		feeburnertypes.StoreKey, adminmoduletypes.StoreKey, ccvconsumertypes.StoreKey, tokenfactorytypes.StoreKey, pfmtypes.StoreKey,
 		crontypes.StoreKey, ibchookstypes.StoreKey, consensusparamtypes.StoreKey, crisistypes.StoreKey, dextypes.StoreKey, auctiontypes.StoreKey,
 		oracletypes.StoreKey, marketmaptypes.StoreKey, feemarkettypes.StoreKey, dynamicfeestypes.StoreKey, globalfeetypes.StoreKey,
+		incentivestypes.StoreKey,
 	)
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, dextypes.TStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, feetypes.MemStoreKey)
@@ -731,14 +739,34 @@ func New(
 			app.TokenFactoryKeeper.Hooks(),
 		))
 
+	app.MarketMapKeeper = marketmapkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[marketmaptypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName),
+	)
+	marketmapModule := marketmap.NewAppModule(appCodec, app.MarketMapKeeper)
+
+	oracleKeeper := oraclekeeper.NewKeeper(runtime.NewKVStoreService(keys[oracletypes.StoreKey]),
+		appCodec,
+		app.MarketMapKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName))
+	app.OracleKeeper = &oracleKeeper
+	oracleModule := oracle.NewAppModule(appCodec, *app.OracleKeeper)
+
+	app.MarketMapKeeper.SetHooks(app.OracleKeeper.Hooks())
+
 	app.DexKeeper = *dexkeeper.NewKeeper(
 		appCodec,
 		keys[dextypes.StoreKey],
 		keys[dextypes.MemStoreKey],
 		tkeys[dextypes.TStoreKey],
 		app.BankKeeper.WithMintCoinsRestriction(dextypes.NewDexDenomMintCoinsRestriction()),
+		app.OracleKeeper,
+		&app.WasmKeeper,
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
+	// Modules reacting to dex swaps, deposits, withdrawals and limit orders register their hooks here
+	app.DexKeeper.SetHooks(dextypes.NewMultiDexHooks())
 
 	app.AuctionKeeper = auctionkeeper.NewKeeperWithRewardsAddressProvider(
 		appCodec,
@@ -752,6 +780,14 @@ func New(
 
 	dexModule := dex.NewAppModule(appCodec, app.DexKeeper, app.BankKeeper)
 
+	app.IncentivesKeeper = *incentiveskeeper.NewKeeper(
+		appCodec,
+		keys[incentivestypes.StoreKey],
+		app.BankKeeper,
+		app.DexKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+
 	app.SwapKeeper = ibcswapkeeper.NewKeeper(
 		appCodec,
 		app.MsgServiceRouter(),
@@ -815,22 +851,6 @@ func New(
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
-	app.MarketMapKeeper = marketmapkeeper.NewKeeper(
-		runtime.NewKVStoreService(keys[marketmaptypes.StoreKey]),
-		appCodec,
-		authtypes.NewModuleAddress(adminmoduletypes.ModuleName),
-	)
-	marketmapModule := marketmap.NewAppModule(appCodec, app.MarketMapKeeper)
-
-	oracleKeeper := oraclekeeper.NewKeeper(runtime.NewKVStoreService(keys[oracletypes.StoreKey]),
-		appCodec,
-		app.MarketMapKeeper,
-		authtypes.NewModuleAddress(adminmoduletypes.ModuleName))
-	app.OracleKeeper = &oracleKeeper
-	oracleModule := oracle.NewAppModule(appCodec, *app.OracleKeeper)
-
-	app.MarketMapKeeper.SetHooks(app.OracleKeeper.Hooks())
-
 	app.CronKeeper = *cronkeeper.NewKeeper(
 		appCodec,
 		keys[crontypes.StoreKey],
@@ -848,6 +868,7 @@ func New(
 		app.TokenFactoryKeeper, &app.CronKeeper,
 		&app.ContractManagerKeeper,
 		&app.DexKeeper,
+		&app.IncentivesKeeper,
 		app.OracleKeeper,
 		app.MarketMapKeeper,
 	), wasmOpts...)
@@ -972,6 +993,7 @@ func New(
 		dynamicfees.NewAppModule(appCodec, *app.DynamicFeesKeeper),
 		swapModule,
 		dexModule,
+		incentives.NewAppModule(appCodec, app.IncentivesKeeper),
 		marketmapModule,
 		oracleModule,
 		auction.NewAppModule(appCodec, app.AuctionKeeper),
@@ -1021,6 +1043,7 @@ func New(
 		feemarkettypes.ModuleName,
 		ibcswaptypes.ModuleName,
 		dextypes.ModuleName,
+		incentivestypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -1057,6 +1080,7 @@ func New(
 		feemarkettypes.ModuleName,
 		ibcswaptypes.ModuleName,
 		dextypes.ModuleName,
+		incentivestypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -1099,6 +1123,7 @@ func New(
 		ibcswaptypes.ModuleName,
 		dextypes.ModuleName,
 		dynamicfeestypes.ModuleName,
+		incentivestypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
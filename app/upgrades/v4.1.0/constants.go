@@ -0,0 +1,23 @@
+package v410
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/neutron-org/neutron/v4/app/upgrades"
+	incentivestypes "github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v4.1.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{
+			incentivestypes.ModuleName,
+		},
+	},
+}
@@ -0,0 +1,56 @@
+package v410_test
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+
+	v410 "github.com/neutron-org/neutron/v4/app/upgrades/v4.1.0"
+	"github.com/neutron-org/neutron/v4/testutil"
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	incentivestypes "github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+type UpgradeTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	suite.IBCConnectionTestSuite.SetupTest()
+}
+
+func (suite *UpgradeTestSuite) TestIncentivesStoreAdded() {
+	suite.Require().Contains(v410.Upgrade.StoreUpgrades.Added, incentivestypes.ModuleName)
+}
+
+func (suite *UpgradeTestSuite) TestDexMigrationUpgrade() {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+
+	// GIVEN the dex module is at consensus version 4 and has no denom index yet
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	suite.Require().NoError(err)
+	vm[dextypes.ModuleName] = 4
+	suite.Require().NoError(app.UpgradeKeeper.SetModuleVersionMap(ctx, vm))
+	pairID := dextypes.MustNewPairID("TokenA", "TokenB")
+	app.DexKeeper.SetPoolMetadata(ctx, dextypes.PoolMetadata{Id: 0, PairId: pairID, Tick: 0, Fee: 1})
+
+	// WHEN the upgrade is applied
+	upgrade := upgradetypes.Plan{
+		Name:   v410.UpgradeName,
+		Info:   "some text here",
+		Height: 100,
+	}
+	suite.Require().NoError(app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade))
+
+	// THEN the dex is migrated to version 5 and its denom index is built
+	vm, err = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(dextypes.ConsensusVersion), vm[dextypes.ModuleName])
+	suite.Require().Equal(uint64(1), app.DexKeeper.GetPairRefCount(ctx, pairID))
+}
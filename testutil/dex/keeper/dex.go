@@ -40,6 +40,8 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		memStoreKey,
 		tStoreKey,
 		nil,
+		nil,
+		nil,
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
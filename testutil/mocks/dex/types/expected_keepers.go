@@ -0,0 +1,335 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../x/dex/types/expected_keepers.go
+
+// Package mock_types is a generated GoMock package.
+package mock_types
+
+import (
+	context "context"
+	reflect "reflect"
+
+	math "cosmossdk.io/math"
+	types "github.com/CosmWasm/wasmd/x/wasm/types"
+	types0 "github.com/cosmos/cosmos-sdk/types"
+	gomock "github.com/golang/mock/gomock"
+	types1 "github.com/neutron-org/neutron/v4/x/dex/types"
+	types2 "github.com/skip-mev/slinky/pkg/types"
+	types3 "github.com/skip-mev/slinky/x/oracle/types"
+)
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
+}
+
+// GetBalance mocks base method.
+func (m *MockBankKeeper) GetBalance(ctx context.Context, addr types0.AccAddress, denom string) types0.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, addr, denom)
+	ret0, _ := ret[0].(types0.Coin)
+	return ret0
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
+}
+
+// GetSupply mocks base method.
+func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) types0.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupply", ctx, denom)
+	ret0, _ := ret[0].(types0.Coin)
+	return ret0
+}
+
+// GetSupply indicates an expected call of GetSupply.
+func (mr *MockBankKeeperMockRecorder) GetSupply(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetSupply), ctx, denom)
+}
+
+// IterateAccountBalances mocks base method.
+func (m *MockBankKeeper) IterateAccountBalances(ctx context.Context, addr types0.AccAddress, cb func(types0.Coin) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateAccountBalances", ctx, addr, cb)
+}
+
+// IterateAccountBalances indicates an expected call of IterateAccountBalances.
+func (mr *MockBankKeeperMockRecorder) IterateAccountBalances(ctx, addr, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccountBalances", reflect.TypeOf((*MockBankKeeper)(nil).IterateAccountBalances), ctx, addr, cb)
+}
+
+// MintCoins mocks base method.
+func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MintCoins indicates an expected call of MintCoins.
+func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types0.AccAddress, recipientModule string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
+// MockOracleKeeper is a mock of OracleKeeper interface.
+type MockOracleKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockOracleKeeperMockRecorder
+}
+
+// MockOracleKeeperMockRecorder is the mock recorder for MockOracleKeeper.
+type MockOracleKeeperMockRecorder struct {
+	mock *MockOracleKeeper
+}
+
+// NewMockOracleKeeper creates a new mock instance.
+func NewMockOracleKeeper(ctrl *gomock.Controller) *MockOracleKeeper {
+	mock := &MockOracleKeeper{ctrl: ctrl}
+	mock.recorder = &MockOracleKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOracleKeeper) EXPECT() *MockOracleKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetDecimalsForCurrencyPair mocks base method.
+func (m *MockOracleKeeper) GetDecimalsForCurrencyPair(ctx types0.Context, cp types2.CurrencyPair) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecimalsForCurrencyPair", ctx, cp)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecimalsForCurrencyPair indicates an expected call of GetDecimalsForCurrencyPair.
+func (mr *MockOracleKeeperMockRecorder) GetDecimalsForCurrencyPair(ctx, cp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecimalsForCurrencyPair", reflect.TypeOf((*MockOracleKeeper)(nil).GetDecimalsForCurrencyPair), ctx, cp)
+}
+
+// GetPriceForCurrencyPair mocks base method.
+func (m *MockOracleKeeper) GetPriceForCurrencyPair(ctx types0.Context, cp types2.CurrencyPair) (types3.QuotePrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceForCurrencyPair", ctx, cp)
+	ret0, _ := ret[0].(types3.QuotePrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceForCurrencyPair indicates an expected call of GetPriceForCurrencyPair.
+func (mr *MockOracleKeeperMockRecorder) GetPriceForCurrencyPair(ctx, cp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceForCurrencyPair", reflect.TypeOf((*MockOracleKeeper)(nil).GetPriceForCurrencyPair), ctx, cp)
+}
+
+// MockContractKeeper is a mock of ContractKeeper interface.
+type MockContractKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractKeeperMockRecorder
+}
+
+// MockContractKeeperMockRecorder is the mock recorder for MockContractKeeper.
+type MockContractKeeperMockRecorder struct {
+	mock *MockContractKeeper
+}
+
+// NewMockContractKeeper creates a new mock instance.
+func NewMockContractKeeper(ctrl *gomock.Controller) *MockContractKeeper {
+	mock := &MockContractKeeper{ctrl: ctrl}
+	mock.recorder = &MockContractKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractKeeper) EXPECT() *MockContractKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetContractInfo mocks base method.
+func (m *MockContractKeeper) GetContractInfo(ctx context.Context, contractAddress types0.AccAddress) *types.ContractInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractInfo", ctx, contractAddress)
+	ret0, _ := ret[0].(*types.ContractInfo)
+	return ret0
+}
+
+// GetContractInfo indicates an expected call of GetContractInfo.
+func (mr *MockContractKeeperMockRecorder) GetContractInfo(ctx, contractAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractInfo", reflect.TypeOf((*MockContractKeeper)(nil).GetContractInfo), ctx, contractAddress)
+}
+
+// Sudo mocks base method.
+func (m *MockContractKeeper) Sudo(ctx context.Context, contractAddress types0.AccAddress, msg []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sudo", ctx, contractAddress, msg)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sudo indicates an expected call of Sudo.
+func (mr *MockContractKeeperMockRecorder) Sudo(ctx, contractAddress, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sudo", reflect.TypeOf((*MockContractKeeper)(nil).Sudo), ctx, contractAddress, msg)
+}
+
+// MockDexHooks is a mock of DexHooks interface.
+type MockDexHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockDexHooksMockRecorder
+}
+
+// MockDexHooksMockRecorder is the mock recorder for MockDexHooks.
+type MockDexHooksMockRecorder struct {
+	mock *MockDexHooks
+}
+
+// NewMockDexHooks creates a new mock instance.
+func NewMockDexHooks(ctrl *gomock.Controller) *MockDexHooks {
+	mock := &MockDexHooks{ctrl: ctrl}
+	mock.recorder = &MockDexHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDexHooks) EXPECT() *MockDexHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterDeposit mocks base method.
+func (m *MockDexHooks) AfterDeposit(ctx context.Context, receiver types0.AccAddress, pool *types1.Pool, amount0, amount1 math.Int, sharesIssued types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterDeposit", ctx, receiver, pool, amount0, amount1, sharesIssued)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterDeposit indicates an expected call of AfterDeposit.
+func (mr *MockDexHooksMockRecorder) AfterDeposit(ctx, receiver, pool, amount0, amount1, sharesIssued interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterDeposit", reflect.TypeOf((*MockDexHooks)(nil).AfterDeposit), ctx, receiver, pool, amount0, amount1, sharesIssued)
+}
+
+// AfterLimitOrderPlaced mocks base method.
+func (m *MockDexHooks) AfterLimitOrderPlaced(ctx context.Context, receiver types0.AccAddress, tranche *types1.LimitOrderTranche, amountIn math.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterLimitOrderPlaced", ctx, receiver, tranche, amountIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterLimitOrderPlaced indicates an expected call of AfterLimitOrderPlaced.
+func (mr *MockDexHooksMockRecorder) AfterLimitOrderPlaced(ctx, receiver, tranche, amountIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterLimitOrderPlaced", reflect.TypeOf((*MockDexHooks)(nil).AfterLimitOrderPlaced), ctx, receiver, tranche, amountIn)
+}
+
+// AfterSwap mocks base method.
+func (m *MockDexHooks) AfterSwap(ctx context.Context, trader types0.AccAddress, coinIn, coinOut types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterSwap", ctx, trader, coinIn, coinOut)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterSwap indicates an expected call of AfterSwap.
+func (mr *MockDexHooksMockRecorder) AfterSwap(ctx, trader, coinIn, coinOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterSwap", reflect.TypeOf((*MockDexHooks)(nil).AfterSwap), ctx, trader, coinIn, coinOut)
+}
+
+// AfterTrancheFilled mocks base method.
+func (m *MockDexHooks) AfterTrancheFilled(ctx context.Context, tranche *types1.LimitOrderTranche, amountIn, amountOut math.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterTrancheFilled", ctx, tranche, amountIn, amountOut)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterTrancheFilled indicates an expected call of AfterTrancheFilled.
+func (mr *MockDexHooksMockRecorder) AfterTrancheFilled(ctx, tranche, amountIn, amountOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterTrancheFilled", reflect.TypeOf((*MockDexHooks)(nil).AfterTrancheFilled), ctx, tranche, amountIn, amountOut)
+}
+
+// AfterWithdraw mocks base method.
+func (m *MockDexHooks) AfterWithdraw(ctx context.Context, withdrawer types0.AccAddress, pool *types1.Pool, amount0, amount1 math.Int, sharesRemoved types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterWithdraw", ctx, withdrawer, pool, amount0, amount1, sharesRemoved)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterWithdraw indicates an expected call of AfterWithdraw.
+func (mr *MockDexHooksMockRecorder) AfterWithdraw(ctx, withdrawer, pool, amount0, amount1, sharesRemoved interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterWithdraw", reflect.TypeOf((*MockDexHooks)(nil).AfterWithdraw), ctx, withdrawer, pool, amount0, amount1, sharesRemoved)
+}
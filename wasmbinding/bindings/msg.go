@@ -9,6 +9,7 @@ import (
 
 	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
 	feetypes "github.com/neutron-org/neutron/v4/x/feerefunder/types"
+	incentivestypes "github.com/neutron-org/neutron/v4/x/incentives/types"
 	icqtypes "github.com/neutron-org/neutron/v4/x/interchainqueries/types"
 	transferwrappertypes "github.com/neutron-org/neutron/v4/x/transfer/types"
 )
@@ -64,6 +65,9 @@ type NeutronMsg struct {
 
 	// dex module bindings
 	Dex *Dex `json:"dex,omitempty"`
+
+	// incentives module bindings
+	Incentives *Incentives `json:"incentives,omitempty"`
 }
 
 // SubmitTx submits interchain transaction on a remote chain.
@@ -228,12 +232,30 @@ type ResubmitFailureResponse struct {
 }
 
 type Dex struct {
-	Deposit                  *dextypes.MsgDeposit                  `json:"deposit"`
-	Withdrawal               *dextypes.MsgWithdrawal               `json:"withdrawal"`
-	PlaceLimitOrder          *MsgPlaceLimitOrder                   `json:"place_limit_order"`
-	WithdrawFilledLimitOrder *dextypes.MsgWithdrawFilledLimitOrder `json:"withdraw_filled_limit_order"`
-	CancelLimitOrder         *dextypes.MsgCancelLimitOrder         `json:"cancel_limit_order"`
-	MultiHopSwap             *dextypes.MsgMultiHopSwap             `json:"multi_hop_swap"`
+	Deposit                        *dextypes.MsgDeposit                        `json:"deposit"`
+	Withdrawal                     *dextypes.MsgWithdrawal                     `json:"withdrawal"`
+	PlaceLimitOrder                *MsgPlaceLimitOrder                         `json:"place_limit_order"`
+	WithdrawFilledLimitOrder       *dextypes.MsgWithdrawFilledLimitOrder       `json:"withdraw_filled_limit_order"`
+	CancelLimitOrder               *dextypes.MsgCancelLimitOrder               `json:"cancel_limit_order"`
+	MultiHopSwap                   *dextypes.MsgMultiHopSwap                   `json:"multi_hop_swap"`
+	PlaceConditionalOrder          *MsgPlaceConditionalOrder                   `json:"place_conditional_order"`
+	CancelConditionalOrder         *dextypes.MsgCancelConditionalOrder         `json:"cancel_conditional_order"`
+	SwapExactIn                    *dextypes.MsgSwapExactIn                    `json:"swap_exact_in"`
+	BatchCancelLimitOrders         *dextypes.MsgBatchCancelLimitOrders         `json:"batch_cancel_limit_orders"`
+	BatchWithdrawFilledLimitOrders *dextypes.MsgBatchWithdrawFilledLimitOrders `json:"batch_withdraw_filled_limit_orders"`
+	CancelAllLimitOrders           *dextypes.MsgCancelAllLimitOrders           `json:"cancel_all_limit_orders"`
+	DepositRange                   *dextypes.MsgDepositRange                   `json:"deposit_range"`
+	WithdrawRange                  *dextypes.MsgWithdrawRange                  `json:"withdraw_range"`
+	WithdrawPosition               *dextypes.MsgWithdrawPosition               `json:"withdraw_position"`
+	FlashSwap                      *dextypes.MsgFlashSwap                      `json:"flash_swap"`
+	ModifyLimitOrder               *MsgModifyLimitOrder                        `json:"modify_limit_order"`
+}
+
+type Incentives struct {
+	CreateGauge  *incentivestypes.MsgCreateGauge  `json:"create_gauge"`
+	Stake        *incentivestypes.MsgStake        `json:"stake"`
+	Unstake      *incentivestypes.MsgUnstake      `json:"unstake"`
+	ClaimRewards *incentivestypes.MsgClaimRewards `json:"claim_rewards"`
 }
 
 // MsgPlaceLimitOrder is a copy dextypes.MsgPlaceLimitOrder with altered ExpirationTime field,
@@ -252,4 +274,33 @@ type MsgPlaceLimitOrder struct {
 	MaxAmountOut   *math.Int `json:"max_amount_out"`
 	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
 	LimitSellPrice string `json:"limit_sell_price,omitempty"`
+	// Defaults to ALLOW_SELF_TRADE if empty
+	SelfTradePrevention string `json:"self_trade_prevention,omitempty"`
+	// If true, fills of the order are recorded and can be queried with the user_fill_records_all dex query
+	RecordFills bool `json:"record_fills,omitempty"`
+}
+
+// MsgModifyLimitOrder is a copy dextypes.MsgModifyLimitOrder with altered ExpirationTime field,
+// it's a preferable way to pass timestamp as unixtime to contracts
+type MsgModifyLimitOrder struct {
+	Creator        string    `json:"creator,omitempty"`
+	TrancheKey     string    `json:"tranche_key,omitempty"`
+	ReduceAmountIn *math.Int `json:"reduce_amount_in"`
+	// expirationTime is only valid for GOOD_TIL_TIME limit orders
+	ExpirationTime *uint64 `json:"expiration_time,omitempty"`
+}
+
+// MsgPlaceConditionalOrder is a copy dextypes.MsgPlaceConditionalOrder with enums and prices passed as strings
+type MsgPlaceConditionalOrder struct {
+	Receiver     string    `json:"receiver,omitempty"`
+	TokenIn      string    `json:"token_in,omitempty"`
+	TokenOut     string    `json:"token_out,omitempty"`
+	AmountIn     math.Int  `json:"amount_in"`
+	OrderType    string    `json:"order_type,omitempty"`
+	MaxAmountOut *math.Int `json:"max_amount_out"`
+	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
+	LimitSellPrice string `json:"limit_sell_price,omitempty"`
+	Trigger        string `json:"trigger,omitempty"`
+	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
+	TriggerPrice string `json:"trigger_price,omitempty"`
 }
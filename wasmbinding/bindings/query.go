@@ -273,6 +273,44 @@ type DexQuery struct {
 	PoolMetadata *dextypes.QueryGetPoolMetadataRequest `json:"pool_metadata"`
 	// Queries a list of PoolMetadata items.
 	PoolMetadataAll *dextypes.QueryAllPoolMetadataRequest `json:"pool_metadata_all"`
+	// Queries a ConditionalOrder by ID
+	ConditionalOrder *dextypes.QueryGetConditionalOrderRequest `json:"conditional_order"`
+	// Queries a list of ConditionalOrder items.
+	ConditionalOrderAll *dextypes.QueryAllConditionalOrderRequest `json:"conditional_order_all"`
+	// Queries the arithmetic time weighted average price of a trade pair
+	ArithmeticTwap *QueryTwapRequest `json:"arithmetic_twap"`
+	// Queries the geometric time weighted average price of a trade pair
+	GeometricTwap *QueryTwapRequest `json:"geometric_twap"`
+	// Queries the routes with liquidity between two denoms ranked by estimated output
+	EstimateBestRoute *dextypes.QueryEstimateBestRouteRequest `json:"estimate_best_route"`
+	// Queries the liquidity of a trade pair aggregated into price buckets
+	OrderBookDepth *dextypes.QueryOrderBookDepthRequest `json:"order_book_depth"`
+	// Queries the recorded fills of an address's limit orders
+	UserFillRecordsAll *dextypes.QueryAllUserFillRecordsRequest `json:"user_fill_records_all"`
+	// Queries the pairs with pools or limit orders trading a denom
+	PairsByDenom *dextypes.QueryPairsByDenomRequest `json:"pairs_by_denom"`
+	// Queries the pools holding a denom
+	PoolsByDenom *dextypes.QueryPoolsByDenomRequest `json:"pools_by_denom"`
+	// Queries the pairs with liquidity on either side
+	ActivePairs *dextypes.QueryActivePairsRequest `json:"active_pairs"`
+	// Queries the current value and earned fees of an address's pool positions
+	UserPositionValuesAll *dextypes.QueryAllUserPositionValuesRequest `json:"user_position_values_all"`
+	// Queries the simulated result of a deposit
+	EstimateDeposit *dextypes.QueryEstimateDepositRequest `json:"estimate_deposit"`
+	// Queries the simulated result of a withdrawal
+	EstimateWithdrawal *dextypes.QueryEstimateWithdrawalRequest `json:"estimate_withdrawal"`
+	// Queries the fee tier recommended for a pair based on the realized volatility of its price
+	RecommendedFee *dextypes.QueryRecommendedFeeRequest `json:"recommended_fee"`
+}
+
+// QueryTwapRequest is a copy of dextypes.QueryArithmeticTwapRequest / dextypes.QueryGeometricTwapRequest with
+// altered StartTime and EndTime fields, it's a preferable way to pass timestamp as unixtime to contracts
+type QueryTwapRequest struct {
+	TokenIn   string `json:"token_in,omitempty"`
+	TokenOut  string `json:"token_out,omitempty"`
+	StartTime uint64 `json:"start_time"`
+	// If EndTime is not supplied the current block time is used
+	EndTime *uint64 `json:"end_time,omitempty"`
 }
 
 // QueryEstimatePlaceLimitOrderRequest is a copy dextypes.QueryEstimatePlaceLimitOrderRequest with altered ExpirationTime field,
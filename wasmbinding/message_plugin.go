@@ -14,6 +14,8 @@ import (
 	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
 	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
 	dexutils "github.com/neutron-org/neutron/v4/x/dex/utils"
+	incentiveskeeper "github.com/neutron-org/neutron/v4/x/incentives/keeper"
+	incentivestypes "github.com/neutron-org/neutron/v4/x/incentives/types"
 
 	contractmanagerkeeper "github.com/neutron-org/neutron/v4/x/contractmanager/keeper"
 
@@ -60,6 +62,7 @@ func CustomMessageDecorator(
 	cronKeeper *cronkeeper.Keeper,
 	contractmanagerKeeper *contractmanagerkeeper.Keeper,
 	dexKeeper *dexkeeper.Keeper,
+	incentivesKeeper *incentiveskeeper.Keeper,
 ) func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
@@ -75,6 +78,7 @@ func CustomMessageDecorator(
 			AdminKeeper:           adminKeeper,
 			ContractmanagerKeeper: contractmanagerKeeper,
 			DexMsgServer:          dexkeeper.NewMsgServerImpl(*dexKeeper),
+			IncentivesMsgServer:   incentiveskeeper.NewMsgServerImpl(*incentivesKeeper),
 		}
 	}
 }
@@ -92,6 +96,7 @@ type CustomMessenger struct {
 	AdminKeeper           *adminmodulekeeper.Keeper
 	ContractmanagerKeeper *contractmanagerkeeper.Keeper
 	DexMsgServer          dextypes.MsgServer
+	IncentivesMsgServer   incentivestypes.MsgServer
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -170,6 +175,10 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		data, messages, err := m.dispatchDexMsg(ctx, contractAddr, *(contractMsg.Dex))
 		return nil, data, messages, err
 	}
+	if contractMsg.Incentives != nil {
+		data, messages, err := m.dispatchIncentivesMsg(ctx, contractAddr, *(contractMsg.Incentives))
+		return nil, data, messages, err
+	}
 
 	// If none of the conditions are met, forward the message to the wrapped handler
 	return m.Wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
@@ -232,6 +241,7 @@ func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAd
 			TickIndexInToOut: dex.PlaceLimitOrder.TickIndexInToOut,
 			AmountIn:         dex.PlaceLimitOrder.AmountIn,
 			MaxAmountOut:     dex.PlaceLimitOrder.MaxAmountOut,
+			RecordFills:      dex.PlaceLimitOrder.RecordFills,
 		}
 		orderTypeInt, ok := dextypes.LimitOrderType_value[dex.PlaceLimitOrder.OrderType]
 		if !ok {
@@ -257,6 +267,19 @@ func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAd
 			msg.LimitSellPrice = &limitPriceDec
 		}
 
+		if selfTradePrevention := dex.PlaceLimitOrder.SelfTradePrevention; selfTradePrevention != "" {
+			selfTradePreventionInt, ok := dextypes.SelfTradePrevention_value[selfTradePrevention]
+			if !ok {
+				return nil, nil, errors.Wrap(dextypes.ErrInvalidSelfTradePrevention,
+					fmt.Sprintf(
+						"got \"%s\", expected one of %s",
+						selfTradePrevention,
+						strings.Join(maps.Keys(dextypes.SelfTradePrevention_value), ", ")),
+				)
+			}
+			msg.SelfTradePrevention = dextypes.SelfTradePrevention(selfTradePreventionInt)
+		}
+
 		return handleDexMsg(ctx, &msg, m.DexMsgServer.PlaceLimitOrder)
 	case dex.CancelLimitOrder != nil:
 		dex.CancelLimitOrder.Creator = contractAddr.String()
@@ -267,6 +290,111 @@ func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAd
 	case dex.MultiHopSwap != nil:
 		dex.MultiHopSwap.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.MultiHopSwap, m.DexMsgServer.MultiHopSwap)
+	case dex.PlaceConditionalOrder != nil:
+		msg := dextypes.MsgPlaceConditionalOrder{
+			Creator:      contractAddr.String(),
+			Receiver:     dex.PlaceConditionalOrder.Receiver,
+			TokenIn:      dex.PlaceConditionalOrder.TokenIn,
+			TokenOut:     dex.PlaceConditionalOrder.TokenOut,
+			AmountIn:     dex.PlaceConditionalOrder.AmountIn,
+			MaxAmountOut: dex.PlaceConditionalOrder.MaxAmountOut,
+		}
+		orderTypeInt, ok := dextypes.LimitOrderType_value[dex.PlaceConditionalOrder.OrderType]
+		if !ok {
+			return nil, nil, errors.Wrap(dextypes.ErrInvalidOrderType,
+				fmt.Sprintf(
+					"got \"%s\", expected one of %s",
+					dex.PlaceConditionalOrder.OrderType,
+					strings.Join(maps.Keys(dextypes.LimitOrderType_value), ", ")),
+			)
+		}
+		msg.OrderType = dextypes.LimitOrderType(orderTypeInt)
+
+		triggerInt, ok := dextypes.ConditionalOrderTrigger_value[dex.PlaceConditionalOrder.Trigger]
+		if !ok {
+			return nil, nil, errors.Wrap(dextypes.ErrInvalidConditionalOrderTrigger,
+				fmt.Sprintf(
+					"got \"%s\", expected one of %s",
+					dex.PlaceConditionalOrder.Trigger,
+					strings.Join(maps.Keys(dextypes.ConditionalOrderTrigger_value), ", ")),
+			)
+		}
+		msg.Trigger = dextypes.ConditionalOrderTrigger(triggerInt)
+
+		limitPriceDec, err := dexutils.ParsePrecDecScientificNotation(dex.PlaceConditionalOrder.LimitSellPrice)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "cannot parse string %s for limit price", dex.PlaceConditionalOrder.LimitSellPrice)
+		}
+		msg.LimitSellPrice = limitPriceDec
+
+		triggerPriceDec, err := dexutils.ParsePrecDecScientificNotation(dex.PlaceConditionalOrder.TriggerPrice)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "cannot parse string %s for trigger price", dex.PlaceConditionalOrder.TriggerPrice)
+		}
+		msg.TriggerPrice = triggerPriceDec
+
+		return handleDexMsg(ctx, &msg, m.DexMsgServer.PlaceConditionalOrder)
+	case dex.CancelConditionalOrder != nil:
+		dex.CancelConditionalOrder.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.CancelConditionalOrder, m.DexMsgServer.CancelConditionalOrder)
+	case dex.SwapExactIn != nil:
+		dex.SwapExactIn.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.SwapExactIn, m.DexMsgServer.SwapExactIn)
+	case dex.BatchCancelLimitOrders != nil:
+		dex.BatchCancelLimitOrders.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.BatchCancelLimitOrders, m.DexMsgServer.BatchCancelLimitOrders)
+	case dex.BatchWithdrawFilledLimitOrders != nil:
+		dex.BatchWithdrawFilledLimitOrders.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.BatchWithdrawFilledLimitOrders, m.DexMsgServer.BatchWithdrawFilledLimitOrders)
+	case dex.CancelAllLimitOrders != nil:
+		dex.CancelAllLimitOrders.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.CancelAllLimitOrders, m.DexMsgServer.CancelAllLimitOrders)
+	case dex.DepositRange != nil:
+		dex.DepositRange.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.DepositRange, m.DexMsgServer.DepositRange)
+	case dex.WithdrawRange != nil:
+		dex.WithdrawRange.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.WithdrawRange, m.DexMsgServer.WithdrawRange)
+	case dex.WithdrawPosition != nil:
+		dex.WithdrawPosition.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.WithdrawPosition, m.DexMsgServer.WithdrawPosition)
+	case dex.FlashSwap != nil:
+		dex.FlashSwap.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.FlashSwap, m.DexMsgServer.FlashSwap)
+	case dex.ModifyLimitOrder != nil:
+		msg := dextypes.MsgModifyLimitOrder{
+			Creator:        contractAddr.String(),
+			TrancheKey:     dex.ModifyLimitOrder.TrancheKey,
+			ReduceAmountIn: dex.ModifyLimitOrder.ReduceAmountIn,
+		}
+		if dex.ModifyLimitOrder.ExpirationTime != nil {
+			t := time.Unix(int64(*(dex.ModifyLimitOrder.ExpirationTime)), 0)
+			msg.ExpirationTime = &t
+		}
+		return handleDexMsg(ctx, &msg, m.DexMsgServer.ModifyLimitOrder)
+	}
+
+	return nil, nil, sdkerrors.ErrUnknownRequest
+}
+
+func (m *CustomMessenger) dispatchIncentivesMsg(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	incentives bindings.Incentives,
+) ([][]byte, [][]*types.Any, error) {
+	switch {
+	case incentives.CreateGauge != nil:
+		incentives.CreateGauge.Creator = contractAddr.String()
+		return handleDexMsg(ctx, incentives.CreateGauge, m.IncentivesMsgServer.CreateGauge)
+	case incentives.Stake != nil:
+		incentives.Stake.Owner = contractAddr.String()
+		return handleDexMsg(ctx, incentives.Stake, m.IncentivesMsgServer.Stake)
+	case incentives.Unstake != nil:
+		incentives.Unstake.Owner = contractAddr.String()
+		return handleDexMsg(ctx, incentives.Unstake, m.IncentivesMsgServer.Unstake)
+	case incentives.ClaimRewards != nil:
+		incentives.ClaimRewards.Owner = contractAddr.String()
+		return handleDexMsg(ctx, incentives.ClaimRewards, m.IncentivesMsgServer.ClaimRewards)
 	}
 
 	return nil, nil, sdkerrors.ErrUnknownRequest
@@ -201,6 +201,48 @@ func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data
 		data, err = dexQuery(ctx, query.TickLiquidityAll, qp.dexKeeper.TickLiquidityAll)
 	case query.UserDepositsAll != nil:
 		data, err = dexQuery(ctx, query.UserDepositsAll, qp.dexKeeper.UserDepositsAll)
+	case query.ConditionalOrder != nil:
+		data, err = dexQuery(ctx, query.ConditionalOrder, qp.dexKeeper.ConditionalOrder)
+	case query.ConditionalOrderAll != nil:
+		data, err = dexQuery(ctx, query.ConditionalOrderAll, qp.dexKeeper.ConditionalOrderAll)
+	case query.ArithmeticTwap != nil:
+		startTime, endTime := twapTimeRange(query.ArithmeticTwap)
+		q := dextypes.QueryArithmeticTwapRequest{
+			TokenIn:   query.ArithmeticTwap.TokenIn,
+			TokenOut:  query.ArithmeticTwap.TokenOut,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}
+		data, err = dexQuery(ctx, &q, qp.dexKeeper.ArithmeticTwap)
+	case query.GeometricTwap != nil:
+		startTime, endTime := twapTimeRange(query.GeometricTwap)
+		q := dextypes.QueryGeometricTwapRequest{
+			TokenIn:   query.GeometricTwap.TokenIn,
+			TokenOut:  query.GeometricTwap.TokenOut,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}
+		data, err = dexQuery(ctx, &q, qp.dexKeeper.GeometricTwap)
+	case query.EstimateBestRoute != nil:
+		data, err = dexQuery(ctx, query.EstimateBestRoute, qp.dexKeeper.EstimateBestRoute)
+	case query.OrderBookDepth != nil:
+		data, err = dexQuery(ctx, query.OrderBookDepth, qp.dexKeeper.OrderBookDepth)
+	case query.UserFillRecordsAll != nil:
+		data, err = dexQuery(ctx, query.UserFillRecordsAll, qp.dexKeeper.UserFillRecordsAll)
+	case query.PairsByDenom != nil:
+		data, err = dexQuery(ctx, query.PairsByDenom, qp.dexKeeper.PairsByDenom)
+	case query.PoolsByDenom != nil:
+		data, err = dexQuery(ctx, query.PoolsByDenom, qp.dexKeeper.PoolsByDenom)
+	case query.ActivePairs != nil:
+		data, err = dexQuery(ctx, query.ActivePairs, qp.dexKeeper.ActivePairs)
+	case query.UserPositionValuesAll != nil:
+		data, err = dexQuery(ctx, query.UserPositionValuesAll, qp.dexKeeper.UserPositionValuesAll)
+	case query.EstimateDeposit != nil:
+		data, err = dexQuery(ctx, query.EstimateDeposit, qp.dexKeeper.EstimateDeposit)
+	case query.EstimateWithdrawal != nil:
+		data, err = dexQuery(ctx, query.EstimateWithdrawal, qp.dexKeeper.EstimateWithdrawal)
+	case query.RecommendedFee != nil:
+		data, err = dexQuery(ctx, query.RecommendedFee, qp.dexKeeper.RecommendedFee)
 
 	default:
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown neutron.dex query type"}
@@ -241,6 +283,15 @@ func (qp *QueryPlugin) MarketMapQuery(ctx sdk.Context, query bindings.MarketMapQ
 	}
 }
 
+func twapTimeRange(query *bindings.QueryTwapRequest) (startTime time.Time, endTime *time.Time) {
+	startTime = time.Unix(int64(query.StartTime), 0)
+	if query.EndTime != nil {
+		t := time.Unix(int64(*query.EndTime), 0)
+		endTime = &t
+	}
+	return startTime, endTime
+}
+
 func dexQuery[T, R any](ctx sdk.Context, query *T, queryHandler func(ctx context.Context, query *T) (R, error)) ([]byte, error) {
 	resp, err := queryHandler(ctx, query)
 	if err != nil {
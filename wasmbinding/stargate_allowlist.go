@@ -18,6 +18,7 @@ import (
 	crontypes "github.com/neutron-org/neutron/v4/x/cron/types"
 	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
 	feeburnertypes "github.com/neutron-org/neutron/v4/x/feeburner/types"
+	incentivestypes "github.com/neutron-org/neutron/v4/x/incentives/types"
 	interchainqueriestypes "github.com/neutron-org/neutron/v4/x/interchainqueries/types"
 	interchaintxstypes "github.com/neutron-org/neutron/v4/x/interchaintxs/types"
 	tokenfactorytypes "github.com/neutron-org/neutron/v4/x/tokenfactory/types"
@@ -90,6 +91,30 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/neutron.dex.Query/PoolByID":                          &dextypes.QueryPoolResponse{},
 		"/neutron.dex.Query/PoolMetadata":                      &dextypes.QueryGetPoolMetadataResponse{},
 		"/neutron.dex.Query/PoolMetadataAll":                   &dextypes.QueryAllPoolMetadataResponse{},
+		"/neutron.dex.Query/ArithmeticTwap":                    &dextypes.QueryArithmeticTwapResponse{},
+		"/neutron.dex.Query/GeometricTwap":                     &dextypes.QueryGeometricTwapResponse{},
+		"/neutron.dex.Query/EstimateBestRoute":                 &dextypes.QueryEstimateBestRouteResponse{},
+		"/neutron.dex.Query/OrderBookDepth":                    &dextypes.QueryOrderBookDepthResponse{},
+		"/neutron.dex.Query/ProtocolFee":                       &dextypes.QueryGetProtocolFeeResponse{},
+		"/neutron.dex.Query/ProtocolFeeAll":                    &dextypes.QueryAllProtocolFeeResponse{},
+		"/neutron.dex.Query/PairCircuitBreaker":                &dextypes.QueryGetPairCircuitBreakerResponse{},
+		"/neutron.dex.Query/PairCircuitBreakerAll":             &dextypes.QueryAllPairCircuitBreakerResponse{},
+		"/neutron.dex.Query/UserFillRecordsAll":                &dextypes.QueryAllUserFillRecordsResponse{},
+		"/neutron.dex.Query/PairsByDenom":                      &dextypes.QueryPairsByDenomResponse{},
+		"/neutron.dex.Query/PoolsByDenom":                      &dextypes.QueryPoolsByDenomResponse{},
+		"/neutron.dex.Query/ActivePairs":                       &dextypes.QueryActivePairsResponse{},
+		"/neutron.dex.Query/UserPositionValuesAll":             &dextypes.QueryAllUserPositionValuesResponse{},
+		"/neutron.dex.Query/EstimateDeposit":                   &dextypes.QueryEstimateDepositResponse{},
+		"/neutron.dex.Query/EstimateWithdrawal":                &dextypes.QueryEstimateWithdrawalResponse{},
+		"/neutron.dex.Query/RecommendedFee":                    &dextypes.QueryRecommendedFeeResponse{},
+
+		// incentives
+		"/neutron.incentives.Query/Params":         &incentivestypes.QueryParamsResponse{},
+		"/neutron.incentives.Query/Gauge":          &incentivestypes.QueryGetGaugeResponse{},
+		"/neutron.incentives.Query/Gauges":         &incentivestypes.QueryAllGaugeResponse{},
+		"/neutron.incentives.Query/Stake":          &incentivestypes.QueryGetStakeResponse{},
+		"/neutron.incentives.Query/StakesByOwner":  &incentivestypes.QueryStakesByOwnerResponse{},
+		"/neutron.incentives.Query/AccountRewards": &incentivestypes.QueryAccountRewardsResponse{},
 
 		// oracle
 		"/slinky.oracle.v1.Query/GetAllCurrencyPairs": &oracletypes.GetAllCurrencyPairsResponse{},
@@ -9,6 +9,7 @@ import (
 	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
 	feeburnerkeeper "github.com/neutron-org/neutron/v4/x/feeburner/keeper"
 	feerefunderkeeper "github.com/neutron-org/neutron/v4/x/feerefunder/keeper"
+	incentiveskeeper "github.com/neutron-org/neutron/v4/x/incentives/keeper"
 
 	adminmodulekeeper "github.com/cosmos/admin-module/v2/x/adminmodule/keeper"
 
@@ -34,6 +35,7 @@ func RegisterCustomPlugins(
 	cronKeeper *cronkeeper.Keeper,
 	contractmanagerKeeper *contractmanagerkeeper.Keeper,
 	dexKeeper *dexkeeper.Keeper,
+	incentivesKeeper *incentiveskeeper.Keeper,
 	oracleKeeper *oraclekeeper.Keeper,
 	markemapKeeper *marketmapkeeper.Keeper,
 ) []wasmkeeper.Option {
@@ -43,7 +45,7 @@ func RegisterCustomPlugins(
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messagePluginOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, adminKeeper, bank, tfk, cronKeeper, contractmanagerKeeper, dexKeeper),
+		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, adminKeeper, bank, tfk, cronKeeper, contractmanagerKeeper, dexKeeper, incentivesKeeper),
 	)
 
 	return []wasmkeeper.Option{
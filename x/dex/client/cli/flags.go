@@ -3,10 +3,23 @@ package cli
 import flag "github.com/spf13/pflag"
 
 const (
-	FlagMaxAmountOut    = "max-amount-out"
-	FlagIncludePoolData = "include-pool-data"
-	FlagCalcWithdraw    = "calc-withdraw"
-	FlagPrice           = "price"
+	FlagMaxAmountOut        = "max-amount-out"
+	FlagIncludePoolData     = "include-pool-data"
+	FlagCalcWithdraw        = "calc-withdraw"
+	FlagPrice               = "price"
+	FlagAmountOut           = "amount-out"
+	FlagMinSharesOut        = "min-shares-out"
+	FlagMinAmount0Out       = "min-amount0-out"
+	FlagMinAmount1Out       = "min-amount1-out"
+	FlagOraclePeg           = "oracle-peg"
+	FlagOracleOffsetBps     = "oracle-offset-bps"
+	FlagSelfTradePrevention = "self-trade-prevention"
+	FlagRecordFills         = "record-fills"
+	FlagTrancheKey          = "tranche-key"
+	FlagQuoteDenom          = "quote-denom"
+	FlagReduceAmountIn      = "reduce-amount-in"
+	FlagExpirationTime      = "expiration-time"
+	FlagUseRecommendedFee   = "use-recommended-fee"
 )
 
 func FlagSetMaxAmountOut() *flag.FlagSet {
@@ -15,6 +28,12 @@ func FlagSetMaxAmountOut() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetAmountOut() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagAmountOut, "", "Exact amount to be returned from swap; amount-in is used as the max amount in")
+	return fs
+}
+
 func FlagSetPrice() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(FlagPrice, "", "Sell price for limit order")
@@ -32,3 +51,60 @@ func FlagSetCalcWithdrawableAmount() *flag.FlagSet {
 	fs.Bool(FlagCalcWithdraw, false, "Calculate withdrawable amount")
 	return fs
 }
+
+func FlagSetMinSharesOut() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMinSharesOut, "", "Comma separated list of the minimum shares to be issued for each deposit")
+	return fs
+}
+
+func FlagSetMinAmountsOut() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMinAmount0Out, "", "Minimum amount of the pair's token0 to be withdrawn")
+	fs.String(FlagMinAmount1Out, "", "Minimum amount of the pair's token1 to be withdrawn")
+	return fs
+}
+
+func FlagSetOraclePeg() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagOraclePeg, "", "Slinky currency pair (ie. ATOM/USD) whose price the limit order is pegged to")
+	fs.Int64(FlagOracleOffsetBps, 0, "Offset from the oracle price in basis points for an oracle pegged limit order")
+	return fs
+}
+
+func FlagSetSelfTradePrevention() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSelfTradePrevention, "", "Handling of own resting limit orders: CANCEL_NEWEST, CANCEL_OLDEST or SKIP_OWN_ORDERS")
+	return fs
+}
+
+func FlagSetRecordFills() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagRecordFills, false, "Record the fills of the limit order so they can be queried with list-user-fill-records")
+	return fs
+}
+
+func FlagSetTrancheKey() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagTrancheKey, "", "Only return results for the limit order in the tranche with this key")
+	return fs
+}
+
+func FlagSetQuoteDenom() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagQuoteDenom, "", "Also value the results in this denom at current prices")
+	return fs
+}
+
+func FlagSetModifyLimitOrder() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagReduceAmountIn, "", "Amount of the unfilled portion of the limit order to return")
+	fs.String(FlagExpirationTime, "", "New expiration time (01/02/2006 15:04:05) of a GOOD_TIL_TIME limit order")
+	return fs
+}
+
+func FlagSetUseRecommendedFee() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagUseRecommendedFee, false, "Make autoswap deposits into the pools with the pair's recommended fee, if any")
+	return fs
+}
@@ -40,6 +40,23 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdListPoolMetadata())
 	cmd.AddCommand(CmdShowPoolMetadata())
+
+	cmd.AddCommand(CmdListConditionalOrder())
+	cmd.AddCommand(CmdShowConditionalOrder())
+	cmd.AddCommand(CmdArithmeticTwap())
+	cmd.AddCommand(CmdGeometricTwap())
+	cmd.AddCommand(CmdEstimateBestRoute())
+	cmd.AddCommand(CmdOrderBookDepth())
+	cmd.AddCommand(CmdListProtocolFee())
+	cmd.AddCommand(CmdShowProtocolFee())
+	cmd.AddCommand(CmdListPairCircuitBreaker())
+	cmd.AddCommand(CmdShowPairCircuitBreaker())
+	cmd.AddCommand(CmdListUserFillRecords())
+	cmd.AddCommand(CmdListPairsByDenom())
+	cmd.AddCommand(CmdListPoolsByDenom())
+	cmd.AddCommand(CmdListActivePairs())
+	cmd.AddCommand(CmdListUserPositionValues())
+	cmd.AddCommand(CmdRecommendedFee())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdListPairsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-pairs-by-denom [denom]",
+		Short:   "list the pairs with pools or limit orders trading a denom",
+		Example: "list-pairs-by-denom tokenA",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqDenom := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPairsByDenomRequest{
+				Denom:      reqDenom,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PairsByDenom(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdListPoolsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-pools-by-denom [denom]",
+		Short:   "list the pools holding a denom",
+		Example: "list-pools-by-denom tokenA",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqDenom := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPoolsByDenomRequest{
+				Denom:      reqDenom,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PoolsByDenom(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdListActivePairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-active-pairs",
+		Short:   "list the pairs with liquidity on either side",
+		Example: "list-active-pairs",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryActivePairsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ActivePairs(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
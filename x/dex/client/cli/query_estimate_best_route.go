@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdEstimateBestRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "estimate-best-route [token-in] [token-out] [amount-in] ?[max-hops]",
+		Short:   "Queries the routes with liquidity between token-in and token-out ranked by estimated output",
+		Example: "estimate-best-route tokenA tokenB 1000 2",
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			amountInInt, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Invalid value for amount-in")
+			}
+
+			var maxHops uint64
+			if len(args) == 4 {
+				maxHops, err = strconv.ParseUint(args[3], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := &types.QueryEstimateBestRouteRequest{
+				TokenIn:  args[0],
+				TokenOut: args[1],
+				AmountIn: amountInInt,
+				MaxHops:  maxHops,
+			}
+
+			res, err := queryClient.EstimateBestRoute(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdOrderBookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order-book-depth [token-in] [token-out] ?[bucket-width] ?[max-buckets]",
+		Short:   "Queries the token-out liquidity available to token-in takers aggregated into price buckets",
+		Example: "order-book-depth tokenA tokenB 10 50",
+		Args:    cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var bucketWidth, maxBuckets uint64
+			if len(args) >= 3 {
+				bucketWidth, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			if len(args) == 4 {
+				maxBuckets, err = strconv.ParseUint(args[3], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := &types.QueryOrderBookDepthRequest{
+				TokenIn:     args[0],
+				TokenOut:    args[1],
+				BucketWidth: bucketWidth,
+				MaxBuckets:  maxBuckets,
+			}
+
+			res, err := queryClient.OrderBookDepth(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdListPairCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pair-circuit-breaker",
+		Short: "list the circuit breakers of all pairs",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllPairCircuitBreakerRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PairCircuitBreakerAll(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPairCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-pair-circuit-breaker [pair-id]",
+		Short:   "shows the circuit breaker of a pair",
+		Example: "show-pair-circuit-breaker tokenA<>tokenB",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetPairCircuitBreakerRequest{
+				PairId: args[0],
+			}
+
+			res, err := queryClient.PairCircuitBreaker(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
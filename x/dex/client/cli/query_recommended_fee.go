@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdRecommendedFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "recommended-fee [token-a] [token-b]",
+		Short:   "shows the fee tier recommended for a pair based on the realized volatility of its price",
+		Example: "recommended-fee tokenA tokenB",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRecommendedFeeRequest{
+				TokenA: args[0],
+				TokenB: args[1],
+			}
+
+			res, err := queryClient.RecommendedFee(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+const twapTimeFormat = "01/02/2006 15:04:05"
+
+func parseTwapTimeRange(args []string) (startTime time.Time, endTime *time.Time, err error) {
+	startTime, err = time.Parse(twapTimeFormat, args[0])
+	if err != nil {
+		return startTime, nil, sdkerrors.Wrapf(types.ErrInvalidTimeString, err.Error())
+	}
+
+	if len(args) == 2 {
+		tm, err := time.Parse(twapTimeFormat, args[1])
+		if err != nil {
+			return startTime, nil, sdkerrors.Wrapf(types.ErrInvalidTimeString, err.Error())
+		}
+		endTime = &tm
+	}
+
+	return startTime, endTime, nil
+}
+
+func CmdArithmeticTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "arithmetic-twap [token-in] [token-out] [start-time] ?[end-time]",
+		Short:   "Queries the arithmetic time weighted average price of token-in denominated in token-out",
+		Example: "arithmetic-twap tokenA tokenB '01/02/2006 15:04:05'",
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, err := parseTwapTimeRange(args[2:])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryArithmeticTwapRequest{
+				TokenIn:   args[0],
+				TokenOut:  args[1],
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGeometricTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "geometric-twap [token-in] [token-out] [start-time] ?[end-time]",
+		Short:   "Queries the geometric time weighted average price of token-in denominated in token-out",
+		Example: "geometric-twap tokenA tokenB '01/02/2006 15:04:05'",
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, err := parseTwapTimeRange(args[2:])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGeometricTwapRequest{
+				TokenIn:   args[0],
+				TokenOut:  args[1],
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+
+			res, err := queryClient.GeometricTwap(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
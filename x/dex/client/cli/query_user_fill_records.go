@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdListUserFillRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-user-fill-records [address] ?(--tranche-key)",
+		Short:   "list the recorded fills of a user's limit orders",
+		Example: "list-user-fill-records alice --tranche-key TRANCHEKEY123",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trancheKey, err := cmd.Flags().GetString(FlagTrancheKey)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllUserFillRecordsRequest{
+				Address:    reqAddress,
+				TrancheKey: trancheKey,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.UserFillRecordsAll(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	cmd.Flags().AddFlagSet(FlagSetTrancheKey())
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdListUserPositionValues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-user-position-values [address] ?(--quote-denom)",
+		Short:   "list the current value and earned fees of a user's pool positions",
+		Example: "list-user-position-values alice --quote-denom tokenA",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			quoteDenom, err := cmd.Flags().GetString(FlagQuoteDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllUserPositionValuesRequest{
+				Address:    reqAddress,
+				QuoteDenom: quoteDenom,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.UserPositionValuesAll(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	cmd.Flags().AddFlagSet(FlagSetQuoteDenom())
+
+	return cmd
+}
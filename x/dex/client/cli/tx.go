@@ -29,6 +29,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdWithdrawFilledLimitOrder())
 	cmd.AddCommand(CmdCancelLimitOrder())
 	cmd.AddCommand(CmdMultiHopSwap())
+	cmd.AddCommand(CmdPlaceConditionalOrder())
+	cmd.AddCommand(CmdCancelConditionalOrder())
+	cmd.AddCommand(CmdSwapExactIn())
+	cmd.AddCommand(CmdBatchCancelLimitOrders())
+	cmd.AddCommand(CmdBatchWithdrawFilledLimitOrders())
+	cmd.AddCommand(CmdCancelAllLimitOrders())
+	cmd.AddCommand(CmdDepositRange())
+	cmd.AddCommand(CmdWithdrawRange())
+	cmd.AddCommand(CmdWithdrawPosition())
+	cmd.AddCommand(CmdSetPairCircuitBreaker())
+	cmd.AddCommand(CmdModifyLimitOrder())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
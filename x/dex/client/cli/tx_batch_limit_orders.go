@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdBatchCancelLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch-cancel-limit-orders [tranche-keys]",
+		Short:   "Broadcast message BatchCancelLimitOrders",
+		Example: "batch-cancel-limit-orders TRANCHEKEY123,TRANCHEKEY456 --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchCancelLimitOrders(
+				clientCtx.GetFromAddress().String(),
+				strings.Split(args[0], ","),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBatchWithdrawFilledLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch-withdraw-filled-limit-orders [tranche-keys]",
+		Short:   "Broadcast message BatchWithdrawFilledLimitOrders",
+		Example: "batch-withdraw-filled-limit-orders TRANCHEKEY123,TRANCHEKEY456 --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchWithdrawFilledLimitOrders(
+				clientCtx.GetFromAddress().String(),
+				strings.Split(args[0], ","),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelAllLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-all-limit-orders [token-a] [token-b]",
+		Short:   "Broadcast message CancelAllLimitOrders",
+		Example: "cancel-all-limit-orders tokenA tokenB --from alice",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAllLimitOrders(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -85,6 +85,11 @@ func CmdDeposit() *cobra.Command {
 				FeesUint = append(FeesUint, FeeInt)
 			}
 
+			useRecommendedFee, err := cmd.Flags().GetBool(FlagUseRecommendedFee)
+			if err != nil {
+				return err
+			}
+
 			for i, s := range argAutoswapOptions {
 				disableAutoswap, err := strconv.ParseBool(s)
 				if err != nil {
@@ -96,11 +101,29 @@ func CmdDeposit() *cobra.Command {
 				}
 
 				DepositOptions = append(DepositOptions, &types.DepositOptions{
-					DisableAutoswap: disableAutoswap,
-					FailTxOnBel:     failTx,
+					DisableAutoswap:   disableAutoswap,
+					FailTxOnBel:       failTx,
+					UseRecommendedFee: useRecommendedFee,
 				})
 			}
 
+			minSharesOutArg, err := cmd.Flags().GetString(FlagMinSharesOut)
+			if err != nil {
+				return err
+			}
+
+			var MinSharesOut []math.Int
+			if minSharesOutArg != "" {
+				for _, s := range strings.Split(minSharesOutArg, ",") {
+					minShares, ok := math.NewIntFromString(s)
+					if !ok {
+						return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for min-shares-out")
+					}
+
+					MinSharesOut = append(MinSharesOut, minShares)
+				}
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -117,12 +140,15 @@ func CmdDeposit() *cobra.Command {
 				FeesUint,
 				DepositOptions,
 			)
+			msg.MinSharesOut = MinSharesOut
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetMinSharesOut())
+	cmd.Flags().AddFlagSet(FlagSetUseRecommendedFee())
 
 	return cmd
 }
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdModifyLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modify-limit-order [tranche-key] ?(--reduce-amount-in) ?(--expiration-time)",
+		Short:   "Broadcast message ModifyLimitOrder which reduces a limit order or extends its expiration time",
+		Example: "modify-limit-order TRANCHEKEY123 --reduce-amount-in 10 --expiration-time '06/15/2030 15:00:00' --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reduceAmountInArg, err := cmd.Flags().GetString(FlagReduceAmountIn)
+			if err != nil {
+				return err
+			}
+
+			var reduceAmountIn *math.Int
+			if reduceAmountInArg != "" {
+				amount, ok := math.NewIntFromString(reduceAmountInArg)
+				if !ok {
+					return sdkerrors.Wrapf(
+						types.ErrIntOverflowTx,
+						"Integer overflow for reduce-amount-in",
+					)
+				}
+				reduceAmountIn = &amount
+			}
+
+			expirationTimeArg, err := cmd.Flags().GetString(FlagExpirationTime)
+			if err != nil {
+				return err
+			}
+
+			var expirationTime *time.Time
+			if expirationTimeArg != "" {
+				const timeFormat = "01/02/2006 15:04:05"
+				tm, err := time.Parse(timeFormat, expirationTimeArg)
+				if err != nil {
+					return sdkerrors.Wrapf(types.ErrInvalidTimeString, err.Error())
+				}
+				expirationTime = &tm
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgModifyLimitOrder(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				reduceAmountIn,
+				expirationTime,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetModifyLimitOrder())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
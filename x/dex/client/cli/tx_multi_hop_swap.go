@@ -19,7 +19,7 @@ var _ = strconv.Itoa(0)
 
 func CmdMultiHopSwap() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "multi-hop-swap [receiver] [routes] [amount-in] [exit-limit-price] [pick-best-route]",
+		Use:   "multi-hop-swap [receiver] [routes] [amount-in] [exit-limit-price] [pick-best-route] ?(--amount-out) ?(--self-trade-prevention)",
 		Short: "Broadcast message multiHopSwap",
 		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -49,6 +49,28 @@ func CmdMultiHopSwap() *cobra.Command {
 				return err
 			}
 
+			amountOutArg, err := cmd.Flags().GetString(FlagAmountOut)
+			if err != nil {
+				return err
+			}
+
+			var amountOutIntP *math.Int
+			if amountOutArg != "" {
+				amountOutInt, ok := math.NewIntFromString(amountOutArg)
+				if !ok {
+					return sdkerrors.Wrapf(
+						types.ErrIntOverflowTx,
+						"Integer overflow for amount-out",
+					)
+				}
+				amountOutIntP = &amountOutInt
+			}
+
+			selfTradePrevention, err := getSelfTradePrevention(cmd)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -62,12 +84,16 @@ func CmdMultiHopSwap() *cobra.Command {
 				exitLimitPriceDec,
 				pickBest,
 			)
+			msg.AmountOut = amountOutIntP
+			msg.SelfTradePrevention = selfTradePrevention
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetAmountOut())
+	cmd.Flags().AddFlagSet(FlagSetSelfTradePrevention())
 
 	return cmd
 }
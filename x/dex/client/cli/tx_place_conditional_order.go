@@ -0,0 +1,95 @@
+package cli
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdPlaceConditionalOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll
+		Use:     "place-conditional-order [receiver] [token-in] [token-out] [amount-in] [order-type] [limit-sell-price] [trigger] [trigger-price] ?(--max-amount-out)",
+		Short:   "Broadcast message PlaceConditionalOrder",
+		Example: "place-conditional-order alice tokenA tokenB 50 IMMEDIATE_OR_CANCEL 0.9 STOP_LOSS 0.95 --from alice",
+		Args:    cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiver := args[0]
+			argTokenIn := args[1]
+			argTokenOut := args[2]
+
+			amountInInt, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-in")
+			}
+
+			orderTypeInt, ok := types.LimitOrderType_value[args[4]]
+			if !ok {
+				return types.ErrInvalidOrderType
+			}
+
+			limitSellPrice, err := math_utils.NewPrecDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+
+			triggerInt, ok := types.ConditionalOrderTrigger_value[args[6]]
+			if !ok {
+				return types.ErrInvalidConditionalOrderTrigger
+			}
+
+			triggerPrice, err := math_utils.NewPrecDecFromStr(args[7])
+			if err != nil {
+				return err
+			}
+
+			maxAmountOutArg, err := cmd.Flags().GetString(FlagMaxAmountOut)
+			if err != nil {
+				return err
+			}
+
+			var maxAmountOutIntP *math.Int
+			if maxAmountOutArg != "" {
+				maxAmountOutInt, ok := math.NewIntFromString(maxAmountOutArg)
+				if !ok {
+					return sdkerrors.Wrapf(
+						types.ErrIntOverflowTx,
+						"Integer overflow for max-amount-out",
+					)
+				}
+				maxAmountOutIntP = &maxAmountOutInt
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceConditionalOrder(
+				clientCtx.GetFromAddress().String(),
+				argReceiver,
+				argTokenIn,
+				argTokenOut,
+				amountInInt,
+				types.LimitOrderType(orderTypeInt),
+				maxAmountOutIntP,
+				limitSellPrice,
+				types.ConditionalOrderTrigger(triggerInt),
+				triggerPrice,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetMaxAmountOut())
+
+	return cmd
+}
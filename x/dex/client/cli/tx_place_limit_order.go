@@ -19,7 +19,7 @@ import (
 func CmdPlaceLimitOrder() *cobra.Command {
 	cmd := &cobra.Command{
 		//nolint:lll
-		Use:     "place-limit-order [receiver] [token-in] [token-out] [tick-index] [amount-in] ?[order-type] ?[expirationTime] ?(--max-amout-out) ?(--price)",
+		Use:     "place-limit-order [receiver] [token-in] [token-out] [tick-index] [amount-in] ?[order-type] ?[expirationTime] ?(--max-amout-out) ?(--price) ?(--oracle-peg) ?(--oracle-offset-bps) ?(--self-trade-prevention)",
 		Short:   "Broadcast message PlaceLimitOrder",
 		Example: "place-limit-order alice tokenA tokenB [-10] tokenA 50 GOOD_TIL_TIME '01/02/2006 15:04:05' --max-amount-out 20 --from alice",
 		Args:    cobra.RangeArgs(5, 7),
@@ -93,6 +93,30 @@ func CmdPlaceLimitOrder() *cobra.Command {
 				priceDecP = &priceDec
 			}
 
+			oraclePegArg, err := cmd.Flags().GetString(FlagOraclePeg)
+			if err != nil {
+				return err
+			}
+
+			var oraclePeg *types.OraclePeg
+			if oraclePegArg != "" {
+				offsetBps, err := cmd.Flags().GetInt64(FlagOracleOffsetBps)
+				if err != nil {
+					return err
+				}
+				oraclePeg = &types.OraclePeg{CurrencyPair: oraclePegArg, OffsetBps: offsetBps}
+			}
+
+			selfTradePrevention, err := getSelfTradePrevention(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordFills, err := cmd.Flags().GetBool(FlagRecordFills)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -110,6 +134,9 @@ func CmdPlaceLimitOrder() *cobra.Command {
 				maxAmountOutIntP,
 				priceDecP,
 			)
+			msg.OraclePeg = oraclePeg
+			msg.SelfTradePrevention = selfTradePrevention
+			msg.RecordFills = recordFills
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -118,6 +145,26 @@ func CmdPlaceLimitOrder() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().AddFlagSet(FlagSetMaxAmountOut())
 	cmd.Flags().AddFlagSet(FlagSetPrice())
+	cmd.Flags().AddFlagSet(FlagSetOraclePeg())
+	cmd.Flags().AddFlagSet(FlagSetSelfTradePrevention())
+	cmd.Flags().AddFlagSet(FlagSetRecordFills())
 
 	return cmd
 }
+
+// getSelfTradePrevention parses the --self-trade-prevention flag, defaulting to ALLOW_SELF_TRADE if it is not set.
+func getSelfTradePrevention(cmd *cobra.Command) (types.SelfTradePrevention, error) {
+	selfTradeArg, err := cmd.Flags().GetString(FlagSelfTradePrevention)
+	if err != nil {
+		return types.SelfTradePrevention_ALLOW_SELF_TRADE, err
+	}
+	if selfTradeArg == "" {
+		return types.SelfTradePrevention_ALLOW_SELF_TRADE, nil
+	}
+
+	selfTradePreventionInt, ok := types.SelfTradePrevention_value[selfTradeArg]
+	if !ok {
+		return types.SelfTradePrevention_ALLOW_SELF_TRADE, types.ErrInvalidSelfTradePrevention
+	}
+	return types.SelfTradePrevention(selfTradePreventionInt), nil
+}
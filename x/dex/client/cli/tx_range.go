@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdDepositRange() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll
+		Use:     "deposit-range [receiver] [token-a] [token-b] [amount-a] [amount-b] [lower-price] [upper-price] [fee] [shape] ?[disable-autoswap] ?[fail-tx-on-BEL]",
+		Short:   "Broadcast message DepositRange which spreads a deposit across the pools between lower-price and upper-price",
+		Example: "deposit-range alice tokenA tokenB 100 50 0.9 1.1 1 NORMAL false false --from alice",
+		Args:    cobra.RangeArgs(9, 11),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiver := args[0]
+			argTokenA := args[1]
+			argTokenB := args[2]
+
+			amountA, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-a")
+			}
+
+			amountB, ok := math.NewIntFromString(args[4])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-b")
+			}
+
+			lowerPrice, err := math_utils.NewPrecDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+
+			upperPrice, err := math_utils.NewPrecDecFromStr(args[6])
+			if err != nil {
+				return err
+			}
+
+			fee, err := strconv.ParseUint(args[7], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			shapeInt, ok := types.LiquidityShape_value[args[8]]
+			if !ok {
+				return types.ErrInvalidLiquidityShape
+			}
+
+			options := &types.DepositOptions{}
+			if len(args) > 9 {
+				options.DisableAutoswap, err = strconv.ParseBool(args[9])
+				if err != nil {
+					return err
+				}
+			}
+			if len(args) > 10 {
+				options.FailTxOnBel, err = strconv.ParseBool(args[10])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositRange(
+				clientCtx.GetFromAddress().String(),
+				argReceiver,
+				argTokenA,
+				argTokenB,
+				amountA,
+				amountB,
+				lowerPrice,
+				upperPrice,
+				fee,
+				types.LiquidityShape(shapeInt),
+				options,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-range [receiver] [token-a] [token-b] [lower-price] [upper-price] [fee]",
+		Short:   "Broadcast message WithdrawRange which withdraws all shares from the pools between lower-price and upper-price",
+		Example: "withdraw-range alice tokenA tokenB 0.9 1.1 1 --from alice",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiver := args[0]
+			argTokenA := args[1]
+			argTokenB := args[2]
+
+			lowerPrice, err := math_utils.NewPrecDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			upperPrice, err := math_utils.NewPrecDecFromStr(args[4])
+			if err != nil {
+				return err
+			}
+
+			fee, err := strconv.ParseUint(args[5], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawRange(
+				clientCtx.GetFromAddress().String(),
+				argReceiver,
+				argTokenA,
+				argTokenB,
+				lowerPrice,
+				upperPrice,
+				fee,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
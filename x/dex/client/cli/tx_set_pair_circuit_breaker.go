@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdSetPairCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-pair-circuit-breaker [token-a] [token-b] [paused] [max-tick-deviation]",
+		Short:   "Broadcast message SetPairCircuitBreaker",
+		Example: "set-pair-circuit-breaker tokenA tokenB true 0 --from security",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			paused, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			maxTickDeviation, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPairCircuitBreaker(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				paused,
+				maxTickDeviation,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdSwapExactIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-exact-in [receiver] [token-in] [token-out] [amount-in] [exit-limit-price] ?[max-hops] ?(--self-trade-prevention)",
+		Short:   "Broadcast message swapExactIn which swaps along the best route found between token-in and token-out",
+		Example: "swap-exact-in alice tokenA tokenB 1000 0.9 2 --from alice",
+		Args:    cobra.RangeArgs(5, 6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiver := args[0]
+			argTokenIn := args[1]
+			argTokenOut := args[2]
+
+			amountInInt, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Invalid value for amount-in")
+			}
+
+			exitLimitPriceDec, err := math_utils.NewPrecDecFromStr(args[4])
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Invalid value for exit-limit-price")
+			}
+
+			var maxHops uint64
+			if len(args) == 6 {
+				maxHops, err = strconv.ParseUint(args[5], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			selfTradePrevention, err := getSelfTradePrevention(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapExactIn(
+				clientCtx.GetFromAddress().String(),
+				argReceiver,
+				argTokenIn,
+				argTokenOut,
+				amountInInt,
+				exitLimitPriceDec,
+				maxHops,
+			)
+			msg.SelfTradePrevention = selfTradePrevention
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetSelfTradePrevention())
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func CmdWithdrawPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-position [receiver] [token-a] [token-b] [percentage]",
+		Short:   "Broadcast message WithdrawPosition which withdraws a percentage of all positions in a pair",
+		Example: "withdraw-position alice tokenA tokenB 100 --from alice",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiver := args[0]
+			argTokenA := args[1]
+			argTokenB := args[2]
+
+			percentage, err := math_utils.NewPrecDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawPosition(
+				clientCtx.GetFromAddress().String(),
+				argReceiver,
+				argTokenA,
+				argTokenB,
+				percentage,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
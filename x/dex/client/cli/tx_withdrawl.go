@@ -64,6 +64,16 @@ func CmdWithdrawal() *cobra.Command {
 				FeesUint = append(FeesUint, feeInt)
 			}
 
+			minAmount0Out, err := getOptionalIntFlag(cmd, FlagMinAmount0Out)
+			if err != nil {
+				return err
+			}
+
+			minAmount1Out, err := getOptionalIntFlag(cmd, FlagMinAmount1Out)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -78,12 +88,33 @@ func CmdWithdrawal() *cobra.Command {
 				TicksIndexesInt,
 				FeesUint,
 			)
+			msg.MinAmount0Out = minAmount0Out
+			msg.MinAmount1Out = minAmount1Out
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetMinAmountsOut())
 
 	return cmd
 }
+
+func getOptionalIntFlag(cmd *cobra.Command, flag string) (*math.Int, error) {
+	arg, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg == "" {
+		return nil, nil
+	}
+
+	amount, ok := math.NewIntFromString(arg)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for %s", flag)
+	}
+
+	return &amount, nil
+}
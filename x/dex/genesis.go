@@ -19,6 +19,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		case *types.TickLiquidity_LimitOrderTranche:
 			tranche := elem.GetLimitOrderTranche()
 			k.SetLimitOrderTranche(ctx, tranche)
+			k.IncPairRefCount(ctx, tranche.Key.TradePairId.MustPairID())
 			if tranche.HasExpiration() {
 				// re-create expiration record
 				loExpiration := keeper.NewLimitOrderExpiration(tranche)
@@ -29,21 +30,65 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the inactiveLimitOrderTranche
 	for _, elem := range genState.InactiveLimitOrderTrancheList {
 		k.SetInactiveLimitOrderTranche(ctx, elem)
+		k.IncPairRefCount(ctx, elem.Key.TradePairId.MustPairID())
 	}
 
 	// Set all the LimitOrderTrancheUser
 	for _, elem := range genState.LimitOrderTrancheUserList {
 		k.SetLimitOrderTrancheUser(ctx, elem)
+		if elem.RecordFills {
+			k.SetFillRecordSubscriber(ctx, elem.TrancheKey, elem.Address)
+		}
 	}
 	// Set all the poolMetadata
 	for _, elem := range genState.PoolMetadataList {
 		k.SetPoolMetadata(ctx, elem)
 		// Store PoolID reference
 		k.StorePoolIDRef(ctx, elem.Id, elem.PairId, elem.Tick, elem.Fee)
+		k.SetPoolByDenom(ctx, elem.Id, elem.PairId)
+		k.IncPairRefCount(ctx, elem.PairId)
 	}
 
 	// Set poolMetadata count
 	k.SetPoolCount(ctx, genState.PoolCount)
+
+	// Set all the conditionalOrders
+	for _, elem := range genState.ConditionalOrderList {
+		k.SetConditionalOrder(ctx, elem)
+	}
+
+	// Set conditionalOrder count
+	k.SetConditionalOrderCount(ctx, genState.ConditionalOrderCount)
+
+	// Set all the twapRecords
+	for _, elem := range genState.TwapRecordList {
+		k.SetTwapRecord(ctx, elem)
+	}
+
+	// Set all the protocolFees
+	for _, elem := range genState.ProtocolFeeList {
+		k.SetProtocolFee(ctx, elem)
+	}
+
+	// Set all the pairCircuitBreakers
+	for _, elem := range genState.PairCircuitBreakerList {
+		k.SetPairCircuitBreaker(ctx, elem)
+	}
+
+	// Set all the peggedLimitOrders
+	for _, elem := range genState.PeggedLimitOrderList {
+		k.SetPeggedLimitOrder(ctx, elem)
+	}
+
+	// Set all the fillRecords
+	for _, elem := range genState.FillRecordList {
+		k.SetFillRecord(ctx, elem)
+	}
+
+	// Set all the depositCostBases
+	for _, elem := range genState.DepositCostBasisList {
+		k.SetDepositCostBasis(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	err := k.SetParams(ctx, genState.Params)
 	if err != nil {
@@ -60,6 +105,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.InactiveLimitOrderTrancheList = k.GetAllInactiveLimitOrderTranche(ctx)
 	genesis.PoolMetadataList = k.GetAllPoolMetadata(ctx)
 	genesis.PoolCount = k.GetPoolCount(ctx)
+	genesis.ConditionalOrderList = k.GetAllConditionalOrder(ctx)
+	genesis.ConditionalOrderCount = k.GetConditionalOrderCount(ctx)
+	genesis.TwapRecordList = k.GetAllTwapRecord(ctx)
+	genesis.ProtocolFeeList = k.GetAllProtocolFee(ctx)
+	genesis.PairCircuitBreakerList = k.GetAllPairCircuitBreaker(ctx)
+	genesis.PeggedLimitOrderList = k.GetAllPeggedLimitOrder(ctx)
+	genesis.FillRecordList = k.GetAllFillRecord(ctx)
+	genesis.DepositCostBasisList = k.GetAllDepositCostBasis(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -9,6 +9,7 @@ import (
 
 	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
 	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
 	"github.com/neutron-org/neutron/v4/x/dex"
 	"github.com/neutron-org/neutron/v4/x/dex/types"
 )
@@ -150,6 +151,111 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		PoolCount: 2,
+		ConditionalOrderList: []types.ConditionalOrder{
+			{
+				Id:          0,
+				Creator:     "creator",
+				Receiver:    "receiver",
+				TradePairId: &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+				AmountIn:    math.OneInt(),
+				Trigger:     types.ConditionalOrderTrigger_STOP_LOSS,
+			},
+			{
+				Id:               1,
+				Creator:          "creator",
+				Receiver:         "receiver",
+				TradePairId:      &types.TradePairID{TakerDenom: "TokenB", MakerDenom: "TokenA"},
+				AmountIn:         math.OneInt(),
+				Trigger:          types.ConditionalOrderTrigger_TAKE_PROFIT,
+				TriggerTickIndex: 5,
+			},
+		},
+		ConditionalOrderCount: 2,
+		TwapRecordList: []types.TwapRecord{
+			{
+				TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+				Time:                  time.Unix(0, 0).UTC(),
+				Price:                 math_utils.OnePrecDec(),
+				ArithmeticAccumulator: math_utils.ZeroPrecDec(),
+				TickAccumulator:       math.ZeroInt(),
+			},
+			{
+				TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+				Time:                  time.Unix(10, 0).UTC(),
+				Price:                 math_utils.OnePrecDec(),
+				ArithmeticAccumulator: math_utils.NewPrecDec(10_000),
+				TickAccumulator:       math.ZeroInt(),
+			},
+		},
+		ProtocolFeeList: []types.ProtocolFee{
+			{
+				Denom:   "TokenA",
+				Accrued: math.NewInt(10),
+				Pending: math.NewInt(5),
+			},
+			{
+				Denom:   "TokenB",
+				Accrued: math.NewInt(10),
+				Pending: math.ZeroInt(),
+			},
+		},
+		PairCircuitBreakerList: []types.PairCircuitBreaker{
+			{
+				PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"},
+				Paused: true,
+			},
+			{
+				PairId:           &types.PairID{Token0: "TokenA", Token1: "TokenC"},
+				MaxTickDeviation: 100,
+			},
+		},
+		PeggedLimitOrderList: []types.PeggedLimitOrder{
+			{
+				Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+				TrancheKey: &types.LimitOrderTrancheKey{
+					TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+					TickIndexTakerToMaker: 0,
+					TrancheKey:            "0",
+				},
+				OraclePeg: &types.OraclePeg{CurrencyPair: "TOKENB/TOKENA", OffsetBps: 10},
+			},
+		},
+		FillRecordList: []types.FillRecord{
+			{
+				Address:          "fakeAddr",
+				TrancheKey:       "0",
+				TradePairId:      &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+				Height:           10,
+				Time:             time.Unix(100, 0).UTC(),
+				AmountTakerDenom: math.NewInt(5),
+				AmountMakerDenom: math.NewInt(6),
+			},
+			{
+				Address:          "fakeAddr",
+				TrancheKey:       "0",
+				TradePairId:      &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+				Height:           11,
+				Time:             time.Unix(106, 0).UTC(),
+				AmountTakerDenom: math.NewInt(1),
+				AmountMakerDenom: math.NewInt(1),
+			},
+		},
+		DepositCostBasisList: []types.DepositCostBasis{
+			{
+				Address: "fakeAddr",
+				PoolId:  0,
+				Shares:  math.NewInt(10),
+				Amount0: math.NewInt(5),
+				Amount1: math.NewInt(5),
+			},
+			{
+				Address: "fakeAddr",
+				PoolId:  1,
+				Shares:  math.NewInt(20),
+				Amount0: math.NewInt(20),
+				Amount1: math.ZeroInt(),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -179,6 +285,17 @@ func TestGenesis(t *testing.T) {
 	_, found := k.GetPool(ctx, types.MustNewPairID("TokenA", "TokenB"), 0, 1)
 	require.True(t, found)
 
+	// Check that the pair and pool indexes by denom are rebuilt
+
+	pairsByDenom, err := k.PairsByDenom(ctx, &types.QueryPairsByDenomRequest{Denom: "TokenA"})
+	require.NoError(t, err)
+	require.Equal(t, []*types.PairID{types.MustNewPairID("TokenA", "TokenB")}, pairsByDenom.PairIds)
+	require.Equal(t, uint64(8), k.GetPairRefCount(ctx, types.MustNewPairID("TokenA", "TokenB")))
+
+	poolsByDenom, err := k.PoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "TokenB"})
+	require.NoError(t, err)
+	require.Len(t, poolsByDenom.Pools, 2)
+
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
@@ -191,5 +308,13 @@ func TestGenesis(t *testing.T) {
 	)
 	require.ElementsMatch(t, genesisState.PoolMetadataList, got.PoolMetadataList)
 	require.Equal(t, genesisState.PoolCount, got.PoolCount)
+	require.ElementsMatch(t, genesisState.ConditionalOrderList, got.ConditionalOrderList)
+	require.Equal(t, genesisState.ConditionalOrderCount, got.ConditionalOrderCount)
+	require.ElementsMatch(t, genesisState.TwapRecordList, got.TwapRecordList)
+	require.ElementsMatch(t, genesisState.ProtocolFeeList, got.ProtocolFeeList)
+	require.ElementsMatch(t, genesisState.PairCircuitBreakerList, got.PairCircuitBreakerList)
+	require.ElementsMatch(t, genesisState.PeggedLimitOrderList, got.PeggedLimitOrderList)
+	require.ElementsMatch(t, genesisState.FillRecordList, got.FillRecordList)
+	require.ElementsMatch(t, genesisState.DepositCostBasisList, got.DepositCostBasisList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// SetConditionalOrder set a specific conditionalOrder in the store and index it by its trigger
+func (k Keeper) SetConditionalOrder(ctx sdk.Context, order types.ConditionalOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConditionalOrderKeyPrefix))
+	b := k.cdc.MustMarshal(&order)
+	store.Set(GetConditionalOrderIDBytes(order.Id), b)
+
+	ctx.KVStore(k.storeKey).Set(order.TriggerKey(), GetConditionalOrderIDBytes(order.Id))
+}
+
+// GetConditionalOrder returns a conditionalOrder from its id
+func (k Keeper) GetConditionalOrder(ctx sdk.Context, id uint64) (val types.ConditionalOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConditionalOrderKeyPrefix))
+	b := store.Get(GetConditionalOrderIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveConditionalOrder removes a conditionalOrder and its trigger index from the store
+func (k Keeper) RemoveConditionalOrder(ctx sdk.Context, order types.ConditionalOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConditionalOrderKeyPrefix))
+	store.Delete(GetConditionalOrderIDBytes(order.Id))
+
+	ctx.KVStore(k.storeKey).Delete(order.TriggerKey())
+}
+
+// GetAllConditionalOrder returns all conditionalOrders
+func (k Keeper) GetAllConditionalOrder(ctx sdk.Context) (list []types.ConditionalOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConditionalOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ConditionalOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetConditionalOrderCount get the total number of conditionalOrders ever placed
+func (k Keeper) GetConditionalOrderCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.ConditionalOrderCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetConditionalOrderCount set the total number of conditionalOrders ever placed
+func (k Keeper) SetConditionalOrderCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.ConditionalOrderCountKey), GetConditionalOrderIDBytes(count))
+}
+
+func (k Keeper) incrementConditionalOrderCount(ctx sdk.Context) {
+	currentCount := k.GetConditionalOrderCount(ctx)
+	k.SetConditionalOrderCount(ctx, currentCount+1)
+}
+
+// GetConditionalOrderIDBytes returns the byte representation of the ID
+func GetConditionalOrderIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// refundConditionalOrder removes the order and returns the escrowed funds to its creator
+func (k Keeper) refundConditionalOrder(ctx sdk.Context, order types.ConditionalOrder) error {
+	k.RemoveConditionalOrder(ctx, order)
+
+	creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+	coinIn := sdk.NewCoin(order.TradePairId.TakerDenom, order.AmountIn)
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.Coins{coinIn})
+}
+
+// executeConditionalOrder converts a triggered conditional order into a taker limit order.
+// The escrowed funds are first handed back to the creator so that the order is placed exactly as if the
+// creator had submitted the limit order themselves. Any portion not used by an IOC order stays with the creator.
+func (k Keeper) executeConditionalOrder(
+	ctx sdk.Context,
+	order types.ConditionalOrder,
+) (swapInCoin, swapOutCoin sdk.Coin, err error) {
+	if err := k.refundConditionalOrder(ctx, order); err != nil {
+		return swapInCoin, swapOutCoin, err
+	}
+
+	creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(order.Receiver)
+
+	_, _, swapInCoin, swapOutCoin, err = k.PlaceLimitOrderCore(
+		ctx,
+		order.TradePairId.TakerDenom,
+		order.TradePairId.MakerDenom,
+		order.AmountIn,
+		order.LimitTickIndexInToOut,
+		order.OrderType,
+		nil,
+		order.MaxAmountOut,
+		nil,
+		types.SelfTradePrevention_ALLOW_SELF_TRADE,
+		false,
+		creatorAddr,
+		receiverAddr,
+	)
+
+	return swapInCoin, swapOutCoin, err
+}
+
+// getTriggeredConditionalOrderIDs walks the trigger index and returns the IDs of all orders whose trigger has
+// been crossed by the current price of their TradePairID. Since the index is sorted by trigger tick for each
+// (TradePairID, trigger) group, only triggered orders plus one lookup per group are read.
+func (k Keeper) getTriggeredConditionalOrderIDs(ctx sdk.Context, gasCutoff uint64) (ids []uint64, hitLimit bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := types.KeyPrefix(types.ConditionalOrderTriggerKeyPrefix)
+	end := storetypes.PrefixEndBytes(cursor)
+
+	for {
+		if ctx.GasMeter().GasConsumed() >= gasCutoff {
+			return ids, true
+		}
+
+		groupIter := store.Iterator(cursor, end)
+		if !groupIter.Valid() {
+			groupIter.Close()
+			return ids, false
+		}
+		order, found := k.GetConditionalOrder(ctx, binary.BigEndian.Uint64(groupIter.Value()))
+		groupIter.Close()
+		if !found {
+			panic("Conditional order trigger index references missing order")
+		}
+
+		groupPrefix := types.ConditionalOrderTriggerPrefix(order.TradePairId, order.Trigger)
+		groupEnd := storetypes.PrefixEndBytes(groupPrefix)
+		cursor = groupEnd
+
+		currTick, found := k.GetCurrTickIndexTakerToMaker(ctx, order.TradePairId)
+		if !found {
+			continue
+		}
+
+		var triggeredStart, triggeredEnd []byte
+		switch order.Trigger {
+		case types.ConditionalOrderTrigger_STOP_LOSS:
+			// triggered orders have triggerTick <= currTick
+			triggeredStart = groupPrefix
+			triggeredEnd = append(append([]byte{}, groupPrefix...), types.TickIndexToBytes(currTick+1)...)
+		case types.ConditionalOrderTrigger_TAKE_PROFIT:
+			// triggered orders have triggerTick >= currTick
+			triggeredStart = append(append([]byte{}, groupPrefix...), types.TickIndexToBytes(currTick)...)
+			triggeredEnd = groupEnd
+		}
+
+		iterator := store.Iterator(triggeredStart, triggeredEnd)
+		for ; iterator.Valid(); iterator.Next() {
+			if ctx.GasMeter().GasConsumed() >= gasCutoff {
+				iterator.Close()
+				return ids, true
+			}
+			ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+		}
+		iterator.Close()
+	}
+}
+
+// ExecuteTriggeredConditionalOrders executes all conditional orders whose trigger has been crossed. Orders are
+// removed once executed. If execution fails (ie. a FILL_OR_KILL order cannot be filled) the escrowed funds are
+// returned to the creator. Work is bounded by the ConditionalOrderAllowance param; any triggered orders that do not
+// fit in the budget will be picked up in the following block.
+func (k Keeper) ExecuteTriggeredConditionalOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Paused {
+		return
+	}
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + params.ConditionalOrderAllowance
+	ids, hitLimit := k.getTriggeredConditionalOrderIDs(ctx, gasCutoff)
+
+	for _, id := range ids {
+		if ctx.GasMeter().GasConsumed() >= gasCutoff {
+			hitLimit = true
+			break
+		}
+
+		order, found := k.GetConditionalOrder(ctx, id)
+		if !found {
+			continue
+		}
+
+		// Orders on paused pairs stay dormant until the pair is unpaused rather than being refunded
+		if k.IsPairPaused(ctx, order.TradePairId.MustPairID()) {
+			continue
+		}
+
+		// Orders executed earlier in this block may have moved the price back across the trigger
+		currTick, found := k.GetCurrTickIndexTakerToMaker(ctx, order.TradePairId)
+		if !found || !order.IsTriggered(currTick) {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		swapInCoin, swapOutCoin, execErr := k.executeConditionalOrder(cacheCtx, order)
+		if execErr != nil {
+			cacheCtx, writeCache = ctx.CacheContext()
+			if err := k.refundConditionalOrder(cacheCtx, order); err != nil {
+				ctx.Logger().Error("failed to refund conditional order", "id", order.Id, "error", err)
+				continue
+			}
+			swapInCoin = sdk.NewCoin(order.TradePairId.TakerDenom, math.ZeroInt())
+			swapOutCoin = sdk.NewCoin(order.TradePairId.MakerDenom, math.ZeroInt())
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvent(types.ExecuteConditionalOrderEvent(order, swapInCoin.Amount, swapOutCoin.Amount, execErr))
+	}
+
+	if hitLimit {
+		ctx.EventManager().EmitEvent(types.ConditionalOrderHitLimitEvent(ctx.GasMeter().GasConsumed()))
+	}
+}
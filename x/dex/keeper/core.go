@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/neutron-org/neutron/v4/utils"
@@ -19,6 +21,16 @@ import (
 // This may create some accounting anomalies but seems preferable to other alternatives.
 // See full ADR here: https://www.notion.so/dualityxyz/A-Modest-Proposal-For-Truncating-696a919d59254876a617f82fb9567895
 
+// poolDeposit records the result of a single successful deposit within DepositCore
+type poolDeposit struct {
+	// index of the deposit in the DepositCore arguments
+	idx                        int
+	pool                       *types.Pool
+	amount0, amount1           math.Int
+	autoswapped0, autoswapped1 math.Int
+	shares                     sdk.Coin
+}
+
 // Handles core logic for MsgDeposit, checking and initializing data structures (tick, pair), calculating
 // shares based on amount deposited, and sending funds to moduleAddress.
 func (k Keeper) DepositCore(
@@ -31,14 +43,55 @@ func (k Keeper) DepositCore(
 	tickIndices []int64,
 	fees []uint64,
 	options []*types.DepositOptions,
+	minSharesOut []math.Int,
 ) (amounts0Deposit, amounts1Deposit []math.Int, sharesIssued sdk.Coins, failedDeposits []*types.FailedDeposit, err error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	amounts0Deposit, amounts1Deposit, sharesIssued, _, failedDeposits, err = k.depositCore(
+		sdk.UnwrapSDKContext(goCtx),
+		pairID,
+		callerAddr,
+		receiverAddr,
+		amounts0,
+		amounts1,
+		tickIndices,
+		fees,
+		options,
+		minSharesOut,
+	)
+
+	return amounts0Deposit, amounts1Deposit, sharesIssued, failedDeposits, err
+}
+
+// depositCore implements DepositCore and additionally returns the result of each successful deposit in order
+func (k Keeper) depositCore(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+	amounts0 []math.Int,
+	amounts1 []math.Int,
+	tickIndices []int64,
+	fees []uint64,
+	options []*types.DepositOptions,
+	minSharesOut []math.Int,
+) (
+	amounts0Deposit, amounts1Deposit []math.Int,
+	sharesIssued sdk.Coins,
+	deposits []poolDeposit,
+	failedDeposits []*types.FailedDeposit,
+	err error,
+) {
+	if err := k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
 
+	minDepositSizes := k.GetParams(ctx).MinDepositSizes
 	totalAmountReserve0 := math.ZeroInt()
 	totalAmountReserve1 := math.ZeroInt()
 	amounts0Deposited := make([]math.Int, len(amounts0))
 	amounts1Deposited := make([]math.Int, len(amounts1))
 	sharesIssued = sdk.Coins{}
+	// deposits are collected so that hooks are only called once the shares have been minted
+	deposits = make([]poolDeposit, 0, len(amounts0))
 
 	for i := 0; i < len(amounts0); i++ {
 		amounts0Deposited[i] = math.ZeroInt()
@@ -48,22 +101,22 @@ func (k Keeper) DepositCore(
 	for i, amount0 := range amounts0 {
 		amount1 := amounts1[i]
 		tickIndex := tickIndices[i]
-		fee := fees[i]
 		option := options[i]
 		if option == nil {
 			option = &types.DepositOptions{}
 		}
 		autoswap := !option.DisableAutoswap
+		fee := k.depositFee(ctx, pairID, fees[i], option)
 
 		if err := k.ValidateFee(ctx, fee); err != nil {
-			return nil, nil, nil, failedDeposits, err
+			return nil, nil, nil, nil, failedDeposits, err
 		}
 
 		if k.IsPoolBehindEnemyLines(ctx, pairID, tickIndex, fee, amount0, amount1) {
 			err = sdkerrors.Wrapf(types.ErrDepositBehindEnemyLines,
 				"deposit failed at tick %d fee %d", tickIndex, fee)
 			if option.FailTxOnBel {
-				return nil, nil, nil, failedDeposits, err
+				return nil, nil, nil, nil, failedDeposits, err
 			}
 			failedDeposits = append(failedDeposits, &types.FailedDeposit{DepositIdx: uint64(i), Error: err.Error()})
 			continue
@@ -76,24 +129,46 @@ func (k Keeper) DepositCore(
 			fee,
 		)
 		if err != nil {
-			return nil, nil, nil, failedDeposits, err
+			return nil, nil, nil, nil, failedDeposits, err
 		}
 
 		existingShares := k.bankKeeper.GetSupply(ctx, pool.GetPoolDenom()).Amount
 
-		inAmount0, inAmount1, outShares := pool.Deposit(amount0, amount1, existingShares, autoswap)
+		inAmount0, inAmount1, autoswapped0, autoswapped1, outShares := pool.Deposit(amount0, amount1, existingShares, autoswap)
 
 		k.SetPool(ctx, pool)
 
 		if inAmount0.IsZero() && inAmount1.IsZero() {
-			return nil, nil, nil, failedDeposits, types.ErrZeroTrueDeposit
+			return nil, nil, nil, nil, failedDeposits, types.ErrZeroTrueDeposit
 		}
 
 		if outShares.IsZero() {
-			return nil, nil, nil, failedDeposits, types.ErrDepositShareUnderflow
+			return nil, nil, nil, nil, failedDeposits, types.ErrDepositShareUnderflow
+		}
+
+		for _, coinIn := range []sdk.Coin{sdk.NewCoin(pairID.Token0, inAmount0), sdk.NewCoin(pairID.Token1, inAmount1)} {
+			if err := assertMinSize(minDepositSizes, coinIn, types.ErrDepositBelowMinSize); err != nil {
+				return nil, nil, nil, nil, failedDeposits, sdkerrors.Wrapf(err, "deposit at tick %d fee %d", tickIndex, fee)
+			}
+		}
+
+		// protects against the pool's reserves being moved (ie. front-running an autoswap) before the deposit
+		if len(minSharesOut) != 0 && outShares.Amount.LT(minSharesOut[i]) {
+			return nil, nil, nil, nil, failedDeposits, sdkerrors.Wrapf(types.ErrDepositSharesBelowMin,
+				"deposit at tick %d fee %d issued %s shares, expected at least %s", tickIndex, fee, outShares.Amount, minSharesOut[i])
 		}
 
 		sharesIssued = append(sharesIssued, outShares)
+		k.AddDepositCostBasis(ctx, receiverAddr.String(), pool.Id, inAmount0, inAmount1, outShares.Amount)
+		deposits = append(deposits, poolDeposit{
+			idx:          i,
+			pool:         pool,
+			amount0:      inAmount0,
+			amount1:      inAmount1,
+			autoswapped0: autoswapped0,
+			autoswapped1: autoswapped1,
+			shares:       outShares,
+		})
 
 		amounts0Deposited[i] = inAmount0
 		amounts1Deposited[i] = inAmount1
@@ -111,6 +186,17 @@ func (k Keeper) DepositCore(
 			inAmount1,
 			outShares.Amount,
 		))
+		k.emitTypedEvent(ctx, &types.EventDeposit{
+			Creator:            callerAddr.String(),
+			Receiver:           receiverAddr.String(),
+			Token0:             pairID.Token0,
+			Token1:             pairID.Token1,
+			TickIndex:          tickIndex,
+			Fee:                fee,
+			Reserves0Deposited: inAmount0,
+			Reserves1Deposited: inAmount1,
+			SharesMinted:       outShares.Amount,
+		})
 	}
 
 	// At this point shares issued is not sorted and may have duplicates
@@ -120,22 +206,28 @@ func (k Keeper) DepositCore(
 	if totalAmountReserve0.IsPositive() {
 		coin0 := sdk.NewCoin(pairID.Token0, totalAmountReserve0)
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coin0}); err != nil {
-			return nil, nil, nil, failedDeposits, err
+			return nil, nil, nil, nil, failedDeposits, err
 		}
 	}
 
 	if totalAmountReserve1.IsPositive() {
 		coin1 := sdk.NewCoin(pairID.Token1, totalAmountReserve1)
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coin1}); err != nil {
-			return nil, nil, nil, failedDeposits, err
+			return nil, nil, nil, nil, failedDeposits, err
 		}
 	}
 
 	if err := k.MintShares(ctx, receiverAddr, sharesIssued); err != nil {
-		return nil, nil, nil, failedDeposits, err
+		return nil, nil, nil, nil, failedDeposits, err
+	}
+
+	for _, d := range deposits {
+		if err := k.Hooks().AfterDeposit(ctx, receiverAddr, d.pool, d.amount0, d.amount1, d.shares); err != nil {
+			return nil, nil, nil, nil, failedDeposits, err
+		}
 	}
 
-	return amounts0Deposited, amounts1Deposited, sharesIssued, failedDeposits, nil
+	return amounts0Deposited, amounts1Deposited, sharesIssued, deposits, failedDeposits, nil
 }
 
 // Handles core logic for MsgWithdrawal; calculating and withdrawing reserve0,reserve1 from a specified tick
@@ -150,8 +242,14 @@ func (k Keeper) WithdrawCore(
 	sharesToRemoveList []math.Int,
 	tickIndicesNormalized []int64,
 	fees []uint64,
-) error {
+	minAmount0Out *math.Int,
+	minAmount1Out *math.Int,
+) (reserve0Removed, reserve1Removed math.Int, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+
 	totalReserve0ToRemove := math.ZeroInt()
 	totalReserve1ToRemove := math.ZeroInt()
 
@@ -161,14 +259,14 @@ func (k Keeper) WithdrawCore(
 
 		pool, err := k.GetOrInitPool(ctx, pairID, tickIndex, fee)
 		if err != nil {
-			return err
+			return math.ZeroInt(), math.ZeroInt(), err
 		}
 
 		poolDenom := pool.GetPoolDenom()
 
 		totalShares := k.bankKeeper.GetSupply(ctx, poolDenom).Amount
 		if totalShares.LT(sharesToRemove) {
-			return sdkerrors.Wrapf(
+			return math.ZeroInt(), math.ZeroInt(), sdkerrors.Wrapf(
 				types.ErrInsufficientShares,
 				"%s does not have %s shares of type %s",
 				callerAddr,
@@ -182,8 +280,9 @@ func (k Keeper) WithdrawCore(
 
 		if sharesToRemove.IsPositive() {
 			if err := k.BurnShares(ctx, callerAddr, sharesToRemove, poolDenom); err != nil {
-				return err
+				return math.ZeroInt(), math.ZeroInt(), err
 			}
+			k.ReduceDepositCostBasis(ctx, callerAddr.String(), pool.Id, sharesToRemove)
 		}
 
 		totalReserve0ToRemove = totalReserve0ToRemove.Add(outAmount0)
@@ -200,6 +299,32 @@ func (k Keeper) WithdrawCore(
 			outAmount1,
 			sharesToRemove,
 		))
+		k.emitTypedEvent(ctx, &types.EventWithdraw{
+			Creator:            callerAddr.String(),
+			Receiver:           receiverAddr.String(),
+			Token0:             pairID.Token0,
+			Token1:             pairID.Token1,
+			TickIndex:          tickIndex,
+			Fee:                fee,
+			Reserves0Withdrawn: outAmount0,
+			Reserves1Withdrawn: outAmount1,
+			SharesRemoved:      sharesToRemove,
+		})
+
+		sharesRemoved := sdk.NewCoin(poolDenom, sharesToRemove)
+		if err := k.Hooks().AfterWithdraw(ctx, callerAddr, pool, outAmount0, outAmount1, sharesRemoved); err != nil {
+			return math.ZeroInt(), math.ZeroInt(), err
+		}
+	}
+
+	if minAmount0Out != nil && totalReserve0ToRemove.LT(*minAmount0Out) {
+		return math.ZeroInt(), math.ZeroInt(), sdkerrors.Wrapf(types.ErrWithdrawAmountBelowMin,
+			"withdrew %s%s, expected at least %s", totalReserve0ToRemove, pairID.Token0, minAmount0Out)
+	}
+
+	if minAmount1Out != nil && totalReserve1ToRemove.LT(*minAmount1Out) {
+		return math.ZeroInt(), math.ZeroInt(), sdkerrors.Wrapf(types.ErrWithdrawAmountBelowMin,
+			"withdrew %s%s, expected at least %s", totalReserve1ToRemove, pairID.Token1, minAmount1Out)
 	}
 
 	if totalReserve0ToRemove.IsPositive() {
@@ -213,7 +338,7 @@ func (k Keeper) WithdrawCore(
 		)
 		ctx.EventManager().EmitEvents(types.GetEventsWithdrawnAmount(sdk.Coins{coin0}))
 		if err != nil {
-			return err
+			return math.ZeroInt(), math.ZeroInt(), err
 		}
 	}
 
@@ -228,11 +353,11 @@ func (k Keeper) WithdrawCore(
 		)
 		ctx.EventManager().EmitEvents(types.GetEventsWithdrawnAmount(sdk.Coins{coin1}))
 		if err != nil {
-			return err
+			return math.ZeroInt(), math.ZeroInt(), err
 		}
 	}
 
-	return nil
+	return totalReserve0ToRemove, totalReserve1ToRemove, nil
 }
 
 func (k Keeper) MultiHopSwapCore(
@@ -243,6 +368,7 @@ func (k Keeper) MultiHopSwapCore(
 	pickBestRoute bool,
 	callerAddr sdk.AccAddress,
 	receiverAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
 ) (coinOut sdk.Coin, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	var routeErrors []error
@@ -263,6 +389,8 @@ func (k Keeper) MultiHopSwapCore(
 			initialInCoin,
 			exitLimitPrice,
 			stepCache,
+			callerAddr,
+			selfTradePrevention,
 		)
 		if err != nil {
 			routeErrors = append(routeErrors, err)
@@ -321,10 +449,129 @@ func (k Keeper) MultiHopSwapCore(
 		bestRoute.route,
 		bestRoute.dust,
 	))
+	k.emitTypedEvent(ctx, &types.EventSwap{
+		Creator:   callerAddr.String(),
+		Receiver:  receiverAddr.String(),
+		TokenIn:   initialInCoin.Denom,
+		TokenOut:  bestRoute.coinOut.Denom,
+		AmountIn:  initialInCoin.Amount,
+		AmountOut: bestRoute.coinOut.Amount,
+		Route:     bestRoute.route,
+		Dust:      bestRoute.dust,
+	})
+
+	if err := k.Hooks().AfterSwap(ctx, callerAddr, initialInCoin, bestRoute.coinOut); err != nil {
+		return sdk.Coin{}, err
+	}
 
 	return bestRoute.coinOut, nil
 }
 
+// MultiHopSwapExactAmountOutCore handles logic for MsgMultihopSwap when an exact AmountOut is requested. Each route is
+// worked backwards from the exit token to find the amount of the entry token required. If pickBestRoute is set the
+// route requiring the least input is used, otherwise the first successful route is used.
+func (k Keeper) MultiHopSwapExactAmountOutCore(
+	goCtx context.Context,
+	amountOut math.Int,
+	maxAmountIn math.Int,
+	routes []*types.MultiHopRoute,
+	exitLimitPrice math_utils.PrecDec,
+	pickBestRoute bool,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
+) (coinIn, coinOut sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var routeErrors []error
+	exitHops := routes[0].Hops
+	exitCoin := sdk.NewCoin(exitHops[len(exitHops)-1], amountOut)
+	var bestRoute struct {
+		write  func()
+		coinIn sdk.Coin
+		route  []string
+	}
+
+	for _, route := range routes {
+		routeCoinIn, writeRoute, err := k.RunMultihopRouteExactAmountOut(
+			ctx,
+			*route,
+			exitCoin,
+			maxAmountIn,
+			exitLimitPrice,
+			callerAddr,
+			selfTradePrevention,
+		)
+		if err != nil {
+			routeErrors = append(routeErrors, err)
+			continue
+		}
+
+		if bestRoute.write == nil || routeCoinIn.Amount.LT(bestRoute.coinIn.Amount) {
+			bestRoute.coinIn = routeCoinIn
+			bestRoute.write = writeRoute
+			bestRoute.route = route.Hops
+		}
+		if !pickBestRoute {
+			break
+		}
+	}
+
+	if bestRoute.write == nil {
+		// All routes have failed
+
+		allErr := errors.Join(append([]error{types.ErrAllMultiHopRoutesFailed}, routeErrors...)...)
+
+		return sdk.Coin{}, sdk.Coin{}, allErr
+	}
+
+	bestRoute.write()
+	err = k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		callerAddr,
+		types.ModuleName,
+		sdk.Coins{bestRoute.coinIn},
+	)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		receiverAddr,
+		sdk.Coins{exitCoin},
+	)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("failed to send out coin to the receiver: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(types.CreateMultihopSwapEvent(
+		callerAddr,
+		receiverAddr,
+		bestRoute.coinIn.Denom,
+		exitCoin.Denom,
+		bestRoute.coinIn.Amount,
+		exitCoin.Amount,
+		bestRoute.route,
+		sdk.Coins{},
+	))
+	k.emitTypedEvent(ctx, &types.EventSwap{
+		Creator:   callerAddr.String(),
+		Receiver:  receiverAddr.String(),
+		TokenIn:   bestRoute.coinIn.Denom,
+		TokenOut:  exitCoin.Denom,
+		AmountIn:  bestRoute.coinIn.Amount,
+		AmountOut: exitCoin.Amount,
+		Route:     bestRoute.route,
+	})
+
+	if err := k.Hooks().AfterSwap(ctx, callerAddr, bestRoute.coinIn, exitCoin); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return bestRoute.coinIn, exitCoin, nil
+}
+
 // PlaceLimitOrderCore handles MsgPlaceLimitOrder, initializing (tick, pair) data structures if needed, calculating and
 // storing information for a new limit order at a specific tick.
 func (k Keeper) PlaceLimitOrderCore(
@@ -336,6 +583,9 @@ func (k Keeper) PlaceLimitOrderCore(
 	orderType types.LimitOrderType,
 	goodTil *time.Time,
 	maxAmountOut *math.Int,
+	oraclePeg *types.OraclePeg,
+	selfTradePrevention types.SelfTradePrevention,
+	recordFills bool,
 	callerAddr sdk.AccAddress,
 	receiverAddr sdk.AccAddress,
 ) (trancheKey string, totalInCoin, swapInCoin, swapOutCoin sdk.Coin, err error) {
@@ -347,6 +597,10 @@ func (k Keeper) PlaceLimitOrderCore(
 		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
 	}
 
+	if err = k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+	}
+
 	amountLeft := amountIn
 
 	// This is ok because tokenOut is provided to the constructor of PairID above
@@ -363,11 +617,27 @@ func (k Keeper) PlaceLimitOrderCore(
 		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
 	}
 
-	var orderFilled bool
+	var orderFilled, selfTradeStopped bool
 	if orderType.IsTakerOnly() {
-		swapInCoin, swapOutCoin, err = k.TakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, maxAmountOut, limitPrice, orderType)
+		swapInCoin, swapOutCoin, err = k.TakerLimitOrderSwap(
+			ctx,
+			*takerTradePairID,
+			amountIn,
+			maxAmountOut,
+			limitPrice,
+			orderType,
+			callerAddr,
+			selfTradePrevention,
+		)
 	} else {
-		swapInCoin, swapOutCoin, orderFilled, err = k.MakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, limitPrice)
+		swapInCoin, swapOutCoin, orderFilled, selfTradeStopped, err = k.MakerLimitOrderSwap(
+			ctx,
+			*takerTradePairID,
+			amountIn,
+			limitPrice,
+			callerAddr,
+			selfTradePrevention,
+		)
 	}
 	if err != nil {
 		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
@@ -392,13 +662,17 @@ func (k Keeper) PlaceLimitOrderCore(
 	makerTradePairID := pairID.MustTradePairIDFromMaker(tokenIn)
 	makerTickIndexTakerToMaker := tickIndexInToOut * -1
 	var placeTranche *types.LimitOrderTranche
-	placeTranche, err = k.GetOrInitPlaceTranche(
-		ctx,
-		makerTradePairID,
-		makerTickIndexTakerToMaker,
-		goodTil,
-		orderType,
-	)
+	if oraclePeg != nil {
+		placeTranche, err = k.InitPeggedPlaceTranche(ctx, makerTradePairID, makerTickIndexTakerToMaker)
+	} else {
+		placeTranche, err = k.GetOrInitPlaceTranche(
+			ctx,
+			makerTradePairID,
+			makerTickIndexTakerToMaker,
+			goodTil,
+			orderType,
+		)
+	}
 	if err != nil {
 		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
 	}
@@ -413,11 +687,16 @@ func (k Keeper) PlaceLimitOrderCore(
 		receiverAddr.String(),
 	)
 
+	if recordFills && swapInCoin.IsPositive() {
+		k.AddFillRecord(ctx, receiverAddr.String(), placeTranche.Key, swapOutCoin.Amount, swapInCoin.Amount)
+	}
+
 	sharesIssued := math.ZeroInt()
 	// FOR GTC, JIT & GoodTil try to place a maker limitOrder with remaining Amount
-	if amountLeft.IsPositive() && !orderFilled &&
-		(orderType.IsGTC() || orderType.IsJIT() || orderType.IsGoodTil()) {
-
+	// unless it was cancelled to prevent a self trade
+	placeMakerOrder := amountLeft.IsPositive() && !orderFilled && !selfTradeStopped &&
+		(orderType.IsGTC() || orderType.IsJIT() || orderType.IsGoodTil())
+	if placeMakerOrder {
 		// Ensure that the maker portion will generate at least 1 token of output
 		// NOTE: This does mean that a successful taker leg of the trade will be thrown away since the entire tx will fail.
 		// In most circumstances this seems preferable to executing the taker leg and exiting early before placing a maker
@@ -426,9 +705,34 @@ func (k Keeper) PlaceLimitOrderCore(
 		if err != nil {
 			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
 		}
+
+		// A remainder below the minimum maker order size is only an error if nothing was swapped. Otherwise the taker
+		// leg is kept and the remainder is never taken from the caller.
+		err = assertMinSize(k.GetParams(ctx).MinMakerOrderSizes, sdk.NewCoin(tokenIn, amountLeft), types.ErrMakerOrderBelowMinSize)
+		if err != nil && swapInCoin.IsZero() {
+			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		}
+		placeMakerOrder = err == nil
+	}
+
+	if placeMakerOrder {
+		// Active tranches always hold some maker denom, so an empty place tranche has just been created
+		if placeTranche.TotalMakerDenom.IsZero() {
+			k.IncPairRefCount(ctx, pairID)
+		}
 		placeTranche.PlaceMakerLimitOrder(amountLeft)
 		trancheUser.SharesOwned = trancheUser.SharesOwned.Add(amountLeft)
 
+		if recordFills {
+			err = k.assertCanSubscribeToFillRecords(ctx, trancheKey, receiverAddr.String())
+			if err != nil {
+				return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+			}
+			placeTranche.RecordFills = true
+			trancheUser.RecordFills = true
+			k.SetFillRecordSubscriber(ctx, trancheKey, receiverAddr.String())
+		}
+
 		if orderType.HasExpiration() {
 			goodTilRecord := NewLimitOrderExpiration(placeTranche)
 			k.SetLimitOrderExpiration(ctx, goodTilRecord)
@@ -437,8 +741,21 @@ func (k Keeper) PlaceLimitOrderCore(
 
 		k.SaveTranche(ctx, placeTranche)
 
+		if oraclePeg != nil {
+			k.SetPeggedLimitOrder(ctx, types.PeggedLimitOrder{
+				Address:    receiverAddr.String(),
+				TrancheKey: placeTranche.Key,
+				OraclePeg:  oraclePeg,
+			})
+		}
+
 		totalIn = totalIn.Add(amountLeft)
 		sharesIssued = amountLeft
+
+		err = k.Hooks().AfterLimitOrderPlaced(ctx, receiverAddr, placeTranche, amountLeft)
+		if err != nil {
+			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		}
 	}
 
 	k.SaveTrancheUser(ctx, trancheUser)
@@ -478,6 +795,36 @@ func (k Keeper) PlaceLimitOrderCore(
 		sharesIssued,
 		trancheKey,
 	))
+	k.emitTypedEvent(ctx, &types.EventPlaceLimitOrder{
+		Creator:               callerAddr.String(),
+		Receiver:              receiverAddr.String(),
+		Token0:                pairID.Token0,
+		Token1:                pairID.Token1,
+		TokenIn:               tokenIn,
+		TokenOut:              tokenOut,
+		AmountIn:              totalIn,
+		LimitTickIndexInToOut: tickIndexInToOut,
+		OrderType:             orderType,
+		Shares:                sharesIssued,
+		TrancheKey:            trancheKey,
+	})
+
+	if swapInCoin.IsPositive() {
+		k.emitTypedEvent(ctx, &types.EventSwap{
+			Creator:   callerAddr.String(),
+			Receiver:  receiverAddr.String(),
+			TokenIn:   swapInCoin.Denom,
+			TokenOut:  swapOutCoin.Denom,
+			AmountIn:  swapInCoin.Amount,
+			AmountOut: swapOutCoin.Amount,
+			Route:     []string{swapInCoin.Denom, swapOutCoin.Denom},
+		})
+
+		err = k.Hooks().AfterSwap(ctx, callerAddr, swapInCoin, swapOutCoin)
+		if err != nil {
+			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		}
+	}
 
 	return trancheKey, totalInCoin, swapInCoin, swapOutCoin, nil
 }
@@ -488,15 +835,19 @@ func (k Keeper) CancelLimitOrderCore(
 	goCtx context.Context,
 	trancheKey string,
 	callerAddr sdk.AccAddress,
-) error {
+) (amountCancelled math.Int, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	trancheUser, found := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
 	if !found {
-		return types.ErrActiveLimitOrderNotFound
+		return math.ZeroInt(), types.ErrActiveLimitOrderNotFound
 	}
 
 	tradePairID, tickIndex := trancheUser.TradePairId, trancheUser.TickIndexTakerToMaker
+	if err := k.AssertPairNotPaused(ctx, tradePairID.MustPairID()); err != nil {
+		return math.ZeroInt(), err
+	}
+
 	tranche := k.GetLimitOrderTranche(
 		ctx,
 		&types.LimitOrderTrancheKey{
@@ -506,10 +857,30 @@ func (k Keeper) CancelLimitOrderCore(
 		},
 	)
 	if tranche == nil {
-		return types.ErrActiveLimitOrderNotFound
+		return math.ZeroInt(), types.ErrActiveLimitOrderNotFound
+	}
+
+	amountCancelled, err = k.cancelLimitOrder(ctx, tranche, trancheUser, callerAddr)
+	if err != nil {
+		return math.ZeroInt(), err
 	}
 
-	amountToCancel := tranche.RemoveTokenIn(trancheUser)
+	k.SaveTranche(ctx, tranche)
+
+	return amountCancelled, nil
+}
+
+// cancelLimitOrder removes the unfilled portion of trancheUser's limit order from tranche and sends it to callerAddr.
+// It is the caller's responsibility to save tranche.
+func (k Keeper) cancelLimitOrder(
+	ctx sdk.Context,
+	tranche *types.LimitOrderTranche,
+	trancheUser *types.LimitOrderTrancheUser,
+	callerAddr sdk.AccAddress,
+) (amountToCancel math.Int, err error) {
+	tradePairID, trancheKey := tranche.Key.TradePairId, tranche.Key.TrancheKey
+
+	amountToCancel = tranche.RemoveTokenIn(trancheUser)
 	trancheUser.SharesCancelled = trancheUser.SharesCancelled.Add(amountToCancel)
 
 	if amountToCancel.IsPositive() {
@@ -522,17 +893,18 @@ func (k Keeper) CancelLimitOrderCore(
 			sdk.Coins{coinOut},
 		)
 		if err != nil {
-			return err
+			return math.ZeroInt(), err
 		}
 
 		k.SaveTrancheUser(ctx, trancheUser)
-		k.SaveTranche(ctx, tranche)
 
 		if trancheUser.OrderType.HasExpiration() {
 			k.RemoveLimitOrderExpiration(ctx, *tranche.ExpirationTime, tranche.Key.KeyMarshal())
 		}
+
+		k.RemovePeggedLimitOrder(ctx, trancheKey)
 	} else {
-		return sdkerrors.Wrapf(types.ErrCancelEmptyLimitOrder, "%s", tranche.Key.TrancheKey)
+		return math.ZeroInt(), sdkerrors.Wrapf(types.ErrCancelEmptyLimitOrder, "%s", trancheKey)
 	}
 
 	pairID := tradePairID.MustPairID()
@@ -545,8 +917,17 @@ func (k Keeper) CancelLimitOrderCore(
 		amountToCancel,
 		trancheKey,
 	))
-
-	return nil
+	k.emitTypedEvent(ctx, &types.EventCancelLimitOrder{
+		Creator:    callerAddr.String(),
+		Token0:     pairID.Token0,
+		Token1:     pairID.Token1,
+		TokenIn:    tradePairID.MakerDenom,
+		TokenOut:   tradePairID.TakerDenom,
+		AmountOut:  amountToCancel,
+		TrancheKey: trancheKey,
+	})
+
+	return amountToCancel, nil
 }
 
 // WithdrawFilledLimitOrderCore handles MsgWithdrawFilledLimitOrder, calculates and sends filled liquidity from module to user
@@ -569,6 +950,9 @@ func (k Keeper) WithdrawFilledLimitOrderCore(
 
 	tradePairID, tickIndex := trancheUser.TradePairId, trancheUser.TickIndexTakerToMaker
 	pairID := tradePairID.MustPairID()
+	if err := k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return err
+	}
 
 	tranche, wasFilled, found := k.FindLimitOrderTranche(
 		ctx,
@@ -624,6 +1008,565 @@ func (k Keeper) WithdrawFilledLimitOrderCore(
 		amountOutTokenOut,
 		trancheKey,
 	))
+	k.emitTypedEvent(ctx, &types.EventWithdrawFilledLimitOrder{
+		Creator:    callerAddr.String(),
+		Token0:     pairID.Token0,
+		Token1:     pairID.Token1,
+		TokenIn:    tradePairID.MakerDenom,
+		TokenOut:   tradePairID.TakerDenom,
+		AmountOut:  amountOutTokenOut,
+		TrancheKey: trancheKey,
+	})
+
+	return nil
+}
+
+// BatchCancelLimitOrdersCore handles MsgBatchCancelLimitOrders. Each limit order is cancelled atomically in its own
+// cached context; orders that fail to cancel are returned as FailedLimitOrders and do not affect the other orders.
+func (k Keeper) BatchCancelLimitOrdersCore(
+	goCtx context.Context,
+	trancheKeys []string,
+	callerAddr sdk.AccAddress,
+) (failedLimitOrders []*types.FailedLimitOrder) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, trancheKey := range trancheKeys {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.CancelLimitOrderCore(cacheCtx, trancheKey, callerAddr); err != nil {
+			failedLimitOrders = append(failedLimitOrders, &types.FailedLimitOrder{TrancheKey: trancheKey, Error: err.Error()})
+			continue
+		}
+		writeCache()
+	}
+
+	return failedLimitOrders
+}
+
+// BatchWithdrawFilledLimitOrdersCore handles MsgBatchWithdrawFilledLimitOrders. Each limit order is withdrawn
+// atomically in its own cached context; orders that fail to withdraw are returned as FailedLimitOrders and do not
+// affect the other orders.
+func (k Keeper) BatchWithdrawFilledLimitOrdersCore(
+	goCtx context.Context,
+	trancheKeys []string,
+	callerAddr sdk.AccAddress,
+) (failedLimitOrders []*types.FailedLimitOrder) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, trancheKey := range trancheKeys {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.WithdrawFilledLimitOrderCore(cacheCtx, trancheKey, callerAddr); err != nil {
+			failedLimitOrders = append(failedLimitOrders, &types.FailedLimitOrder{TrancheKey: trancheKey, Error: err.Error()})
+			continue
+		}
+		writeCache()
+	}
+
+	return failedLimitOrders
+}
+
+// CancelAllLimitOrdersCore handles MsgCancelAllLimitOrders, cancelling all of the caller's limit orders on both sides
+// of pairID. Limit orders that are no longer active or have nothing left to cancel are skipped.
+func (k Keeper) CancelAllLimitOrdersCore(
+	goCtx context.Context,
+	pairID *types.PairID,
+	callerAddr sdk.AccAddress,
+) (cancelledTrancheKeys []string, failedLimitOrders []*types.FailedLimitOrder) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, trancheUser := range k.GetAllLimitOrderTrancheUserForAddress(ctx, callerAddr) {
+		if *trancheUser.TradePairId.MustPairID() != *pairID {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		_, err := k.CancelLimitOrderCore(cacheCtx, trancheUser.TrancheKey, callerAddr)
+		switch {
+		case errors.Is(err, types.ErrActiveLimitOrderNotFound), errors.Is(err, types.ErrCancelEmptyLimitOrder):
+			continue
+		case err != nil:
+			failedLimitOrders = append(
+				failedLimitOrders,
+				&types.FailedLimitOrder{TrancheKey: trancheUser.TrancheKey, Error: err.Error()},
+			)
+		default:
+			writeCache()
+			cancelledTrancheKeys = append(cancelledTrancheKeys, trancheUser.TrancheKey)
+		}
+	}
+
+	return cancelledTrancheKeys, failedLimitOrders
+}
+
+// PlaceConditionalOrderCore handles MsgPlaceConditionalOrder. The full amountIn is escrowed in the dex module
+// until the order is either triggered (see ExecuteTriggeredConditionalOrders) or cancelled.
+func (k Keeper) PlaceConditionalOrderCore(
+	goCtx context.Context,
+	tokenIn string,
+	tokenOut string,
+	amountIn math.Int,
+	orderType types.LimitOrderType,
+	maxAmountOut *math.Int,
+	limitTickIndexInToOut int64,
+	trigger types.ConditionalOrderTrigger,
+	triggerTickIndex int64,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+) (id uint64, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromUnsorted(tokenIn, tokenOut)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return 0, err
+	}
+
+	limitPrice, err := types.CalcPrice(limitTickIndexInToOut)
+	if err != nil {
+		return 0, err
+	}
+
+	// Ensure that the order will generate at least 1 token of output once triggered
+	err = types.ValidateFairOutput(amountIn, limitPrice)
+	if err != nil {
+		return 0, err
+	}
+
+	coinIn := sdk.NewCoin(tokenIn, amountIn)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coinIn})
+	if err != nil {
+		return 0, err
+	}
+
+	order := types.ConditionalOrder{
+		Id:       k.GetConditionalOrderCount(ctx),
+		Creator:  callerAddr.String(),
+		Receiver: receiverAddr.String(),
+		// This is ok because tokenOut is provided to the constructor of PairID above
+		TradePairId:           pairID.MustTradePairIDFromMaker(tokenOut),
+		AmountIn:              amountIn,
+		OrderType:             orderType,
+		MaxAmountOut:          maxAmountOut,
+		LimitTickIndexInToOut: limitTickIndexInToOut,
+		Trigger:               trigger,
+		TriggerTickIndex:      triggerTickIndex,
+	}
+	k.SetConditionalOrder(ctx, order)
+	k.incrementConditionalOrderCount(ctx)
+
+	ctx.GasMeter().ConsumeGas(types.ConditionalOrderGas, "Conditional Order Fee")
+	ctx.EventManager().EmitEvent(types.CreatePlaceConditionalOrderEvent(order))
+
+	return order.Id, nil
+}
+
+// CancelConditionalOrderCore handles MsgCancelConditionalOrder, removing a dormant conditional order
+// and returning the escrowed funds to the creator.
+func (k Keeper) CancelConditionalOrderCore(
+	goCtx context.Context,
+	id uint64,
+	callerAddr sdk.AccAddress,
+) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, found := k.GetConditionalOrder(ctx, id)
+	if !found || order.Creator != callerAddr.String() {
+		return types.ErrConditionalOrderNotFound
+	}
+
+	if err := k.AssertPairNotPaused(ctx, order.TradePairId.MustPairID()); err != nil {
+		return err
+	}
+
+	if err := k.refundConditionalOrder(ctx, order); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(types.CancelConditionalOrderEvent(order))
 
 	return nil
 }
+
+// DepositRangeCore handles MsgDepositRange, splitting amount0 and amount1 across the pools at tickIndexes according
+// to shape before depositing them with DepositCore. Each token is only placed in pools where it would not be behind
+// enemy lines.
+func (k Keeper) DepositRangeCore(
+	goCtx context.Context,
+	pairID *types.PairID,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+	amount0 math.Int,
+	amount1 math.Int,
+	tickIndexes []int64,
+	fee uint64,
+	shape types.LiquidityShape,
+	option *types.DepositOptions,
+) (depositTickIndexes []int64, amounts0Deposit, amounts1Deposit []math.Int, failedDeposits []*types.FailedDeposit, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	weights := shape.Weights(len(tickIndexes), k.GetRangePeakIndex(ctx, pairID, tickIndexes))
+	weights0 := k.FilterRangeWeights(ctx, pairID, tickIndexes, fee, weights, pairID.Token0)
+	weights1 := k.FilterRangeWeights(ctx, pairID, tickIndexes, fee, weights, pairID.Token1)
+	rangeAmounts0 := types.DistributeAmount(amount0, weights0)
+	rangeAmounts1 := types.DistributeAmount(amount1, weights1)
+
+	if err := checkRangeCanHoldToken(amount0, rangeAmounts0, pairID.Token0, fee); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if err := checkRangeCanHoldToken(amount1, rangeAmounts1, pairID.Token1, fee); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	var amounts0, amounts1 []math.Int
+	var fees []uint64
+	var options []*types.DepositOptions
+	for i, tickIndex := range tickIndexes {
+		if rangeAmounts0[i].IsZero() && rangeAmounts1[i].IsZero() {
+			continue
+		}
+		depositTickIndexes = append(depositTickIndexes, tickIndex)
+		amounts0 = append(amounts0, rangeAmounts0[i])
+		amounts1 = append(amounts1, rangeAmounts1[i])
+		fees = append(fees, fee)
+		options = append(options, option)
+	}
+
+	amounts0Deposit, amounts1Deposit, _, failedDeposits, err = k.DepositCore(
+		goCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		amounts0,
+		amounts1,
+		depositTickIndexes,
+		fees,
+		options,
+		nil,
+	)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return depositTickIndexes, amounts0Deposit, amounts1Deposit, failedDeposits, nil
+}
+
+// WithdrawRangeCore handles MsgWithdrawRange, withdrawing all of the caller's shares from the pools at tickIndexes.
+func (k Keeper) WithdrawRangeCore(
+	goCtx context.Context,
+	pairID *types.PairID,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+	tickIndexes []int64,
+	fee uint64,
+) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var sharesToRemove []math.Int
+	var withdrawTickIndexes []int64
+	var fees []uint64
+	for _, tickIndex := range tickIndexes {
+		pool, found := k.GetPool(ctx, pairID, tickIndex, fee)
+		if !found {
+			continue
+		}
+
+		shares := k.bankKeeper.GetBalance(ctx, callerAddr, pool.GetPoolDenom()).Amount
+		if !shares.IsPositive() {
+			continue
+		}
+
+		sharesToRemove = append(sharesToRemove, shares)
+		withdrawTickIndexes = append(withdrawTickIndexes, tickIndex)
+		fees = append(fees, fee)
+	}
+
+	if len(sharesToRemove) == 0 {
+		return types.ErrNoRangePosition
+	}
+
+	_, _, err := k.WithdrawCore(goCtx, pairID, callerAddr, receiverAddr, sharesToRemove, withdrawTickIndexes, fees, nil, nil)
+
+	return err
+}
+
+// WithdrawPositionCore handles MsgWithdrawPosition, withdrawing percentage of each of the caller's positions in the
+// pair. Positions are resolved from the caller's pool share balances so clients don't need to query them first.
+func (k Keeper) WithdrawPositionCore(
+	goCtx context.Context,
+	pairID *types.PairID,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+	percentage math_utils.PrecDec,
+) (reserve0Removed, reserve1Removed math.Int, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	withdrawAll := percentage.Equal(math_utils.NewPrecDec(100))
+
+	var sharesToRemove []math.Int
+	var tickIndexes []int64
+	var fees []uint64
+	for _, deposit := range k.GetAllDepositsForAddress(ctx, callerAddr) {
+		if *deposit.PairId != *pairID {
+			continue
+		}
+
+		shares := deposit.SharesOwned
+		if !withdrawAll {
+			shares = percentage.MulInt(shares).QuoInt64(100).TruncateInt()
+		}
+		if !shares.IsPositive() {
+			continue
+		}
+
+		sharesToRemove = append(sharesToRemove, shares)
+		tickIndexes = append(tickIndexes, deposit.CenterTickIndex)
+		fees = append(fees, deposit.Fee)
+	}
+
+	if len(sharesToRemove) == 0 {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrNoPosition
+	}
+
+	return k.WithdrawCore(goCtx, pairID, callerAddr, receiverAddr, sharesToRemove, tickIndexes, fees, nil, nil)
+}
+
+// FlashSwapCore handles MsgFlashSwap. The swap is made before the caller pays for it: the output is sent to the
+// contract at contractAddr and its flash_swap_callback is called, after which the input owed is taken from the
+// contract's balance. If the callback fails or the contract cannot repay the input nothing is written.
+func (k Keeper) FlashSwapCore(
+	goCtx context.Context,
+	tokenIn string,
+	tokenOut string,
+	amountIn math.Int,
+	maxAmountOut *math.Int,
+	limitSellPrice *math_utils.PrecDec,
+	callbackMsg []byte,
+	contractAddr sdk.AccAddress,
+) (coinIn, coinOut sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.contractKeeper == nil || k.contractKeeper.GetContractInfo(ctx, contractAddr) == nil {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrFlashSwapNotContract, "%s is not a contract", contractAddr)
+	}
+
+	pairID, err := types.NewPairIDFromUnsorted(tokenIn, tokenOut)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	tradePairID := pairID.MustTradePairIDFromMaker(tokenOut)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	coinIn, coinOut, _, _, err = k.SwapWithCache(
+		cacheCtx,
+		tradePairID,
+		amountIn,
+		maxAmountOut,
+		limitSellPrice,
+		contractAddr,
+		types.SelfTradePrevention_ALLOW_SELF_TRADE,
+	)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if coinIn.Amount.IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrLimitPriceNotSatisfied
+	}
+
+	if coinOut.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, contractAddr, sdk.Coins{coinOut})
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	sudoMsg, err := json.Marshal(types.FlashSwapCallbackSudoMsg{
+		FlashSwapCallback: types.FlashSwapCallbackMsg{
+			CoinIn:  wasmvmtypes.Coin{Denom: coinIn.Denom, Amount: coinIn.Amount.String()},
+			CoinOut: wasmvmtypes.Coin{Denom: coinOut.Denom, Amount: coinOut.Amount.String()},
+			Msg:     callbackMsg,
+		},
+	})
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contractAddr, sudoMsg); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrFlashSwapCallbackFailed, err.Error())
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, contractAddr, types.ModuleName, sdk.Coins{coinIn})
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrFlashSwapNotRepaid, "owed %s: %s", coinIn, err)
+	}
+
+	writeCache()
+
+	k.emitTypedEvent(ctx, &types.EventSwap{
+		Creator:   contractAddr.String(),
+		Receiver:  contractAddr.String(),
+		TokenIn:   coinIn.Denom,
+		TokenOut:  coinOut.Denom,
+		AmountIn:  coinIn.Amount,
+		AmountOut: coinOut.Amount,
+		Route:     []string{coinIn.Denom, coinOut.Denom},
+	})
+
+	if err := k.Hooks().AfterSwap(ctx, contractAddr, coinIn, coinOut); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	return coinIn, coinOut, nil
+}
+
+// ModifyLimitOrderCore handles MsgModifyLimitOrder. It returns reduceAmountIn of the unfilled portion of a GTC or
+// GoodTil limit order to callerAddr and/or extends the expiration time of a GoodTil limit order. The order keeps its
+// tranche, and thus its priority. Reducing an order by its entire unfilled amount cancels it.
+func (k Keeper) ModifyLimitOrderCore(
+	goCtx context.Context,
+	trancheKey string,
+	reduceAmountIn *math.Int,
+	expirationTime *time.Time,
+	callerAddr sdk.AccAddress,
+) (coinOut sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	trancheUser, found := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
+	if !found {
+		return sdk.Coin{}, types.ErrActiveLimitOrderNotFound
+	}
+
+	tradePairID, tickIndex := trancheUser.TradePairId, trancheUser.TickIndexTakerToMaker
+	if err := k.AssertPairNotPaused(ctx, tradePairID.MustPairID()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tranche := k.GetLimitOrderTranche(
+		ctx,
+		&types.LimitOrderTrancheKey{
+			TradePairId:           tradePairID,
+			TickIndexTakerToMaker: tickIndex,
+			TrancheKey:            trancheKey,
+		},
+	)
+	if tranche == nil {
+		return sdk.Coin{}, types.ErrActiveLimitOrderNotFound
+	}
+
+	if !trancheUser.OrderType.IsGTC() && !trancheUser.OrderType.IsGoodTil() {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidLimitOrderModification,
+			"only GOOD_TIL_CANCELLED and GOOD_TIL_TIME limit orders can be modified; got %s",
+			trancheUser.OrderType,
+		)
+	}
+
+	coinOut = sdk.NewCoin(tradePairID.MakerDenom, math.ZeroInt())
+
+	if expirationTime != nil {
+		if !trancheUser.OrderType.IsGoodTil() {
+			return sdk.Coin{}, sdkerrors.Wrap(
+				types.ErrInvalidLimitOrderModification,
+				"expiration_time can only be set for GOOD_TIL_TIME limit orders",
+			)
+		}
+		if !expirationTime.After(*tranche.ExpirationTime) {
+			return sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrInvalidLimitOrderModification,
+				"expiration_time %s must be after the current expiration time %s",
+				expirationTime.String(),
+				tranche.ExpirationTime.String(),
+			)
+		}
+
+		k.RemoveLimitOrderExpiration(ctx, *tranche.ExpirationTime, tranche.Key.KeyMarshal())
+		tranche.ExpirationTime = expirationTime
+		k.SetLimitOrderExpiration(ctx, NewLimitOrderExpiration(tranche))
+	}
+
+	if reduceAmountIn != nil {
+		amountUnfilled := tranche.CalcRemoveTokenInAmount(trancheUser)
+		if reduceAmountIn.GT(amountUnfilled) {
+			return sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrInvalidLimitOrderModification,
+				"reduce_amount_in %s exceeds the unfilled amount %s",
+				reduceAmountIn.String(),
+				amountUnfilled.String(),
+			)
+		}
+
+		if reduceAmountIn.Equal(amountUnfilled) {
+			amountCancelled, err := k.cancelLimitOrder(ctx, tranche, trancheUser, callerAddr)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+			coinOut = sdk.NewCoin(tradePairID.MakerDenom, amountCancelled)
+		} else {
+			coinOut, err = k.reduceLimitOrder(ctx, tranche, trancheUser, *reduceAmountIn, amountUnfilled, callerAddr)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+	}
+
+	k.SaveTranche(ctx, tranche)
+
+	k.emitTypedEvent(ctx, &types.EventModifyLimitOrder{
+		Creator:        callerAddr.String(),
+		TokenIn:        tradePairID.MakerDenom,
+		TrancheKey:     trancheKey,
+		AmountReduced:  coinOut.Amount,
+		ExpirationTime: tranche.ExpirationTime,
+	})
+
+	return coinOut, nil
+}
+
+// reduceLimitOrder removes amountIn, which must be less than amountUnfilled, from trancheUser's limit order in tranche
+// and sends it to callerAddr. Fills are split between the users of a tranche in proportion to their shares, so:
+//   - if the tranche has not been filled, the removed amount is taken off the user's and the tranche's shares.
+//   - if it has been filled and the user owns all of its shares, the removed amount is accounted for as cancelled shares
+//     like a cancellation.
+//   - otherwise the reduction cannot be split fairly and fails.
+//
+// It is the caller's responsibility to save tranche.
+func (k Keeper) reduceLimitOrder(
+	ctx sdk.Context,
+	tranche *types.LimitOrderTranche,
+	trancheUser *types.LimitOrderTrancheUser,
+	amountIn math.Int,
+	amountUnfilled math.Int,
+	callerAddr sdk.AccAddress,
+) (sdk.Coin, error) {
+	makerDenom := tranche.Key.TradePairId.MakerDenom
+
+	amountLeft := amountUnfilled.Sub(amountIn)
+	err := assertMinSize(k.GetParams(ctx).MinMakerOrderSizes, sdk.NewCoin(makerDenom, amountLeft), types.ErrMakerOrderBelowMinSize)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	switch {
+	case tranche.TotalTakerDenom.IsZero():
+		trancheUser.SharesOwned = trancheUser.SharesOwned.Sub(amountIn)
+		tranche.TotalMakerDenom = tranche.TotalMakerDenom.Sub(amountIn)
+	case trancheUser.SharesOwned.Equal(tranche.TotalMakerDenom):
+		trancheUser.SharesCancelled = trancheUser.SharesCancelled.Add(amountIn)
+	default:
+		return sdk.Coin{}, sdkerrors.Wrap(
+			types.ErrInvalidLimitOrderModification,
+			"cannot reduce a partially filled limit order that shares its tranche with other limit orders",
+		)
+	}
+	tranche.ReservesMakerDenom = tranche.ReservesMakerDenom.Sub(amountIn)
+
+	coinOut := sdk.NewCoin(makerDenom, amountIn)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, callerAddr, sdk.Coins{coinOut})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SaveTrancheUser(ctx, trancheUser)
+
+	return coinOut, nil
+}
@@ -23,6 +23,15 @@ func (k Keeper) GetCurrPrice(ctx sdk.Context, tradePairID *types.TradePairID) (m
 	return math_utils.ZeroPrecDec(), false
 }
 
+// GetCurrPriceInQuote returns the amount of quoteDenom received for selling one denom at the best price on the book
+func (k Keeper) GetCurrPriceInQuote(ctx sdk.Context, denom, quoteDenom string) (math_utils.PrecDec, bool) {
+	if denom == quoteDenom {
+		return math_utils.OnePrecDec(), true
+	}
+
+	return k.GetCurrPrice(ctx, types.MustNewTradePairID(denom, quoteDenom))
+}
+
 // Returns a takerToMaker tick index
 func (k Keeper) GetCurrTickIndexTakerToMaker(
 	ctx sdk.Context,
@@ -90,6 +99,16 @@ func (k Keeper) AssertCanPlaceJIT(ctx sdk.Context) error {
 	return nil
 }
 
+// assertMinSize returns errBelowMin if a positive amount of coin is below the minimum for its denom in minSizes
+func assertMinSize(minSizes sdk.Coins, coin sdk.Coin, errBelowMin *sdkerrors.Error) error {
+	minSize := minSizes.AmountOf(coin.Denom)
+	if coin.IsPositive() && coin.Amount.LT(minSize) {
+		return errBelowMin.Wrapf("%s is below the minimum of %s%s", coin, minSize, coin.Denom)
+	}
+
+	return nil
+}
+
 func (k Keeper) GetGoodTilPurgeAllowance(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).GoodTilPurgeAllowance
 }
@@ -124,6 +143,74 @@ func (k Keeper) IsPoolBehindEnemyLines(ctx sdk.Context, pairID *types.PairID, ti
 	return false
 }
 
+// GetRangePeakIndex returns the index of the tick index closest to the current price of the pair. If the pair has no
+// liquidity the middle of the range is used.
+func (k Keeper) GetRangePeakIndex(ctx sdk.Context, pairID *types.PairID, tickIndexes []int64) int {
+	curr0To1, found0To1 := k.GetCurrTickIndexTakerToMakerNormalized(ctx, types.NewTradePairIDFromTaker(pairID, pairID.Token0))
+	curr1To0, found1To0 := k.GetCurrTickIndexTakerToMakerNormalized(ctx, types.NewTradePairIDFromTaker(pairID, pairID.Token1))
+
+	var currTickIndex int64
+	switch {
+	case found0To1 && found1To0:
+		currTickIndex = (curr0To1 + curr1To0) / 2
+	case found0To1:
+		currTickIndex = curr0To1
+	case found1To0:
+		currTickIndex = curr1To0
+	default:
+		return len(tickIndexes) / 2
+	}
+
+	peak := 0
+	for i, tickIndex := range tickIndexes {
+		if utils.Abs(tickIndex-currTickIndex) < utils.Abs(tickIndexes[peak]-currTickIndex) {
+			peak = i
+		}
+	}
+
+	return peak
+}
+
+// FilterRangeWeights zeroes the weights of the pools in a range deposit where token would be behind enemy lines.
+func (k Keeper) FilterRangeWeights(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	tickIndexes []int64,
+	fee uint64,
+	weights []math.Int,
+	token string,
+) []math.Int {
+	amount0, amount1 := math.OneInt(), math.ZeroInt()
+	if token == pairID.Token1 {
+		amount0, amount1 = amount1, amount0
+	}
+
+	filteredWeights := make([]math.Int, len(weights))
+	for i, tickIndex := range tickIndexes {
+		if k.IsPoolBehindEnemyLines(ctx, pairID, tickIndex, fee, amount0, amount1) {
+			filteredWeights[i] = math.ZeroInt()
+		} else {
+			filteredWeights[i] = weights[i]
+		}
+	}
+
+	return filteredWeights
+}
+
+func checkRangeCanHoldToken(amount math.Int, rangeAmounts []math.Int, token string, fee uint64) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	for _, rangeAmount := range rangeAmounts {
+		if rangeAmount.IsPositive() {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(types.ErrDepositBehindEnemyLines, "no pool in the range can hold %s at fee %d", token, fee)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 //                            TOKENIZER UTILS                                //
 ///////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// IncPairRefCount is called when a pool or limit order tranche of pairID is created. The first one indexes the pair
+// by both of its denoms.
+func (k Keeper) IncPairRefCount(ctx sdk.Context, pairID *types.PairID) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PairRefCountKey(pairID)
+
+	count := uint64(0)
+	if bz := store.Get(key); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+
+	if count == 0 {
+		b := k.cdc.MustMarshal(pairID)
+		store.Set(types.PairByDenomKey(pairID.Token0, pairID), b)
+		store.Set(types.PairByDenomKey(pairID.Token1, pairID), b)
+	}
+}
+
+// DecPairRefCount is called when a limit order tranche of pairID is removed. Once the pair has no pools or limit order
+// tranches left it is removed from the index.
+func (k Keeper) DecPairRefCount(ctx sdk.Context, pairID *types.PairID) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PairRefCountKey(pairID)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	count := sdk.BigEndianToUint64(bz)
+	if count > 1 {
+		store.Set(key, sdk.Uint64ToBigEndian(count-1))
+		return
+	}
+
+	store.Delete(key)
+	store.Delete(types.PairByDenomKey(pairID.Token0, pairID))
+	store.Delete(types.PairByDenomKey(pairID.Token1, pairID))
+}
+
+// GetPairRefCount returns the number of pools and limit order tranches (active or inactive) of pairID
+func (k Keeper) GetPairRefCount(ctx sdk.Context, pairID *types.PairID) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.PairRefCountKey(pairID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPoolByDenom indexes the pool with poolID by both denoms of pairID
+func (k Keeper) SetPoolByDenom(ctx sdk.Context, poolID uint64, pairID *types.PairID) {
+	store := ctx.KVStore(k.storeKey)
+	poolIDBz := sdk.Uint64ToBigEndian(poolID)
+	store.Set(types.PoolByDenomKey(pairID.Token0, poolID), poolIDBz)
+	store.Set(types.PoolByDenomKey(pairID.Token1, poolID), poolIDBz)
+}
+
+// PairHasLiquidity returns true if either side of pairID has swappable liquidity
+func (k Keeper) PairHasLiquidity(ctx sdk.Context, pairID *types.PairID) bool {
+	for _, makerDenom := range []string{pairID.Token0, pairID.Token1} {
+		tradePairID := pairID.MustTradePairIDFromMaker(makerDenom)
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TickLiquidityPrefix(tradePairID))
+		iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+		hasLiquidity := false
+		for ; iterator.Valid(); iterator.Next() {
+			tick := &types.TickLiquidity{}
+			k.cdc.MustUnmarshal(iterator.Value(), tick)
+			if tick.HasToken() {
+				hasLiquidity = true
+				break
+			}
+		}
+		iterator.Close()
+
+		if hasLiquidity {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// SetDepositCostBasis set a specific depositCostBasis in the store from its index
+func (k Keeper) SetDepositCostBasis(ctx sdk.Context, costBasis types.DepositCostBasis) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&costBasis)
+	store.Set(types.DepositCostBasisKey(costBasis.Address, costBasis.PoolId), b)
+}
+
+// GetDepositCostBasis returns the depositCostBasis of an address in the pool with poolID
+func (k Keeper) GetDepositCostBasis(
+	ctx sdk.Context,
+	address string,
+	poolID uint64,
+) (val types.DepositCostBasis, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.DepositCostBasisKey(address, poolID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDepositCostBasis removes a depositCostBasis from the store
+func (k Keeper) RemoveDepositCostBasis(ctx sdk.Context, address string, poolID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DepositCostBasisKey(address, poolID))
+}
+
+// GetAllDepositCostBasis returns all depositCostBases
+func (k Keeper) GetAllDepositCostBasis(ctx sdk.Context) (list []types.DepositCostBasis) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DepositCostBasisKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DepositCostBasis
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddDepositCostBasis adds a deposit of amount0 and amount1 for shares to address's cost basis in the pool with poolID
+func (k Keeper) AddDepositCostBasis(
+	ctx sdk.Context,
+	address string,
+	poolID uint64,
+	amount0 math.Int,
+	amount1 math.Int,
+	shares math.Int,
+) {
+	costBasis, found := k.GetDepositCostBasis(ctx, address, poolID)
+	if !found {
+		costBasis = types.DepositCostBasis{
+			Address: address,
+			PoolId:  poolID,
+			Shares:  math.ZeroInt(),
+			Amount0: math.ZeroInt(),
+			Amount1: math.ZeroInt(),
+		}
+	}
+
+	costBasis.Shares = costBasis.Shares.Add(shares)
+	costBasis.Amount0 = costBasis.Amount0.Add(amount0)
+	costBasis.Amount1 = costBasis.Amount1.Add(amount1)
+	k.SetDepositCostBasis(ctx, costBasis)
+}
+
+// ReduceDepositCostBasis removes the cost basis of sharesRemoved from address's cost basis in the pool with poolID.
+// The cost basis is reduced pro-rata, shares beyond those with a cost basis have none to remove.
+func (k Keeper) ReduceDepositCostBasis(ctx sdk.Context, address string, poolID uint64, sharesRemoved math.Int) {
+	costBasis, found := k.GetDepositCostBasis(ctx, address, poolID)
+	if !found {
+		return
+	}
+
+	if sharesRemoved.GTE(costBasis.Shares) {
+		k.RemoveDepositCostBasis(ctx, address, poolID)
+		return
+	}
+
+	sharesLeft := costBasis.Shares.Sub(sharesRemoved)
+	costBasis.Amount0 = costBasis.Amount0.Mul(sharesLeft).Quo(costBasis.Shares)
+	costBasis.Amount1 = costBasis.Amount1.Mul(sharesLeft).Quo(costBasis.Shares)
+	costBasis.Shares = sharesLeft
+	k.SetDepositCostBasis(ctx, costBasis)
+}
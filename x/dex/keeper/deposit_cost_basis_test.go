@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func createNDepositCostBasis(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.DepositCostBasis {
+	items := make([]types.DepositCostBasis, n)
+	for i := range items {
+		items[i].Address = "addr" + strconv.Itoa(i%2)
+		items[i].PoolId = uint64(i)
+		items[i].Shares = math.NewInt(int64(i) + 10)
+		items[i].Amount0 = math.NewInt(int64(i) + 5)
+		items[i].Amount1 = math.NewInt(int64(i) + 5)
+		keeper.SetDepositCostBasis(ctx, items[i])
+	}
+
+	return items
+}
+
+func TestDepositCostBasisGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNDepositCostBasis(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetDepositCostBasis(ctx, item.Address, item.PoolId)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(item),
+			nullify.Fill(got),
+		)
+	}
+}
+
+func TestDepositCostBasisRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNDepositCostBasis(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveDepositCostBasis(ctx, item.Address, item.PoolId)
+		_, found := keeper.GetDepositCostBasis(ctx, item.Address, item.PoolId)
+		require.False(t, found)
+	}
+}
+
+func TestDepositCostBasisGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNDepositCostBasis(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllDepositCostBasis(ctx)),
+	)
+}
+
+func TestDepositCostBasisAddAndReduce(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+
+	keeper.AddDepositCostBasis(ctx, "alice", 0, math.NewInt(10), math.NewInt(30), math.NewInt(40))
+	keeper.AddDepositCostBasis(ctx, "alice", 0, math.NewInt(10), math.ZeroInt(), math.NewInt(10))
+
+	costBasis, found := keeper.GetDepositCostBasis(ctx, "alice", 0)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(50), costBasis.Shares)
+	require.Equal(t, math.NewInt(20), costBasis.Amount0)
+	require.Equal(t, math.NewInt(30), costBasis.Amount1)
+
+	// Reducing is pro-rata to the shares removed
+	keeper.ReduceDepositCostBasis(ctx, "alice", 0, math.NewInt(10))
+
+	costBasis, found = keeper.GetDepositCostBasis(ctx, "alice", 0)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(40), costBasis.Shares)
+	require.Equal(t, math.NewInt(16), costBasis.Amount0)
+	require.Equal(t, math.NewInt(24), costBasis.Amount1)
+
+	// Removing more shares than have a cost basis removes it
+	keeper.ReduceDepositCostBasis(ctx, "alice", 0, math.NewInt(50))
+
+	_, found = keeper.GetDepositCostBasis(ctx, "alice", 0)
+	require.False(t, found)
+}
@@ -0,0 +1,6 @@
+package keeper
+
+// ClearHooks removes the hooks registered by the app so tests can set their own
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// SetFillRecord set a specific fillRecord in the store and index it by its time
+func (k Keeper) SetFillRecord(ctx sdk.Context, record types.FillRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FillRecordKey(record.Address, record.TrancheKey, record.Height)
+	b := k.cdc.MustMarshal(&record)
+	store.Set(key, b)
+
+	store.Set(types.FillRecordTimeKey(record.Time, key), key)
+}
+
+// GetFillRecord returns the fillRecord of an address in a tranche at height
+func (k Keeper) GetFillRecord(
+	ctx sdk.Context,
+	address string,
+	trancheKey string,
+	height int64,
+) (val types.FillRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.FillRecordKey(address, trancheKey, height))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFillRecord returns all fillRecords
+func (k Keeper) GetAllFillRecord(ctx sdk.Context) (list []types.FillRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FillRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FillRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddFillRecord records a fill of address's limit order in the tranche with trancheKey. Fills in the same block
+// are added to a single record.
+func (k Keeper) AddFillRecord(
+	ctx sdk.Context,
+	address string,
+	trancheKey *types.LimitOrderTrancheKey,
+	amountTakerDenom math.Int,
+	amountMakerDenom math.Int,
+) {
+	record, found := k.GetFillRecord(ctx, address, trancheKey.TrancheKey, ctx.BlockHeight())
+	if !found {
+		record = types.FillRecord{
+			Address:          address,
+			TrancheKey:       trancheKey.TrancheKey,
+			TradePairId:      trancheKey.TradePairId,
+			Height:           ctx.BlockHeight(),
+			Time:             ctx.BlockTime(),
+			AmountTakerDenom: math.ZeroInt(),
+			AmountMakerDenom: math.ZeroInt(),
+		}
+	}
+
+	record.AmountTakerDenom = record.AmountTakerDenom.Add(amountTakerDenom)
+	record.AmountMakerDenom = record.AmountMakerDenom.Add(amountMakerDenom)
+	k.SetFillRecord(ctx, record)
+}
+
+// PruneFillRecords removes fillRecords older than cutoff. At most FillRecordPruneAllowance gas is spent per call, so
+// a backlog of expired records cannot make a single block do unbounded work. Pruning resumes after the last removed
+// record in the following call.
+func (k Keeper) PruneFillRecords(ctx sdk.Context, cutoff time.Time) {
+	gasCutoff := ctx.GasMeter().GasConsumed() + k.GetParams(ctx).FillRecordPruneAllowance
+	store := ctx.KVStore(k.storeKey)
+	start := types.KeyPrefix(types.FillRecordTimeKeyPrefix)
+	if cursor := k.getFillRecordPruneCursor(ctx); cursor != nil {
+		start = append(cursor, 0x00)
+	}
+	end := append(types.KeyPrefix(types.FillRecordTimeKeyPrefix), sdk.FormatTimeBytes(cutoff)...)
+	if bytes.Compare(start, end) >= 0 {
+		return
+	}
+	iterator := store.Iterator(start, end)
+
+	var keys [][]byte
+	var cursor []byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key(), iterator.Value())
+
+		// At least one record is removed per call so that pruning always makes progress
+		if ctx.GasMeter().GasConsumed() >= gasCutoff {
+			cursor = iterator.Key()
+			break
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if cursor != nil {
+		k.setFillRecordPruneCursor(ctx, cursor)
+	} else {
+		k.removeFillRecordPruneCursor(ctx)
+	}
+}
+
+func (k Keeper) getFillRecordPruneCursor(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.FillRecordPruneCursorKey))
+}
+
+func (k Keeper) setFillRecordPruneCursor(ctx sdk.Context, cursor []byte) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.FillRecordPruneCursorKey), bytes.Clone(cursor))
+}
+
+func (k Keeper) removeFillRecordPruneCursor(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPrefix(types.FillRecordPruneCursorKey))
+}
+
+// SetFillRecordSubscriber records the fills of address's limit order in the tranche with trancheKey
+func (k Keeper) SetFillRecordSubscriber(ctx sdk.Context, trancheKey, address string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FillRecordSubscriberKey(trancheKey, address)
+	if store.Has(key) {
+		return
+	}
+
+	store.Set(key, []byte(address))
+	store.Set(
+		types.FillRecordSubscriberCountKey(trancheKey),
+		sdk.Uint64ToBigEndian(k.GetFillRecordSubscriberCount(ctx, trancheKey)+1),
+	)
+}
+
+// RemoveFillRecordSubscriber stops recording the fills of address's limit order in the tranche with trancheKey
+func (k Keeper) RemoveFillRecordSubscriber(ctx sdk.Context, trancheKey, address string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FillRecordSubscriberKey(trancheKey, address)
+	if !store.Has(key) {
+		return
+	}
+
+	store.Delete(key)
+	count := k.GetFillRecordSubscriberCount(ctx, trancheKey)
+	if count > 1 {
+		store.Set(types.FillRecordSubscriberCountKey(trancheKey), sdk.Uint64ToBigEndian(count-1))
+	} else {
+		store.Delete(types.FillRecordSubscriberCountKey(trancheKey))
+	}
+}
+
+// HasFillRecordSubscriber returns whether the fills of address's limit order in the tranche with trancheKey are recorded
+func (k Keeper) HasFillRecordSubscriber(ctx sdk.Context, trancheKey, address string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FillRecordSubscriberKey(trancheKey, address))
+}
+
+// GetFillRecordSubscriberCount returns the number of addresses recording fills in the tranche with trancheKey
+func (k Keeper) GetFillRecordSubscriberCount(ctx sdk.Context, trancheKey string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.FillRecordSubscriberCountKey(trancheKey))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// assertCanSubscribeToFillRecords returns ErrTooManyFillRecordSubscribers if address would exceed the maximum number
+// of addresses recording fills in the tranche with trancheKey
+func (k Keeper) assertCanSubscribeToFillRecords(ctx sdk.Context, trancheKey, address string) error {
+	if k.HasFillRecordSubscriber(ctx, trancheKey, address) {
+		return nil
+	}
+
+	maxSubscribers := k.GetParams(ctx).MaxFillRecordSubscribers
+	if k.GetFillRecordSubscriberCount(ctx, trancheKey) >= maxSubscribers {
+		return types.ErrTooManyFillRecordSubscribers.Wrapf("tranche %s already has %d", trancheKey, maxSubscribers)
+	}
+
+	return nil
+}
+
+// RecordTrancheFill splits a fill of the tranche between the users recording their fills in it, in proportion to
+// their shares. Since no limit orders can be added to a tranche once it has been filled, each user's part of the
+// fill matches what they can withdraw from it. The number of users is bounded by the MaxFillRecordSubscribers param.
+func (k Keeper) RecordTrancheFill(
+	ctx sdk.Context,
+	tranche *types.LimitOrderTranche,
+	amountTakerDenom math.Int,
+	amountMakerDenom math.Int,
+) {
+	if !tranche.RecordFills {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FillRecordSubscriberPrefix(tranche.Key.TrancheKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, address := range addresses {
+		trancheUser, found := k.GetLimitOrderTrancheUser(ctx, address, tranche.Key.TrancheKey)
+		if !found {
+			continue
+		}
+
+		k.AddFillRecord(
+			ctx,
+			address,
+			tranche.Key,
+			amountTakerDenom.Mul(trancheUser.SharesOwned).Quo(tranche.TotalMakerDenom),
+			amountMakerDenom.Mul(trancheUser.SharesOwned).Quo(tranche.TotalMakerDenom),
+		)
+	}
+}
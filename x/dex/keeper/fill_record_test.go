@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func createNFillRecord(keeper *keeper.Keeper, ctx sdk.Context, address string, n int) []types.FillRecord {
+	items := make([]types.FillRecord, n)
+	for i := range items {
+		items[i].Address = address
+		items[i].TrancheKey = "tranche" + strconv.Itoa(i%2)
+		items[i].TradePairId = defaultTradePairID0To1
+		items[i].Height = int64(i)
+		items[i].Time = time.Unix(int64(i), 0).UTC()
+		items[i].AmountTakerDenom = math.NewInt(int64(i) + 10)
+		items[i].AmountMakerDenom = math.NewInt(int64(i) + 5)
+		keeper.SetFillRecord(ctx, items[i])
+	}
+
+	return items
+}
+
+func TestFillRecordGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNFillRecord(keeper, ctx, "alice", 10)
+	for _, item := range items {
+		got, found := keeper.GetFillRecord(ctx, item.Address, item.TrancheKey, item.Height)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(item),
+			nullify.Fill(got),
+		)
+	}
+}
+
+func TestFillRecordGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNFillRecord(keeper, ctx, "alice", 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllFillRecord(ctx)),
+	)
+}
+
+func TestAddFillRecordSameBlock(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(time.Unix(100, 0).UTC())
+	trancheKey := &types.LimitOrderTrancheKey{TradePairId: defaultTradePairID0To1, TrancheKey: "tranche"}
+
+	keeper.AddFillRecord(ctx, "alice", trancheKey, math.NewInt(10), math.NewInt(20))
+	keeper.AddFillRecord(ctx, "alice", trancheKey, math.NewInt(1), math.NewInt(2))
+
+	records := keeper.GetAllFillRecord(ctx)
+	require.Len(t, records, 1)
+	require.Equal(t, types.FillRecord{
+		Address:          "alice",
+		TrancheKey:       "tranche",
+		TradePairId:      defaultTradePairID0To1,
+		Height:           5,
+		Time:             time.Unix(100, 0).UTC(),
+		AmountTakerDenom: math.NewInt(11),
+		AmountMakerDenom: math.NewInt(22),
+	}, records[0])
+}
+
+func TestPruneFillRecords(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNFillRecord(keeper, ctx, "alice", 10)
+
+	keeper.PruneFillRecords(ctx, time.Unix(4, 0))
+
+	require.ElementsMatch(t,
+		nullify.Fill(items[4:]),
+		nullify.Fill(keeper.GetAllFillRecord(ctx)),
+	)
+
+	// Pruning again does not remove any more records
+	keeper.PruneFillRecords(ctx, time.Unix(4, 0))
+	require.Len(t, keeper.GetAllFillRecord(ctx), 6)
+}
+
+func TestPruneFillRecordsAllowance(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.FillRecordPruneAllowance = 1
+	require.NoError(t, keeper.SetParams(ctx, params))
+	items := createNFillRecord(keeper, ctx, "alice", 10)
+
+	// With the smallest allowance one record is pruned per call, resuming after the last one removed
+	keeper.PruneFillRecords(ctx, time.Unix(4, 0))
+	require.Len(t, keeper.GetAllFillRecord(ctx), 9)
+
+	keeper.PruneFillRecords(ctx, time.Unix(4, 0))
+	require.Len(t, keeper.GetAllFillRecord(ctx), 8)
+
+	for i := 0; i < 3; i++ {
+		keeper.PruneFillRecords(ctx, time.Unix(4, 0))
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items[4:]),
+		nullify.Fill(keeper.GetAllFillRecord(ctx)),
+	)
+}
+
+func TestFillRecordSubscriberCount(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+
+	keeper.SetFillRecordSubscriber(ctx, "tranche", "alice")
+	keeper.SetFillRecordSubscriber(ctx, "tranche", "alice")
+	keeper.SetFillRecordSubscriber(ctx, "tranche", "bob")
+	keeper.SetFillRecordSubscriber(ctx, "other", "alice")
+	require.Equal(t, uint64(2), keeper.GetFillRecordSubscriberCount(ctx, "tranche"))
+
+	keeper.RemoveFillRecordSubscriber(ctx, "tranche", "alice")
+	keeper.RemoveFillRecordSubscriber(ctx, "tranche", "alice")
+	require.Equal(t, uint64(1), keeper.GetFillRecordSubscriberCount(ctx, "tranche"))
+	require.True(t, keeper.HasFillRecordSubscriber(ctx, "tranche", "bob"))
+
+	keeper.RemoveFillRecordSubscriber(ctx, "tranche", "bob")
+	require.Equal(t, uint64(0), keeper.GetFillRecordSubscriberCount(ctx, "tranche"))
+	require.Equal(t, uint64(1), keeper.GetFillRecordSubscriberCount(ctx, "other"))
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) ConditionalOrderAll(
+	goCtx context.Context,
+	req *types.QueryAllConditionalOrderRequest,
+) (*types.QueryAllConditionalOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var conditionalOrders []types.ConditionalOrder
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	conditionalOrderStore := prefix.NewStore(store, types.KeyPrefix(types.ConditionalOrderKeyPrefix))
+
+	pageRes, err := query.Paginate(conditionalOrderStore, req.Pagination, func(_, value []byte) error {
+		var conditionalOrder types.ConditionalOrder
+		if err := k.cdc.Unmarshal(value, &conditionalOrder); err != nil {
+			return err
+		}
+
+		conditionalOrders = append(conditionalOrders, conditionalOrder)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllConditionalOrderResponse{ConditionalOrder: conditionalOrders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ConditionalOrder(
+	goCtx context.Context,
+	req *types.QueryGetConditionalOrderRequest,
+) (*types.QueryGetConditionalOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	conditionalOrder, found := k.GetConditionalOrder(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "ConditionalOrder not found for key")
+	}
+
+	return &types.QueryGetConditionalOrderResponse{ConditionalOrder: conditionalOrder}, nil
+}
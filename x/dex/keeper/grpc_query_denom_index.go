@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) PairsByDenom(
+	goCtx context.Context,
+	req *types.QueryPairsByDenomRequest,
+) (*types.QueryPairsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var pairIDs []*types.PairID
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairByDenomPrefix(req.Denom))
+
+	pageRes, err := query.FilteredPaginate(pairStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		pairID := &types.PairID{}
+		if err := k.cdc.Unmarshal(value, pairID); err != nil {
+			return false, err
+		}
+
+		// Denoms may contain "/" so the prefix of a denom can also match keys of longer denoms
+		if _, ok := pairID.OppositeToken(req.Denom); !ok {
+			return false, nil
+		}
+
+		if accumulate {
+			pairIDs = append(pairIDs, pairID)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPairsByDenomResponse{PairIds: pairIDs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PoolsByDenom(
+	goCtx context.Context,
+	req *types.QueryPoolsByDenomRequest,
+) (*types.QueryPoolsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var pools []*types.Pool
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PoolByDenomPrefix(req.Denom))
+
+	pageRes, err := query.FilteredPaginate(poolStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		poolMetadata, found := k.GetPoolMetadata(ctx, sdk.BigEndianToUint64(value))
+		if !found {
+			return false, nil
+		}
+
+		// Denoms may contain "/" so the prefix of a denom can also match keys of longer denoms
+		if _, ok := poolMetadata.PairId.OppositeToken(req.Denom); !ok {
+			return false, nil
+		}
+
+		if accumulate {
+			pool, _ := k.getPoolByPoolID(ctx, poolMetadata.Id, poolMetadata.PairId, poolMetadata.Tick, poolMetadata.Fee)
+			pools = append(pools, pool)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsByDenomResponse{Pools: pools, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ActivePairs(
+	goCtx context.Context,
+	req *types.QueryActivePairsRequest,
+) (*types.QueryActivePairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pairIDs []*types.PairID
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairRefCountKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(pairStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		pairID, err := types.NewPairIDFromCanonicalString(strings.TrimSuffix(string(key), "/"))
+		if err != nil {
+			return false, err
+		}
+
+		if !k.PairHasLiquidity(ctx, pairID) {
+			return false, nil
+		}
+
+		if accumulate {
+			pairIDs = append(pairIDs, pairID)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryActivePairsResponse{PairIds: pairIDs, Pagination: pageRes}, nil
+}
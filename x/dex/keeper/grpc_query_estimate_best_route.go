@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) EstimateBestRoute(
+	goCtx context.Context,
+	req *types.QueryEstimateBestRouteRequest,
+) (*types.QueryEstimateBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	routes := k.FindRoutes(ctx, req.TokenIn, req.TokenOut, req.MaxHops)
+	if len(routes) == 0 {
+		return nil, types.ErrNoRouteFound
+	}
+
+	// NB: Routes are simulated against a cached context that is never written since this is only an estimate.
+	cacheCtx, _ := ctx.CacheContext()
+	inCoin := sdk.NewCoin(req.TokenIn, req.AmountIn)
+	stepCache := make(map[multihopCacheKey]StepResult)
+	estimates := make([]types.RouteEstimate, 0, len(routes))
+	for _, route := range routes {
+		_, coinOut, _, err := k.RunMultihopRoute(
+			cacheCtx,
+			*route,
+			inCoin,
+			math_utils.ZeroPrecDec(),
+			stepCache,
+			nil,
+			types.SelfTradePrevention_ALLOW_SELF_TRADE,
+		)
+		if err != nil || !coinOut.IsPositive() {
+			continue
+		}
+		estimates = append(estimates, types.RouteEstimate{Route: *route, CoinOut: coinOut})
+	}
+
+	if len(estimates) == 0 {
+		return nil, types.ErrNoRouteFound
+	}
+
+	sort.SliceStable(estimates, func(i, j int) bool {
+		return estimates[i].CoinOut.Amount.GT(estimates[j].CoinOut.Amount)
+	})
+
+	return &types.QueryEstimateBestRouteResponse{Routes: estimates}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) EstimateDeposit(
+	goCtx context.Context,
+	req *types.QueryEstimateDepositRequest,
+) (*types.QueryEstimateDepositResponse, error) {
+	msg := types.MsgDeposit{
+		Creator:         req.Creator,
+		Receiver:        req.Receiver,
+		TokenA:          req.TokenA,
+		TokenB:          req.TokenB,
+		AmountsA:        req.AmountsA,
+		AmountsB:        req.AmountsB,
+		TickIndexesAToB: req.TickIndexesAToB,
+		Fees:            req.Fees,
+		Options:         req.Options,
+		MinSharesOut:    req.MinSharesOut,
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	callerAddr := sdk.MustAccAddressFromBech32(req.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(req.Receiver)
+
+	pairID, err := types.NewPairIDFromUnsorted(req.TokenA, req.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts0, amounts1 := SortAmounts(req.TokenA, pairID.Token0, req.AmountsA, req.AmountsB)
+	tickIndexes := NormalizeAllTickIndexes(req.TokenA, pairID.Token0, req.TickIndexesAToB)
+
+	reserve0Deposited, reserve1Deposited, _, deposits, failedDeposits, err := k.depositCore(
+		cacheCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		amounts0,
+		amounts1,
+		tickIndexes,
+		req.Fees,
+		req.Options,
+		req.MinSharesOut,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryEstimateDepositResponse{
+		Reserve0Deposited:   reserve0Deposited,
+		Reserve1Deposited:   reserve1Deposited,
+		SharesIssued:        make([]math.Int, len(amounts0)),
+		Reserve0Autoswapped: make([]math.Int, len(amounts0)),
+		Reserve1Autoswapped: make([]math.Int, len(amounts0)),
+		FailedDeposits:      failedDeposits,
+	}
+	for i := range amounts0 {
+		resp.SharesIssued[i] = math.ZeroInt()
+		resp.Reserve0Autoswapped[i] = math.ZeroInt()
+		resp.Reserve1Autoswapped[i] = math.ZeroInt()
+	}
+	for _, d := range deposits {
+		resp.SharesIssued[d.idx] = d.shares.Amount
+		resp.Reserve0Autoswapped[d.idx] = d.autoswapped0
+		resp.Reserve1Autoswapped[d.idx] = d.autoswapped1
+	}
+
+	// NB: We're only using a cache context so we don't expect any writes to happen.
+
+	return resp, nil
+}
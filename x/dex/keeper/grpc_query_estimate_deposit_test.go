@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) aliceEstimatesDeposit(deposits ...*Deposit) (*types.QueryEstimateDepositResponse, error) {
+	amountsA := make([]math.Int, len(deposits))
+	amountsB := make([]math.Int, len(deposits))
+	tickIndexes := make([]int64, len(deposits))
+	fees := make([]uint64, len(deposits))
+	options := make([]*types.DepositOptions, len(deposits))
+	for i, e := range deposits {
+		amountsA[i] = e.AmountA
+		amountsB[i] = e.AmountB
+		tickIndexes[i] = e.TickIndex
+		fees[i] = e.Fee
+		if e.Options != nil {
+			options[i] = e.Options
+		} else {
+			options[i] = &types.DepositOptions{}
+		}
+	}
+
+	return s.App.DexKeeper.EstimateDeposit(s.Ctx, &types.QueryEstimateDepositRequest{
+		Creator:         s.alice.String(),
+		Receiver:        s.alice.String(),
+		TokenA:          "TokenA",
+		TokenB:          "TokenB",
+		AmountsA:        amountsA,
+		AmountsB:        amountsB,
+		TickIndexesAToB: tickIndexes,
+		Fees:            fees,
+		Options:         options,
+	})
+}
+
+func (s *DexTestSuite) aliceEstimatesWithdrawal(withdrawals ...*Withdrawal) (*types.QueryEstimateWithdrawalResponse, error) {
+	tickIndexes := make([]int64, len(withdrawals))
+	fees := make([]uint64, len(withdrawals))
+	sharesToRemove := make([]math.Int, len(withdrawals))
+	for i, e := range withdrawals {
+		tickIndexes[i] = e.TickIndex
+		fees[i] = e.Fee
+		sharesToRemove[i] = e.Shares
+	}
+
+	return s.App.DexKeeper.EstimateWithdrawal(s.Ctx, &types.QueryEstimateWithdrawalRequest{
+		Creator:         s.alice.String(),
+		Receiver:        s.alice.String(),
+		TokenA:          "TokenA",
+		TokenB:          "TokenB",
+		SharesToRemove:  sharesToRemove,
+		TickIndexesAToB: tickIndexes,
+		Fees:            fees,
+	})
+}
+
+// Tests
+
+func (s *DexTestSuite) TestEstimateDepositMatchesDeposit() {
+	s.fundAliceBalances(50, 50)
+
+	// GIVEN no existing liquidity
+
+	// WHEN alice estimates depositing 10 A, 10 B at tick 0 fee 1 and 5 A at tick -5 fee 1
+	deposits := []*Deposit{NewDeposit(10, 10, 0, 1), NewDeposit(5, 0, -5, 1)}
+	resp, err := s.aliceEstimatesDeposit(deposits...)
+	s.NoError(err)
+
+	// THEN nothing is deposited
+	s.assertAliceBalances(50, 50)
+	s.assertDexBalances(0, 0)
+	s.assertPoolShares(0, 1, 0)
+
+	// AND the estimate matches the actual deposit
+	depositResp, err := s.deposits(s.alice, deposits)
+	s.NoError(err)
+	s.Equal(depositResp.Reserve0Deposited, resp.Reserve0Deposited)
+	s.Equal(depositResp.Reserve1Deposited, resp.Reserve1Deposited)
+	s.Equal(s.getPoolShares("TokenA", "TokenB", 0, 1), resp.SharesIssued[0])
+	s.Equal(s.getPoolShares("TokenA", "TokenB", -5, 1), resp.SharesIssued[1])
+	for i := range deposits {
+		s.True(resp.Reserve0Autoswapped[i].IsZero())
+		s.True(resp.Reserve1Autoswapped[i].IsZero())
+	}
+	s.Empty(resp.FailedDeposits)
+}
+
+func (s *DexTestSuite) TestEstimateDepositAutoswap() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+
+	// GIVEN a balanced pool at tick 0 fee 1
+	s.bobDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice estimates depositing 10 A, 5 B into it
+	resp, err := s.aliceEstimatesDeposit(NewDeposit(10, 5, 0, 1))
+	s.NoError(err)
+
+	// THEN all of it is deposited and the 5 A that do not match the pool's ratio are autoswapped
+	s.Equal(math.NewInt(10_000_000), resp.Reserve0Deposited[0])
+	s.Equal(math.NewInt(5_000_000), resp.Reserve1Deposited[0])
+	s.Equal(math.NewInt(5_000_000), resp.Reserve0Autoswapped[0])
+	s.True(resp.Reserve1Autoswapped[0].IsZero())
+
+	// AND the shares issued match the actual deposit
+	sharesBefore := s.getPoolShares("TokenA", "TokenB", 0, 1)
+	s.aliceDeposits(NewDeposit(10, 5, 0, 1))
+	s.Equal(s.getPoolShares("TokenA", "TokenB", 0, 1).Sub(sharesBefore), resp.SharesIssued[0])
+}
+
+func (s *DexTestSuite) TestEstimateDepositNoAutoswap() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+
+	// GIVEN a balanced pool at tick 0 fee 1
+	s.bobDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice estimates depositing 10 A, 5 B into it with autoswap disabled
+	resp, err := s.aliceEstimatesDeposit(
+		NewDepositWithOptions(10, 5, 0, 1, types.DepositOptions{DisableAutoswap: true}),
+	)
+	s.NoError(err)
+
+	// THEN only the amounts matching the pool's ratio are deposited
+	s.Equal(math.NewInt(5_000_000), resp.Reserve0Deposited[0])
+	s.Equal(math.NewInt(5_000_000), resp.Reserve1Deposited[0])
+	s.True(resp.Reserve0Autoswapped[0].IsZero())
+	s.True(resp.Reserve1Autoswapped[0].IsZero())
+}
+
+func (s *DexTestSuite) TestEstimateDepositBEL() {
+	s.fundAliceBalances(50, 50)
+
+	// GIVEN no existing liquidity
+
+	// WHEN alice estimates depositing 5 A, 5 B at tick 0 and 5 A at tick 3
+	resp, err := s.aliceEstimatesDeposit(
+		NewDeposit(5, 5, 0, 1),
+		NewDeposit(5, 0, 3, 1),
+	)
+	s.NoError(err)
+
+	// THEN the second deposit would fail the BEL check
+	s.Len(resp.FailedDeposits, 1)
+	s.EqualValues(1, resp.FailedDeposits[0].DepositIdx)
+	s.True(resp.SharesIssued[0].IsPositive())
+	s.True(resp.SharesIssued[1].IsZero())
+	s.True(resp.Reserve0Deposited[1].IsZero())
+
+	// AND nothing is deposited
+	s.assertAliceBalances(50, 50)
+	s.assertDexBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestEstimateDepositBELFailTx() {
+	s.fundAliceBalances(50, 50)
+
+	// GIVEN no existing liquidity
+
+	// WHEN alice estimates depositing 5 A, 5 B at tick 0 and 5 A at tick 3 with FailTxOnBel
+	_, err := s.aliceEstimatesDeposit(
+		NewDeposit(5, 5, 0, 1),
+		NewDepositWithOptions(5, 0, 3, 1, types.DepositOptions{FailTxOnBel: true}),
+	)
+
+	// THEN the estimate fails
+	s.ErrorIs(err, types.ErrDepositBehindEnemyLines)
+}
+
+func (s *DexTestSuite) TestEstimateDepositAutoswapMultipleEntries() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+
+	// GIVEN balanced pools at tick 0 fee 1 and tick 2 fee 1
+	s.bobDeposits(NewDeposit(10, 10, 0, 1), NewDeposit(10, 10, 2, 1))
+
+	// WHEN alice estimates depositing 10 A, 5 B at tick 0 and 5 A, 10 B at tick 2
+	deposits := []*Deposit{NewDeposit(10, 5, 0, 1), NewDeposit(5, 10, 2, 1)}
+	resp, err := s.aliceEstimatesDeposit(deposits...)
+	s.NoError(err)
+
+	// THEN the amounts autoswapped are attributed to each entry
+	s.Equal(math.NewInt(5_000_000), resp.Reserve0Autoswapped[0])
+	s.True(resp.Reserve1Autoswapped[0].IsZero())
+	s.True(resp.Reserve0Autoswapped[1].IsZero())
+	s.Equal(math.NewInt(5_000_000), resp.Reserve1Autoswapped[1])
+
+	// AND the estimate matches the actual deposit
+	shares0Before := s.getPoolShares("TokenA", "TokenB", 0, 1)
+	shares1Before := s.getPoolShares("TokenA", "TokenB", 2, 1)
+	depositResp, err := s.deposits(s.alice, deposits)
+	s.NoError(err)
+	s.Equal(depositResp.Reserve0Deposited, resp.Reserve0Deposited)
+	s.Equal(depositResp.Reserve1Deposited, resp.Reserve1Deposited)
+	s.Equal(s.getPoolShares("TokenA", "TokenB", 0, 1).Sub(shares0Before), resp.SharesIssued[0])
+	s.Equal(s.getPoolShares("TokenA", "TokenB", 2, 1).Sub(shares1Before), resp.SharesIssued[1])
+}
+
+func (s *DexTestSuite) TestEstimateWithdrawalMatchesWithdrawal() {
+	s.fundAliceBalances(50, 50)
+
+	// GIVEN alice has deposited 10 A, 20 B at tick 0 fee 1
+	s.aliceDeposits(NewDeposit(10, 20, 0, 1))
+
+	// WHEN alice estimates withdrawing half of her shares
+	shares := s.getPoolShares("TokenA", "TokenB", 0, 1).QuoRaw(2)
+	resp, err := s.aliceEstimatesWithdrawal(NewWithdrawalInt(shares, 0, 1))
+	s.NoError(err)
+
+	// THEN nothing is withdrawn
+	s.assertAliceBalances(40, 30)
+	s.assertDexBalances(10, 20)
+
+	// AND the estimate matches the actual withdrawal
+	s.Equal(math.NewInt(5_000_000), resp.Reserve0Withdrawn)
+	s.Equal(math.NewInt(10_000_000), resp.Reserve1Withdrawn)
+	s.aliceWithdraws(NewWithdrawalInt(shares, 0, 1))
+	s.assertAliceBalances(45, 40)
+}
+
+func (s *DexTestSuite) TestEstimateWithdrawalInsufficientShares() {
+	s.fundAliceBalances(50, 50)
+
+	// GIVEN alice has deposited 10 A, 10 B at tick 0 fee 1
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice estimates withdrawing more shares than they own
+	_, err := s.aliceEstimatesWithdrawal(NewWithdrawal(30, 0, 1))
+
+	// THEN the estimate fails
+	s.ErrorIs(err, types.ErrInsufficientShares)
+}
@@ -14,12 +14,14 @@ func (k Keeper) EstimateMultiHopSwap(
 	req *types.QueryEstimateMultiHopSwapRequest,
 ) (*types.QueryEstimateMultiHopSwapResponse, error) {
 	msg := types.MsgMultiHopSwap{
-		Creator:        req.Creator,
-		Receiver:       req.Receiver,
-		Routes:         req.Routes,
-		AmountIn:       req.AmountIn,
-		ExitLimitPrice: req.ExitLimitPrice,
-		PickBestRoute:  req.PickBestRoute,
+		Creator:             req.Creator,
+		Receiver:            req.Receiver,
+		Routes:              req.Routes,
+		AmountIn:            req.AmountIn,
+		ExitLimitPrice:      req.ExitLimitPrice,
+		PickBestRoute:       req.PickBestRoute,
+		AmountOut:           req.AmountOut,
+		SelfTradePrevention: req.SelfTradePrevention,
 	}
 	if err := msg.Validate(); err != nil {
 		return nil, err
@@ -31,6 +33,27 @@ func (k Keeper) EstimateMultiHopSwap(
 	callerAddr := sdk.MustAccAddressFromBech32(req.Creator)
 	receiverAddr := sdk.MustAccAddressFromBech32(req.Receiver)
 
+	// NB: Critically, we do not write the best route's buffered state context since this is only an estimate.
+
+	if msg.IsExactAmountOut() {
+		coinIn, coinOut, err := k.MultiHopSwapExactAmountOutCore(
+			cacheCtx,
+			*req.AmountOut,
+			req.AmountIn,
+			req.Routes,
+			req.ExitLimitPrice,
+			req.PickBestRoute,
+			callerAddr,
+			receiverAddr,
+			req.SelfTradePrevention,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryEstimateMultiHopSwapResponse{CoinOut: coinOut, CoinIn: coinIn}, nil
+	}
+
 	coinOut, err := k.MultiHopSwapCore(
 		cacheCtx,
 		req.AmountIn,
@@ -39,12 +62,12 @@ func (k Keeper) EstimateMultiHopSwap(
 		req.PickBestRoute,
 		callerAddr,
 		receiverAddr,
+		req.SelfTradePrevention,
 	)
 	if err != nil {
 		return nil, err
 	}
+	coinIn := sdk.NewCoin(req.Routes[0].Hops[0], req.AmountIn)
 
-	// NB: Critically, we do not write the best route's buffered state context since this is only an estimate.
-
-	return &types.QueryEstimateMultiHopSwapResponse{CoinOut: coinOut}, nil
+	return &types.QueryEstimateMultiHopSwapResponse{CoinOut: coinOut, CoinIn: coinIn}, nil
 }
@@ -364,3 +364,28 @@ func (s *DexTestSuite) TestEstimateMultiHopSwapEventsEmitted() {
 	// 8 tickUpdateEvents are emitted 4x for pool setup 4x for two swaps
 	s.AssertEventValueNotEmitted(types.TickUpdateEventKey, "Expected no events")
 }
+
+func (s *DexTestSuite) TestEstimateMultiHopSwapExactAmountOutSingleRoute() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, 0, 1),
+	)
+
+	// WHEN alice estimates a multihopswap for exactly 50 TokenD
+	route := [][]string{{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	coinIn, coinOut := s.aliceEstimatesMultiHopSwapExactAmountOut(route, 50, 100, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN alice would pay ~50 TokenA for exactly 50 TokenD
+	s.Assert().Equal(math.NewInt(50_015_003), coinIn.Amount)
+	s.Assert().Equal(math.NewInt(50_000_000), coinOut.Amount)
+
+	// AND no state is changed
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 100)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenD", 0)
+	s.assertDexBalanceWithDenom("TokenA", 0)
+	s.assertDexBalanceWithDenom("TokenD", 100)
+}
@@ -15,15 +15,16 @@ func (k Keeper) EstimatePlaceLimitOrder(
 	req *types.QueryEstimatePlaceLimitOrderRequest,
 ) (*types.QueryEstimatePlaceLimitOrderResponse, error) {
 	msg := types.MsgPlaceLimitOrder{
-		Creator:          req.Creator,
-		Receiver:         req.Receiver,
-		TokenIn:          req.TokenIn,
-		TokenOut:         req.TokenOut,
-		TickIndexInToOut: req.TickIndexInToOut,
-		AmountIn:         req.AmountIn,
-		OrderType:        req.OrderType,
-		ExpirationTime:   req.ExpirationTime,
-		MaxAmountOut:     req.MaxAmountOut,
+		Creator:             req.Creator,
+		Receiver:            req.Receiver,
+		TokenIn:             req.TokenIn,
+		TokenOut:            req.TokenOut,
+		TickIndexInToOut:    req.TickIndexInToOut,
+		AmountIn:            req.AmountIn,
+		OrderType:           req.OrderType,
+		ExpirationTime:      req.ExpirationTime,
+		MaxAmountOut:        req.MaxAmountOut,
+		SelfTradePrevention: req.SelfTradePrevention,
 	}
 	if err := msg.Validate(); err != nil {
 		return nil, err
@@ -53,6 +54,9 @@ func (k Keeper) EstimatePlaceLimitOrder(
 		req.OrderType,
 		req.ExpirationTime,
 		req.MaxAmountOut,
+		nil,
+		req.SelfTradePrevention,
+		false,
 		callerAddr,
 		receiverAddr,
 	)
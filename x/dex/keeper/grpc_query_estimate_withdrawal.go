@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) EstimateWithdrawal(
+	goCtx context.Context,
+	req *types.QueryEstimateWithdrawalRequest,
+) (*types.QueryEstimateWithdrawalResponse, error) {
+	msg := types.MsgWithdrawal{
+		Creator:         req.Creator,
+		Receiver:        req.Receiver,
+		TokenA:          req.TokenA,
+		TokenB:          req.TokenB,
+		SharesToRemove:  req.SharesToRemove,
+		TickIndexesAToB: req.TickIndexesAToB,
+		Fees:            req.Fees,
+		MinAmount0Out:   req.MinAmount0Out,
+		MinAmount1Out:   req.MinAmount1Out,
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	callerAddr := sdk.MustAccAddressFromBech32(req.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(req.Receiver)
+
+	pairID, err := types.NewPairIDFromUnsorted(req.TokenA, req.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	tickIndexes := NormalizeAllTickIndexes(req.TokenA, pairID.Token0, req.TickIndexesAToB)
+
+	reserve0Withdrawn, reserve1Withdrawn, err := k.WithdrawCore(
+		cacheCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		req.SharesToRemove,
+		tickIndexes,
+		req.Fees,
+		req.MinAmount0Out,
+		req.MinAmount1Out,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// NB: We're only using a cache context so we don't expect any writes to happen.
+
+	return &types.QueryEstimateWithdrawalResponse{
+		Reserve0Withdrawn: reserve0Withdrawn,
+		Reserve1Withdrawn: reserve1Withdrawn,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) OrderBookDepth(
+	goCtx context.Context,
+	req *types.QueryOrderBookDepthRequest,
+) (*types.QueryOrderBookDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tradePairID, err := types.NewTradePairID(req.TokenIn, req.TokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketWidth := int64(req.BucketWidth)
+	if bucketWidth == 0 {
+		bucketWidth = 1
+	}
+
+	maxBuckets := int(req.MaxBuckets)
+	if maxBuckets == 0 {
+		maxBuckets = types.MaxOrderBookDepthBuckets
+	}
+
+	buckets := k.GetOrderBookDepth(ctx, tradePairID, bucketWidth, maxBuckets)
+
+	return &types.QueryOrderBookDepthResponse{Buckets: buckets}, nil
+}
+
+// GetOrderBookDepth aggregates the PoolReserves and LimitOrderTranche liquidity of a TradePairID into buckets of
+// bucketWidth ticks, ordered from best to worst price. Iteration stops once maxBuckets buckets have been filled.
+func (k Keeper) GetOrderBookDepth(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	bucketWidth int64,
+	maxBuckets int,
+) []types.DepthBucket {
+	buckets := make([]types.DepthBucket, 0)
+	cumulative := math.ZeroInt()
+
+	ti := k.NewTickIterator(ctx, tradePairID)
+	defer ti.Close()
+	for ; ti.Valid(); ti.Next() {
+		tick := ti.Value()
+
+		var reserves math.Int
+		switch liquidity := tick.Liquidity.(type) {
+		case *types.TickLiquidity_PoolReserves:
+			reserves = liquidity.PoolReserves.ReservesMakerDenom
+		case *types.TickLiquidity_LimitOrderTranche:
+			reserves = liquidity.LimitOrderTranche.ReservesMakerDenom
+		}
+		if !reserves.IsPositive() {
+			continue
+		}
+
+		start, end := types.DepthBucketBounds(tick.TickIndex(), bucketWidth)
+		if len(buckets) == 0 || buckets[len(buckets)-1].TickIndexStart != start {
+			if len(buckets) == maxBuckets {
+				break
+			}
+			buckets = append(buckets, types.DepthBucket{
+				TickIndexStart:     start,
+				TickIndexEnd:       end,
+				PriceStart:         types.MustCalcPrice(start),
+				PriceEnd:           types.MustCalcPrice(end),
+				Reserves:           math.ZeroInt(),
+				CumulativeReserves: cumulative,
+			})
+		}
+
+		cumulative = cumulative.Add(reserves)
+		bucket := &buckets[len(buckets)-1]
+		bucket.Reserves = bucket.Reserves.Add(reserves)
+		bucket.CumulativeReserves = cumulative
+	}
+
+	return buckets
+}
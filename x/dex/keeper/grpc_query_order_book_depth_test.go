@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (s *DexTestSuite) setupOrderBookDepth() {
+	s.fundBobBalances(0, 50)
+
+	// GIVEN TokenB liquidity at ticks -12, -5, 3 and 25 with a pool and a limit order sharing tick 3
+	s.bobLimitSells("TokenB", -12, 10)
+	s.bobLimitSells("TokenB", -5, 5)
+	s.bobDeposits(NewDeposit(0, 20, 2, 1))
+	s.bobLimitSells("TokenB", 3, 5)
+	s.bobLimitSells("TokenB", 25, 7)
+}
+
+func (s *DexTestSuite) orderBookDepth(bucketWidth, maxBuckets uint64) []types.DepthBucket {
+	resp, err := s.App.DexKeeper.OrderBookDepth(s.Ctx, &types.QueryOrderBookDepthRequest{
+		TokenIn:     "TokenA",
+		TokenOut:    "TokenB",
+		BucketWidth: bucketWidth,
+		MaxBuckets:  maxBuckets,
+	})
+	s.Require().NoError(err)
+
+	return resp.Buckets
+}
+
+func (s *DexTestSuite) assertDepthBucket(bucket types.DepthBucket, start, end int64, reserves, cumulative int64) {
+	s.Assert().Equal(start, bucket.TickIndexStart)
+	s.Assert().Equal(end, bucket.TickIndexEnd)
+	s.Assert().Equal(types.MustCalcPrice(start), bucket.PriceStart)
+	s.Assert().Equal(types.MustCalcPrice(end), bucket.PriceEnd)
+	s.Assert().Equal(math.NewInt(reserves).Mul(denomMultiple), bucket.Reserves)
+	s.Assert().Equal(math.NewInt(cumulative).Mul(denomMultiple), bucket.CumulativeReserves)
+}
+
+func (s *DexTestSuite) TestOrderBookDepthSingleTickBuckets() {
+	s.setupOrderBookDepth()
+
+	// WHEN querying depth without a bucket width
+	buckets := s.orderBookDepth(0, 0)
+
+	// THEN each tick is reported individually with PoolReserves and LimitOrderTranches merged
+	s.Require().Len(buckets, 4)
+	s.assertDepthBucket(buckets[0], -12, -12, 10, 10)
+	s.assertDepthBucket(buckets[1], -5, -5, 5, 15)
+	s.assertDepthBucket(buckets[2], 3, 3, 25, 40)
+	s.assertDepthBucket(buckets[3], 25, 25, 7, 47)
+}
+
+func (s *DexTestSuite) TestOrderBookDepthAggregatesBuckets() {
+	s.setupOrderBookDepth()
+
+	// WHEN querying depth with buckets 10 ticks wide
+	buckets := s.orderBookDepth(10, 0)
+
+	// THEN liquidity is aggregated into aligned buckets ordered by price
+	s.Require().Len(buckets, 4)
+	s.assertDepthBucket(buckets[0], -20, -11, 10, 10)
+	s.assertDepthBucket(buckets[1], -10, -1, 5, 15)
+	s.assertDepthBucket(buckets[2], 0, 9, 25, 40)
+	s.assertDepthBucket(buckets[3], 20, 29, 7, 47)
+
+	// WHEN querying depth with buckets 100 ticks wide
+	buckets = s.orderBookDepth(100, 0)
+
+	// THEN all liquidity is split between the negative and positive buckets
+	s.Require().Len(buckets, 2)
+	s.assertDepthBucket(buckets[0], -100, -1, 15, 15)
+	s.assertDepthBucket(buckets[1], 0, 99, 32, 47)
+}
+
+func (s *DexTestSuite) TestOrderBookDepthMaxBuckets() {
+	s.setupOrderBookDepth()
+
+	// WHEN querying depth with at most 2 buckets
+	buckets := s.orderBookDepth(10, 2)
+
+	// THEN only the best 2 buckets are returned
+	s.Require().Len(buckets, 2)
+	s.assertDepthBucket(buckets[0], -20, -11, 10, 10)
+	s.assertDepthBucket(buckets[1], -10, -1, 5, 15)
+}
+
+func (s *DexTestSuite) TestOrderBookDepthEmpty() {
+	s.setupOrderBookDepth()
+
+	// WHEN querying depth in the direction without liquidity
+	resp, err := s.App.DexKeeper.OrderBookDepth(s.Ctx, &types.QueryOrderBookDepthRequest{
+		TokenIn:  "TokenB",
+		TokenOut: "TokenA",
+	})
+
+	// THEN no buckets are returned
+	s.Require().NoError(err)
+	s.Assert().Empty(resp.Buckets)
+}
+
+func (s *DexTestSuite) TestOrderBookDepthInvalidRequest() {
+	_, err := s.App.DexKeeper.OrderBookDepth(s.Ctx, &types.QueryOrderBookDepthRequest{
+		TokenIn:  "TokenA",
+		TokenOut: "TokenA",
+	})
+	s.Assert().ErrorIs(err, types.ErrInvalidDenom)
+
+	_, err = s.App.DexKeeper.OrderBookDepth(s.Ctx, &types.QueryOrderBookDepthRequest{
+		TokenIn:     "TokenA",
+		TokenOut:    "TokenB",
+		BucketWidth: types.MaxOrderBookDepthBucketWidth + 1,
+	})
+	s.Assert().ErrorIs(err, types.ErrInvalidBucketWidth)
+
+	_, err = s.App.DexKeeper.OrderBookDepth(s.Ctx, &types.QueryOrderBookDepthRequest{
+		TokenIn:    "TokenA",
+		TokenOut:   "TokenB",
+		MaxBuckets: types.MaxOrderBookDepthBuckets + 1,
+	})
+	s.Assert().ErrorIs(err, types.ErrInvalidMaxBuckets)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) PairCircuitBreakerAll(
+	goCtx context.Context,
+	req *types.QueryAllPairCircuitBreakerRequest,
+) (*types.QueryAllPairCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var breakers []types.PairCircuitBreaker
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	breakerStore := prefix.NewStore(store, types.KeyPrefix(types.PairCircuitBreakerKeyPrefix))
+
+	pageRes, err := query.Paginate(breakerStore, req.Pagination, func(_, value []byte) error {
+		var breaker types.PairCircuitBreaker
+		if err := k.cdc.Unmarshal(value, &breaker); err != nil {
+			return err
+		}
+
+		breakers = append(breakers, breaker)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPairCircuitBreakerResponse{PairCircuitBreaker: breakers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PairCircuitBreaker(
+	goCtx context.Context,
+	req *types.QueryGetPairCircuitBreakerRequest,
+) (*types.QueryGetPairCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	breaker, found := k.GetPairCircuitBreaker(ctx, pairID)
+	if !found {
+		return nil, status.Error(codes.NotFound, "PairCircuitBreaker not found for pair")
+	}
+
+	return &types.QueryGetPairCircuitBreakerResponse{PairCircuitBreaker: breaker}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func TestPairCircuitBreakerQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	msgs := createNPairCircuitBreaker(keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetPairCircuitBreakerRequest
+		response *types.QueryGetPairCircuitBreakerResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetPairCircuitBreakerRequest{PairId: msgs[0].PairId.CanonicalString()},
+			response: &types.QueryGetPairCircuitBreakerResponse{PairCircuitBreaker: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetPairCircuitBreakerRequest{PairId: msgs[1].PairId.CanonicalString()},
+			response: &types.QueryGetPairCircuitBreakerResponse{PairCircuitBreaker: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetPairCircuitBreakerRequest{PairId: "TokenA<>missing"},
+			err:     status.Error(codes.NotFound, "PairCircuitBreaker not found for pair"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PairCircuitBreaker(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPairCircuitBreakerQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	msgs := createNPairCircuitBreaker(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllPairCircuitBreakerRequest {
+		return &types.QueryAllPairCircuitBreakerRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PairCircuitBreakerAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PairCircuitBreaker), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PairCircuitBreaker),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PairCircuitBreakerAll(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.PairCircuitBreaker), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.PairCircuitBreaker),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PairCircuitBreakerAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.PairCircuitBreaker),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PairCircuitBreakerAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) ProtocolFeeAll(goCtx context.Context, req *types.QueryAllProtocolFeeRequest) (*types.QueryAllProtocolFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var protocolFees []types.ProtocolFee
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	protocolFeeStore := prefix.NewStore(store, types.KeyPrefix(types.ProtocolFeeKeyPrefix))
+
+	pageRes, err := query.Paginate(protocolFeeStore, req.Pagination, func(_, value []byte) error {
+		var protocolFee types.ProtocolFee
+		if err := k.cdc.Unmarshal(value, &protocolFee); err != nil {
+			return err
+		}
+
+		protocolFees = append(protocolFees, protocolFee)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProtocolFeeResponse{ProtocolFee: protocolFees, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProtocolFee(goCtx context.Context, req *types.QueryGetProtocolFeeRequest) (*types.QueryGetProtocolFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	protocolFee, found := k.GetProtocolFee(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "ProtocolFee not found for denom")
+	}
+
+	return &types.QueryGetProtocolFeeResponse{ProtocolFee: protocolFee}, nil
+}
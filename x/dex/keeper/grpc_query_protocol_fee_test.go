@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func TestProtocolFeeQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	msgs := createNProtocolFee(keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetProtocolFeeRequest
+		response *types.QueryGetProtocolFeeResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetProtocolFeeRequest{Denom: msgs[0].Denom},
+			response: &types.QueryGetProtocolFeeResponse{ProtocolFee: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetProtocolFeeRequest{Denom: msgs[1].Denom},
+			response: &types.QueryGetProtocolFeeResponse{ProtocolFee: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetProtocolFeeRequest{Denom: "missing"},
+			err:     status.Error(codes.NotFound, "ProtocolFee not found for denom"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ProtocolFee(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestProtocolFeeQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	msgs := createNProtocolFee(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllProtocolFeeRequest {
+		return &types.QueryAllProtocolFeeRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ProtocolFeeAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ProtocolFee), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ProtocolFee),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ProtocolFeeAll(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ProtocolFee), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ProtocolFee),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ProtocolFeeAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.ProtocolFee),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ProtocolFeeAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
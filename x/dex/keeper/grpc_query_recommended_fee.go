@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) RecommendedFee(
+	goCtx context.Context,
+	req *types.QueryRecommendedFeeRequest,
+) (*types.QueryRecommendedFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pairID, err := types.NewPairIDFromUnsorted(req.TokenA, req.TokenB)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fee, volatility, found := k.GetRecommendedFee(ctx, pairID)
+	if !found {
+		return nil, types.ErrNoRecommendedFee
+	}
+
+	return &types.QueryRecommendedFeeResponse{
+		Fee:                fee,
+		RealizedVolatility: volatility,
+	}, nil
+}
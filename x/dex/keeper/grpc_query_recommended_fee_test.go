@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (s *DexTestSuite) setFeeRecommendationWindow(window time.Duration) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.FeeRecommendationWindow = uint64(window.Seconds())
+	err := s.App.DexKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(err)
+}
+
+func (s *DexTestSuite) queryRecommendedFee() (*types.QueryRecommendedFeeResponse, error) {
+	return s.App.DexKeeper.RecommendedFee(s.Ctx, &types.QueryRecommendedFeeRequest{
+		TokenA: "TokenA",
+		TokenB: "TokenB",
+	})
+}
+
+func (s *DexTestSuite) TestRecommendedFee() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+
+	// WHEN the window covers the move of the price from tick -10 to tick 0
+	s.setFeeRecommendationWindow(20 * time.Second)
+
+	// THEN the realized volatility is 10 ticks and the 10 fee tier is recommended
+	resp, err := s.queryRecommendedFee()
+	s.Require().NoError(err)
+	s.Assert().Equal(math_utils.NewPrecDec(10), resp.RealizedVolatility)
+	s.Assert().Equal(uint64(10), resp.Fee)
+
+	// AND the recommendation is the same regardless of token order
+	resp, err = s.App.DexKeeper.RecommendedFee(s.Ctx, &types.QueryRecommendedFeeRequest{
+		TokenA: "TokenB",
+		TokenB: "TokenA",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(uint64(10), resp.Fee)
+}
+
+func (s *DexTestSuite) TestRecommendedFeeRollingWindow() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+
+	// WHEN the window starts after the price moved
+	s.setFeeRecommendationWindow(5 * time.Second)
+
+	// THEN there is no volatility and the lowest fee tier is recommended
+	resp, err := s.queryRecommendedFee()
+	s.Require().NoError(err)
+	s.Assert().True(resp.RealizedVolatility.IsZero())
+	s.Assert().Equal(uint64(0), resp.Fee)
+}
+
+func (s *DexTestSuite) TestRecommendedFeeHighVolatility() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+	s.setFeeRecommendationWindow(20 * time.Second)
+
+	// WHEN the fee tiers are all below the realized volatility
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.FeeTiers = []uint64{5, 1, 2}
+	err := s.App.DexKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(err)
+
+	// THEN the largest fee tier is recommended
+	resp, err := s.queryRecommendedFee()
+	s.Require().NoError(err)
+	s.Assert().Equal(uint64(5), resp.Fee)
+}
+
+func (s *DexTestSuite) TestRecommendedFeeIgnoresMovesWithinBlock() {
+	s.fundAliceBalances(20, 0)
+	s.fundBobBalances(0, 30)
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setFeeRecommendationWindow(20 * time.Second)
+
+	// GIVEN TokenB liquidity at tick -10 and tick 0 and a swap at startTime leaving liquidity at tick -10
+	s.bobLimitSells("TokenB", -10, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 5, math_utils.MustNewPrecDecFromStr("0.5"), false)
+
+	// WHEN, 20s later, a swap within a block moves the price to tick 0 and a later swap in the same block moves it
+	// back to tick -10
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(20 * time.Second))
+	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 5, math_utils.MustNewPrecDecFromStr("0.5"), false)
+	s.bobLimitSells("TokenB", -10, 10)
+	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 1, math_utils.MustNewPrecDecFromStr("0.5"), false)
+
+	// THEN the moves within the block are not part of the realized volatility
+	resp, err := s.queryRecommendedFee()
+	s.Require().NoError(err)
+	s.Assert().True(resp.RealizedVolatility.IsZero())
+}
+
+func (s *DexTestSuite) TestRecommendedFeeNotFound() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+
+	// WHEN fee recommendations are disabled
+	// THEN no fee is recommended
+	_, err := s.queryRecommendedFee()
+	s.Assert().ErrorIs(err, types.ErrNoRecommendedFee)
+
+	// WHEN the window starts before the first TwapRecord
+	s.setFeeRecommendationWindow(30 * time.Second)
+
+	// THEN no fee is recommended
+	_, err = s.queryRecommendedFee()
+	s.Assert().ErrorIs(err, types.ErrNoRecommendedFee)
+}
+
+func (s *DexTestSuite) TestDepositUseRecommendedFee() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+	s.setFeeRecommendationWindow(20 * time.Second)
+
+	// WHEN alice makes an autoswap deposit with fee 1 using the recommended fee
+	s.aliceDeposits(NewDepositWithOptions(2, 0, -20, 1, types.DepositOptions{UseRecommendedFee: true}))
+
+	// THEN the deposit is made into the pool with the recommended fee of 10
+	s.assertAliceShares(-20, 10, 2)
+	s.assertAliceShares(-20, 1, 0)
+
+	// WHEN alice makes the same deposit with autoswap disabled
+	s.aliceDeposits(NewDepositWithOptions(2, 0, -20, 1, types.DepositOptions{
+		DisableAutoswap:   true,
+		UseRecommendedFee: true,
+	}))
+
+	// THEN the deposit is made into the pool with the given fee
+	s.assertAliceShares(-20, 1, 2)
+}
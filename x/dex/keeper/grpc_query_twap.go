@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) ArithmeticTwap(
+	goCtx context.Context,
+	req *types.QueryArithmeticTwapRequest,
+) (*types.QueryArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	startRecord, endRecord, endTime, err := k.getTwapRecordsForRange(ctx, req.TokenIn, req.TokenOut, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	twap := types.ComputeArithmeticTwap(startRecord, endRecord, req.StartTime, endTime)
+
+	return &types.QueryArithmeticTwapResponse{ArithmeticTwap: twap}, nil
+}
+
+func (k Keeper) GeometricTwap(
+	goCtx context.Context,
+	req *types.QueryGeometricTwapRequest,
+) (*types.QueryGeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	startRecord, endRecord, endTime, err := k.getTwapRecordsForRange(ctx, req.TokenIn, req.TokenOut, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	twap, err := types.ComputeGeometricTwap(startRecord, endRecord, req.StartTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGeometricTwapResponse{GeometricTwap: twap}, nil
+}
+
+// getTwapRecordsForRange returns the latest twapRecords at or before startTime and endTime. If endTime is nil the
+// current block time is used.
+func (k Keeper) getTwapRecordsForRange(
+	ctx sdk.Context,
+	tokenIn, tokenOut string,
+	startTime time.Time,
+	endTimeP *time.Time,
+) (startRecord, endRecord types.TwapRecord, endTime time.Time, err error) {
+	tradePairID, err := types.NewTradePairID(tokenIn, tokenOut)
+	if err != nil {
+		return startRecord, endRecord, endTime, err
+	}
+
+	endTime = ctx.BlockTime()
+	if endTimeP != nil {
+		endTime = *endTimeP
+	}
+
+	if startTime.After(endTime) || endTime.After(ctx.BlockTime()) {
+		return startRecord, endRecord, endTime, types.ErrInvalidTwapTimeRange
+	}
+
+	startRecord, found := k.GetTwapRecordAtOrBefore(ctx, tradePairID, startTime)
+	if !found {
+		return startRecord, endRecord, endTime, types.ErrNoTwapRecord
+	}
+
+	endRecord, _ = k.GetTwapRecordAtOrBefore(ctx, tradePairID, endTime)
+
+	return startRecord, endRecord, endTime, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"time"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (s *DexTestSuite) setupTwapPriceHistory(startTime time.Time) {
+	s.fundAliceBalances(20, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN TokenB liquidity at tick -10 and tick 0
+	s.bobLimitSells("TokenB", -10, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// WHEN alice swaps at startTime leaving liquidity at tick -10
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 5, math_utils.MustNewPrecDecFromStr("0.5"), false)
+
+	// AND alice swaps 10s later using up the liquidity at tick -10
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(10 * time.Second))
+	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 10, math_utils.MustNewPrecDecFromStr("0.5"), false)
+
+	// AND 10 more seconds pass
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(20 * time.Second))
+}
+
+func (s *DexTestSuite) TestArithmeticTwap() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+
+	// THEN the arithmetic TWAP is the mean of the price at tick -10 and tick 0
+	resp, err := s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		StartTime: startTime,
+	})
+	s.Require().NoError(err)
+	expected := types.MustCalcPrice(-10).Add(math_utils.OnePrecDec()).QuoInt64(2)
+	s.Assert().Equal(expected, resp.ArithmeticTwap)
+
+	// AND the TWAP over the first 10s is the price at tick -10
+	endTime := startTime.Add(10 * time.Second)
+	resp, err = s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		StartTime: startTime,
+		EndTime:   &endTime,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(types.MustCalcPrice(-10), resp.ArithmeticTwap)
+
+	// AND a zero length range returns the spot price at that time
+	resp, err = s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		StartTime: endTime,
+		EndTime:   &endTime,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(math_utils.OnePrecDec(), resp.ArithmeticTwap)
+}
+
+func (s *DexTestSuite) TestGeometricTwap() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+
+	// THEN the geometric TWAP is the price at the mean tick
+	resp, err := s.App.DexKeeper.GeometricTwap(s.Ctx, &types.QueryGeometricTwapRequest{
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		StartTime: startTime,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(types.MustCalcPrice(-5), resp.GeometricTwap)
+}
+
+func (s *DexTestSuite) TestTwapInvalidRange() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	s.setupTwapPriceHistory(startTime)
+
+	// THEN a start time before the first record fails
+	_, err := s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		StartTime: startTime.Add(-time.Second),
+	})
+	s.Assert().ErrorIs(err, types.ErrNoTwapRecord)
+
+	// AND an end time after the current block time fails
+	endTime := s.Ctx.BlockTime().Add(time.Second)
+	_, err = s.App.DexKeeper.GeometricTwap(s.Ctx, &types.QueryGeometricTwapRequest{
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		StartTime: startTime,
+		EndTime:   &endTime,
+	})
+	s.Assert().ErrorIs(err, types.ErrInvalidTwapTimeRange)
+
+	// AND the reverse trade pair has no records
+	_, err = s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		TokenIn:   "TokenB",
+		TokenOut:  "TokenA",
+		StartTime: startTime,
+	})
+	s.Assert().ErrorIs(err, types.ErrNoTwapRecord)
+}
+
+func (s *DexTestSuite) TestTwapRecordPruning() {
+	s.fundAliceBalances(3, 0)
+	s.fundBobBalances(0, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// GIVEN swaps at t0, t0 + 1h and t0 + 50h
+	startTime := time.Unix(1_000_000, 0).UTC()
+	for _, offset := range []time.Duration{0, time.Hour, 50 * time.Hour} {
+		s.Ctx = s.Ctx.WithBlockTime(startTime.Add(offset))
+		s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 1, math_utils.MustNewPrecDecFromStr("0.5"), false)
+	}
+
+	// THEN only the latest record older than the keep period is retained
+	records := s.App.DexKeeper.GetAllTwapRecord(s.Ctx)
+	s.Require().Len(records, 2)
+	s.Assert().Equal(startTime.Add(time.Hour), records[0].Time)
+	s.Assert().Equal(startTime.Add(50*time.Hour), records[1].Time)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (k Keeper) UserFillRecordsAll(
+	goCtx context.Context,
+	req *types.QueryAllUserFillRecordsRequest,
+) (*types.QueryAllUserFillRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var fillRecords []types.FillRecord
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keyPrefix := types.FillRecordAddressPrefix(req.Address)
+	if req.TrancheKey != "" {
+		keyPrefix = types.FillRecordTrancheUserPrefix(req.Address, req.TrancheKey)
+	}
+	fillRecordStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	pageRes, err := query.Paginate(fillRecordStore, req.Pagination, func(_, value []byte) error {
+		var fillRecord types.FillRecord
+		if err := k.cdc.Unmarshal(value, &fillRecord); err != nil {
+			return err
+		}
+
+		fillRecords = append(fillRecords, fillRecord)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllUserFillRecordsResponse{FillRecords: fillRecords, Pagination: pageRes}, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	"github.com/neutron-org/neutron/v4/testutil/common/sample"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func TestUserFillRecordsQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	address := sample.AccAddress()
+	msgs := createNFillRecord(keeper, ctx, address, 4)
+	createNFillRecord(keeper, ctx, sample.AccAddress(), 4)
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryAllUserFillRecordsRequest
+		response []types.FillRecord
+		err      error
+	}{
+		{
+			desc:     "ByAddress",
+			request:  &types.QueryAllUserFillRecordsRequest{Address: address},
+			response: msgs,
+		},
+		{
+			desc:     "ByTrancheKey",
+			request:  &types.QueryAllUserFillRecordsRequest{Address: address, TrancheKey: "tranche1"},
+			response: []types.FillRecord{msgs[1], msgs[3]},
+		},
+		{
+			desc:     "NoRecords",
+			request:  &types.QueryAllUserFillRecordsRequest{Address: sample.AccAddress()},
+			response: nil,
+		},
+		{
+			desc:    "InvalidAddress",
+			request: &types.QueryAllUserFillRecordsRequest{Address: "invalid"},
+			err:     status.Error(codes.InvalidArgument, "decoding bech32 failed: invalid bech32 string length 7"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.UserFillRecordsAll(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.ElementsMatch(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response.FillRecords),
+				)
+			}
+		})
+	}
+}
+
+func TestUserFillRecordsQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	address := sample.AccAddress()
+	msgs := createNFillRecord(keeper, ctx, address, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllUserFillRecordsRequest {
+		return &types.QueryAllUserFillRecordsRequest{
+			Address: address,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.UserFillRecordsAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FillRecords), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FillRecords),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.UserFillRecordsAll(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FillRecords), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FillRecords),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.UserFillRecordsAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.FillRecords),
+		)
+	})
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/utils"
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+	dexutils "github.com/neutron-org/neutron/v4/x/dex/utils"
+)
+
+func (k Keeper) UserPositionValuesAll(
+	goCtx context.Context,
+	req *types.QueryAllUserPositionValuesRequest,
+) (*types.QueryAllUserPositionValuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.QuoteDenom != "" {
+		if err := sdk.ValidateDenom(req.QuoteDenom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var positions []types.PositionValue
+
+	pageRes, err := utils.FilteredPaginateAccountBalances(
+		ctx,
+		k.bankKeeper,
+		addr,
+		req.Pagination,
+		func(poolCoinMaybe sdk.Coin, accumulate bool) bool {
+			err := types.ValidatePoolDenom(poolCoinMaybe.Denom)
+			if err != nil {
+				return false
+			}
+
+			poolMetadata, err := k.GetPoolMetadataByDenom(ctx, poolCoinMaybe.Denom)
+			if err != nil {
+				panic("Can't get info for PoolDenom")
+			}
+
+			fee := dexutils.MustSafeUint64ToInt64(poolMetadata.Fee)
+
+			if accumulate {
+				depositRecord := &types.DepositRecord{
+					PairId:          poolMetadata.PairId,
+					SharesOwned:     poolCoinMaybe.Amount,
+					CenterTickIndex: poolMetadata.Tick,
+					LowerTickIndex:  poolMetadata.Tick - fee,
+					UpperTickIndex:  poolMetadata.Tick + fee,
+					Fee:             poolMetadata.Fee,
+				}
+				k.addPoolData(ctx, depositRecord)
+
+				positions = append(positions, k.calcPositionValue(ctx, req.Address, depositRecord, req.QuoteDenom))
+			}
+
+			return true
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllUserPositionValuesResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// calcPositionValue values the shares of depositRecord, which must include its pool data. Fees earned are the gain in
+// value at the pool's center price of the shares with a cost basis, since that value only grows through swap fees.
+func (k Keeper) calcPositionValue(
+	ctx sdk.Context,
+	address string,
+	depositRecord *types.DepositRecord,
+	quoteDenom string,
+) types.PositionValue {
+	pool := depositRecord.Pool
+	totalShares := *depositRecord.TotalShares
+
+	amount0, amount1 := pool.RedeemValue(depositRecord.SharesOwned, totalShares)
+	positionValue := types.PositionValue{
+		Deposit:    depositRecord,
+		Amount0:    amount0,
+		Amount1:    amount1,
+		CostBasis0: math.ZeroInt(),
+		CostBasis1: math.ZeroInt(),
+		FeesEarned: math_utils.ZeroPrecDec(),
+	}
+
+	costBasis, found := k.GetDepositCostBasis(ctx, address, pool.Id)
+	if found && costBasis.Shares.IsPositive() {
+		// Shares may have been transferred away, in which case only the cost basis of the remaining shares is used
+		sharesWithCostBasis := math.MinInt(depositRecord.SharesOwned, costBasis.Shares)
+		positionValue.CostBasis0 = costBasis.Amount0.Mul(sharesWithCostBasis).Quo(costBasis.Shares)
+		positionValue.CostBasis1 = costBasis.Amount1.Mul(sharesWithCostBasis).Quo(costBasis.Shares)
+
+		price1To0Center := pool.MustCalcPrice1To0Center()
+		value0, value1 := pool.RedeemValue(sharesWithCostBasis, totalShares)
+		positionValue.FeesEarned = types.CalcAmountAsToken0(value0, value1, price1To0Center).
+			Sub(types.CalcAmountAsToken0(positionValue.CostBasis0, positionValue.CostBasis1, price1To0Center))
+	}
+
+	if quoteDenom == "" {
+		return positionValue
+	}
+
+	price0, found0 := k.GetCurrPriceInQuote(ctx, depositRecord.PairId.Token0, quoteDenom)
+	price1, found1 := k.GetCurrPriceInQuote(ctx, depositRecord.PairId.Token1, quoteDenom)
+	if found0 && found1 {
+		valueInQuote := price0.MulInt(amount0).Add(price1.MulInt(amount1))
+		positionValue.ValueInQuote = &valueInQuote
+	}
+	if found0 {
+		feesEarnedInQuote := positionValue.FeesEarned.Mul(price0)
+		positionValue.FeesEarnedInQuote = &feesEarnedInQuote
+	}
+
+	return positionValue
+}
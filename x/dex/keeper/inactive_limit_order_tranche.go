@@ -66,5 +66,6 @@ func (k Keeper) SaveInactiveTranche(sdkCtx sdk.Context, tranche *types.LimitOrde
 			sdkCtx,
 			tranche.Key,
 		)
+		k.DecPairRefCount(sdkCtx, tranche.Key.TradePairId.MustPairID())
 	}
 }
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceBatchCancelsLimitSells(trancheKeys ...string) []*types.FailedLimitOrder {
+	resp, err := s.msgServer.BatchCancelLimitOrders(
+		s.Ctx,
+		types.NewMsgBatchCancelLimitOrders(s.alice.String(), trancheKeys),
+	)
+	s.Assert().NoError(err)
+
+	return resp.FailedLimitOrders
+}
+
+func (s *DexTestSuite) aliceBatchWithdrawsLimitSells(trancheKeys ...string) []*types.FailedLimitOrder {
+	resp, err := s.msgServer.BatchWithdrawFilledLimitOrders(
+		s.Ctx,
+		types.NewMsgBatchWithdrawFilledLimitOrders(s.alice.String(), trancheKeys),
+	)
+	s.Assert().NoError(err)
+
+	return resp.FailedLimitOrders
+}
+
+func (s *DexTestSuite) aliceCancelsAllLimitSells(tokenA, tokenB string) *types.MsgCancelAllLimitOrdersResponse {
+	resp, err := s.msgServer.CancelAllLimitOrders(
+		s.Ctx,
+		types.NewMsgCancelAllLimitOrders(s.alice.String(), tokenA, tokenB),
+	)
+	s.Assert().NoError(err)
+
+	return resp
+}
+
+func (s *DexTestSuite) TestBatchCancelLimitOrders() {
+	s.fundAliceBalances(50, 50)
+
+	// GIVEN alice has three limit orders
+	trancheKey0 := s.aliceLimitSells("TokenA", 0, 10)
+	trancheKey1 := s.aliceLimitSells("TokenA", -5, 10)
+	trancheKey2 := s.aliceLimitSells("TokenB", 10, 10)
+	s.assertAliceBalances(30, 40)
+
+	// WHEN alice cancels two of them along with a tranche key that does not exist
+	failed := s.aliceBatchCancelsLimitSells(trancheKey0, "BADKEY", trancheKey2)
+
+	// THEN the valid orders are cancelled and the invalid one is reported
+	s.Assert().Len(failed, 1)
+	s.Assert().Equal("BADKEY", failed[0].TrancheKey)
+	s.Assert().Contains(failed[0].Error, types.ErrActiveLimitOrderNotFound.Error())
+
+	s.assertAliceBalances(40, 50)
+	s.assertDexBalances(10, 0)
+	s.assertAliceLimitLiquidityAtTick("TokenA", 10, -5)
+	_, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey1)
+	s.Assert().True(found)
+}
+
+func (s *DexTestSuite) TestBatchWithdrawFilledLimitOrders() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+
+	// GIVEN alice has a filled limit order and an unfilled limit order
+	trancheKey0 := s.aliceLimitSells("TokenA", 0, 10)
+	trancheKey1 := s.aliceLimitSells("TokenB", 5, 10)
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+	s.assertAliceBalances(40, 40)
+
+	// WHEN alice withdraws both
+	failed := s.aliceBatchWithdrawsLimitSells(trancheKey0, trancheKey1)
+
+	// THEN the filled order is withdrawn and the unfilled order is reported
+	s.Assert().Len(failed, 1)
+	s.Assert().Equal(trancheKey1, failed[0].TrancheKey)
+	s.Assert().Contains(failed[0].Error, types.ErrWithdrawEmptyLimitOrder.Error())
+
+	s.assertAliceBalances(40, 50)
+	s.assertDexBalances(0, 10)
+	s.assertAliceLimitLiquidityAtTick("TokenB", 10, 5)
+}
+
+func (s *DexTestSuite) TestBatchLimitOrdersAllFail() {
+	s.fundAliceBalances(50, 50)
+
+	// WHEN alice cancels orders that do not exist
+	failed := s.aliceBatchCancelsLimitSells("BADKEY1", "BADKEY2")
+
+	// THEN both are reported and the message succeeds
+	s.Assert().Len(failed, 2)
+	s.assertAliceBalances(50, 50)
+}
+
+func (s *DexTestSuite) TestCancelAllLimitOrders() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+	s.fundAccountBalancesWithDenom(s.alice, sdk.NewCoins(sdk.NewCoin("TokenC", sdkmath.NewInt(10).Mul(denomMultiple))))
+
+	// GIVEN alice has a filled limit order and two open limit orders on A<>B
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+	trancheKeyA := s.aliceLimitSells("TokenA", 2, 10)
+	trancheKeyB := s.aliceLimitSells("TokenB", 5, 10)
+
+	// AND bob has an open limit order on A<>B
+	s.bobLimitSells("TokenB", 3, 10)
+
+	// AND alice has an open limit order on A<>C
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          "TokenC",
+		TokenOut:         "TokenA",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+	})
+	s.Require().NoError(err)
+	s.assertAliceBalances(30, 40)
+
+	// WHEN alice cancels all her orders on A<>B
+	resp := s.aliceCancelsAllLimitSells("TokenB", "TokenA")
+
+	// THEN both open orders are cancelled and the filled order is skipped
+	s.Assert().ElementsMatch([]string{trancheKeyA, trancheKeyB}, resp.CancelledTrancheKeys)
+	s.Assert().Empty(resp.FailedLimitOrders)
+	s.assertAliceBalances(40, 50)
+
+	// AND bob's order and alice's order on A<>C are untouched
+	s.assertLimitLiquidityAtTick("TokenB", 3, 10)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenC", 0)
+}
+
+func (s *DexTestSuite) TestCancelAllLimitOrdersNoOrders() {
+	// WHEN alice cancels all orders without having any
+	resp := s.aliceCancelsAllLimitSells("TokenA", "TokenB")
+
+	// THEN nothing is cancelled
+	s.Assert().Empty(resp.CancelledTrancheKeys)
+	s.Assert().Empty(resp.FailedLimitOrders)
+}
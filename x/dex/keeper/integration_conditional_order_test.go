@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) aliceConditionalSells(
+	trigger types.ConditionalOrderTrigger,
+	triggerTick, limitTick int64,
+	amountIn int,
+	orderType types.LimitOrderType,
+) uint64 {
+	resp, err := s.msgServer.PlaceConditionalOrder(s.Ctx, &types.MsgPlaceConditionalOrder{
+		Creator:        s.alice.String(),
+		Receiver:       s.alice.String(),
+		TokenIn:        "TokenA",
+		TokenOut:       "TokenB",
+		AmountIn:       sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:      orderType,
+		LimitSellPrice: types.MustCalcPrice(limitTick),
+		Trigger:        trigger,
+		TriggerPrice:   types.MustCalcPrice(triggerTick),
+	})
+	s.Assert().NoError(err)
+
+	return resp.Id
+}
+
+func (s *DexTestSuite) assertConditionalOrderExists(id uint64, exists bool) {
+	_, found := s.App.DexKeeper.GetConditionalOrder(s.Ctx, id)
+	s.Assert().Equal(exists, found)
+}
+
+// Tests
+
+func (s *DexTestSuite) TestConditionalOrderStopLossTriggered() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN TokenB liquidity at tick 0 and at the better tick -10
+	s.bobLimitSells("TokenB", 0, 10)
+	trancheKey := s.bobLimitSells("TokenB", -10, 10)
+
+	// WHEN alice places a stop loss that triggers once the price drops below tick -5
+	id := s.aliceConditionalSells(types.ConditionalOrderTrigger_STOP_LOSS, -5, 0, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN funds are escrowed and the order is not triggered
+	s.assertAliceBalances(5, 0)
+	s.assertDexBalances(5, 20)
+	s.beginBlockWithTime(time.Now())
+	s.assertConditionalOrderExists(id, true)
+	s.assertAliceBalances(5, 0)
+
+	// WHEN the best liquidity is removed and the price drops to tick 0
+	s.bobCancelsLimitSell(trancheKey)
+	s.beginBlockWithTime(time.Now())
+
+	// THEN the order is executed against the liquidity at tick 0
+	s.assertConditionalOrderExists(id, false)
+	s.assertAliceBalances(5, 5)
+	s.assertDexBalances(5, 5)
+}
+
+func (s *DexTestSuite) TestConditionalOrderTakeProfitTriggered() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN TokenB liquidity at tick 0
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// WHEN alice places a take profit that triggers once the price rises above tick -5
+	id := s.aliceConditionalSells(types.ConditionalOrderTrigger_TAKE_PROFIT, -5, -5, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.beginBlockWithTime(time.Now())
+
+	// THEN the order is not triggered
+	s.assertConditionalOrderExists(id, true)
+	s.assertAliceBalances(5, 0)
+
+	// WHEN liquidity is added at tick -10
+	s.bobLimitSells("TokenB", -10, 10)
+	s.beginBlockWithTime(time.Now())
+
+	// THEN the order is executed
+	s.assertConditionalOrderExists(id, false)
+	s.assertAliceBalancesInt(sdkmath.NewInt(5_000_000), sdkmath.NewInt(5_005_002))
+}
+
+func (s *DexTestSuite) TestConditionalOrderFailedExecutionRefunds() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN TokenB liquidity at tick -10 and only a small amount at tick 0
+	s.bobLimitSells("TokenB", 0, 1)
+	trancheKey := s.bobLimitSells("TokenB", -10, 10)
+
+	// WHEN alice places a FILL_OR_KILL stop loss larger than the liquidity at its limit price
+	id := s.aliceConditionalSells(types.ConditionalOrderTrigger_STOP_LOSS, -5, 0, 5, types.LimitOrderType_FILL_OR_KILL)
+	s.assertAliceBalances(5, 0)
+
+	// AND the order is triggered
+	s.bobCancelsLimitSell(trancheKey)
+	s.beginBlockWithTime(time.Now())
+
+	// THEN the order is removed and alice is refunded
+	s.assertConditionalOrderExists(id, false)
+	s.assertAliceBalances(10, 0)
+	s.assertDexBalances(0, 1)
+}
+
+func (s *DexTestSuite) TestConditionalOrderCancel() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice places a conditional order
+	id := s.aliceConditionalSells(types.ConditionalOrderTrigger_STOP_LOSS, 0, 10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertAliceBalances(5, 0)
+	s.assertDexBalances(5, 0)
+
+	// WHEN bob tries to cancel it
+	_, err := s.msgServer.CancelConditionalOrder(s.Ctx, &types.MsgCancelConditionalOrder{
+		Creator: s.bob.String(),
+		Id:      id,
+	})
+
+	// THEN it fails
+	s.Assert().ErrorIs(err, types.ErrConditionalOrderNotFound)
+
+	// WHEN alice cancels it
+	_, err = s.msgServer.CancelConditionalOrder(s.Ctx, &types.MsgCancelConditionalOrder{
+		Creator: s.alice.String(),
+		Id:      id,
+	})
+	s.Assert().NoError(err)
+
+	// THEN the order is removed and alice is refunded
+	s.assertConditionalOrderExists(id, false)
+	s.assertAliceBalances(10, 0)
+	s.assertDexBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestConditionalOrderNoLiquidityNotTriggered() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice places a conditional order on a pair with no liquidity
+	id := s.aliceConditionalSells(types.ConditionalOrderTrigger_STOP_LOSS, 0, 10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN a block is processed
+	s.beginBlockWithTime(time.Now())
+
+	// THEN the order remains
+	s.assertConditionalOrderExists(id, true)
+	s.assertAliceBalances(5, 0)
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) aliceDepositsInPair(pairID types.PairID, deposits ...*Deposit) {
+	amount0, amount1 := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, deposit := range deposits {
+		amount0 = amount0.Add(deposit.AmountA)
+		amount1 = amount1.Add(deposit.AmountB)
+	}
+	s.fundAccountBalancesWithDenom(s.alice, sdk.NewCoins(
+		sdk.NewCoin(pairID.Token0, amount0),
+		sdk.NewCoin(pairID.Token1, amount1),
+	))
+
+	s.depositsSuccess(s.alice, deposits, pairID)
+}
+
+func (s *DexTestSuite) getPairsByDenom(denom string) []*types.PairID {
+	resp, err := s.App.DexKeeper.PairsByDenom(s.Ctx, &types.QueryPairsByDenomRequest{Denom: denom})
+	s.Require().NoError(err)
+
+	return resp.PairIds
+}
+
+func (s *DexTestSuite) getPoolIDsByDenom(denom string) []uint64 {
+	resp, err := s.App.DexKeeper.PoolsByDenom(s.Ctx, &types.QueryPoolsByDenomRequest{Denom: denom})
+	s.Require().NoError(err)
+
+	poolIDs := make([]uint64, len(resp.Pools))
+	for i, pool := range resp.Pools {
+		poolIDs[i] = pool.Id
+	}
+
+	return poolIDs
+}
+
+func (s *DexTestSuite) getActivePairs() []*types.PairID {
+	resp, err := s.App.DexKeeper.ActivePairs(s.Ctx, &types.QueryActivePairsRequest{})
+	s.Require().NoError(err)
+
+	return resp.PairIds
+}
+
+// Tests
+
+func (s *DexTestSuite) TestPairsAndPoolsByDenom() {
+	pairAB := types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	pairBC := types.PairID{Token0: "TokenB", Token1: "TokenC"}
+
+	// GIVEN alice deposits into two pools of TokenA<>TokenB and one pool of TokenB<>TokenC
+	s.aliceDepositsInPair(pairAB, NewDeposit(10, 10, 0, 1), NewDeposit(10, 10, 0, 5))
+	s.aliceDepositsInPair(pairBC, NewDeposit(10, 10, 0, 1))
+
+	// THEN the pairs and pools are indexed by both of their denoms
+	s.Equal([]*types.PairID{&pairAB}, s.getPairsByDenom("TokenA"))
+	s.Equal([]*types.PairID{&pairAB, &pairBC}, s.getPairsByDenom("TokenB"))
+	s.Equal([]*types.PairID{&pairBC}, s.getPairsByDenom("TokenC"))
+	s.Empty(s.getPairsByDenom("TokenD"))
+
+	s.Equal([]uint64{0, 1}, s.getPoolIDsByDenom("TokenA"))
+	s.Equal([]uint64{0, 1, 2}, s.getPoolIDsByDenom("TokenB"))
+	s.Equal([]uint64{2}, s.getPoolIDsByDenom("TokenC"))
+}
+
+func (s *DexTestSuite) TestPairsAndPoolsByDenomIgnoresLongerDenoms() {
+	pairAB := types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	pairAB2 := types.PairID{Token0: "TokenA", Token1: "TokenB/2"}
+
+	// GIVEN alice deposits into TokenA<>TokenB and TokenA<>TokenB/2
+	s.aliceDepositsInPair(pairAB, NewDeposit(10, 10, 0, 1))
+	s.aliceDepositsInPair(pairAB2, NewDeposit(10, 10, 0, 1))
+
+	// THEN querying TokenB does not return the pair or pool of TokenB/2
+	s.Equal([]*types.PairID{&pairAB}, s.getPairsByDenom("TokenB"))
+	s.Equal([]uint64{0}, s.getPoolIDsByDenom("TokenB"))
+	s.Equal([]*types.PairID{&pairAB2}, s.getPairsByDenom("TokenB/2"))
+	s.Equal([]uint64{1}, s.getPoolIDsByDenom("TokenB/2"))
+}
+
+func (s *DexTestSuite) TestPairsByDenomPagination() {
+	pairAB := types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	pairBC := types.PairID{Token0: "TokenB", Token1: "TokenC"}
+
+	// GIVEN TokenB is traded in two pairs
+	s.aliceDepositsInPair(pairAB, NewDeposit(10, 10, 0, 1))
+	s.aliceDepositsInPair(pairBC, NewDeposit(10, 10, 0, 1))
+
+	// WHEN the pairs of TokenB are queried one at a time
+	resp, err := s.App.DexKeeper.PairsByDenom(s.Ctx, &types.QueryPairsByDenomRequest{
+		Denom:      "TokenB",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+
+	// THEN the first page has the first pair and the total counts both pairs
+	s.Equal([]*types.PairID{&pairAB}, resp.PairIds)
+	s.Equal(uint64(2), resp.Pagination.Total)
+
+	resp, err = s.App.DexKeeper.PairsByDenom(s.Ctx, &types.QueryPairsByDenomRequest{
+		Denom:      "TokenB",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Equal([]*types.PairID{&pairBC}, resp.PairIds)
+	s.Nil(resp.Pagination.NextKey)
+}
+
+func (s *DexTestSuite) TestPairsByDenomLimitOrderLifecycle() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	pairAB := types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	pairBC := types.PairID{Token0: "TokenB", Token1: "TokenC"}
+
+	// GIVEN alice has a limit order in TokenA<>TokenB and a pool in TokenB<>TokenC
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.aliceDepositsInPair(pairBC, NewDeposit(10, 10, 0, 1))
+
+	// THEN both pairs are indexed and active
+	s.Equal([]*types.PairID{&pairAB}, s.getPairsByDenom("TokenA"))
+	s.Empty(s.getPoolIDsByDenom("TokenA"))
+	s.Equal([]*types.PairID{&pairAB, &pairBC}, s.getActivePairs())
+
+	// WHEN bob fills the limit order
+	s.bobLimitSells("TokenB", -1, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN TokenA<>TokenB has no liquidity but is still indexed until alice withdraws
+	s.Equal([]*types.PairID{&pairBC}, s.getActivePairs())
+	s.Equal([]*types.PairID{&pairAB}, s.getPairsByDenom("TokenA"))
+
+	// WHEN alice withdraws her filled limit order
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN TokenA<>TokenB is no longer indexed
+	s.Empty(s.getPairsByDenom("TokenA"))
+	s.Equal([]*types.PairID{&pairBC}, s.getPairsByDenom("TokenB"))
+	s.Equal([]*types.PairID{&pairBC}, s.getActivePairs())
+}
+
+func (s *DexTestSuite) TestPairsByDenomIgnoresTakerOnlyOrders() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice has a limit order in TokenA<>TokenB
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob fills it with a taker only order and alice withdraws
+	s.bobLimitSells("TokenB", -1, 10, types.LimitOrderType_FILL_OR_KILL)
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN the pair is not indexed
+	s.Empty(s.getPairsByDenom("TokenA"))
+	s.Empty(s.getPairsByDenom("TokenB"))
+	s.Empty(s.getActivePairs())
+	s.Equal(uint64(0), s.App.DexKeeper.GetPairRefCount(s.Ctx, &types.PairID{Token0: "TokenA", Token1: "TokenB"}))
+}
+
+func (s *DexTestSuite) TestPairsByDenomCancelledLimitOrder() {
+	s.fundAliceBalances(10, 0)
+	pairAB := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+
+	// GIVEN alice has a limit order in TokenA<>TokenB
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.Equal([]*types.PairID{pairAB}, s.getPairsByDenom("TokenA"))
+
+	// WHEN alice cancels it before it is filled
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// THEN the empty tranche is deleted and the pair is no longer indexed
+	_, _, found := s.App.DexKeeper.FindLimitOrderTranche(s.Ctx, &types.LimitOrderTrancheKey{
+		TradePairId:           types.MustNewTradePairID("TokenB", "TokenA"),
+		TickIndexTakerToMaker: 0,
+		TrancheKey:            trancheKey,
+	})
+	s.False(found)
+	s.Empty(s.getPairsByDenom("TokenA"))
+	s.Empty(s.getPairsByDenom("TokenB"))
+	s.Equal(uint64(0), s.App.DexKeeper.GetPairRefCount(s.Ctx, pairAB))
+}
+
+func (s *DexTestSuite) TestPairsByDenomExpiredLimitOrder() {
+	s.fundAliceBalances(10, 0)
+	pairAB := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+
+	// GIVEN alice has an expiring limit order in TokenA<>TokenB
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 10, time.Now())
+
+	// WHEN it is purged before it is filled
+	s.App.DexKeeper.PurgeExpiredLimitOrders(s.Ctx, time.Now())
+
+	// THEN the pair is still indexed until alice withdraws the unfilled amount
+	s.Equal([]*types.PairID{pairAB}, s.getPairsByDenom("TokenA"))
+	s.Equal(uint64(1), s.App.DexKeeper.GetPairRefCount(s.Ctx, pairAB))
+
+	// WHEN alice withdraws it
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN the pair is no longer indexed
+	s.Empty(s.getPairsByDenom("TokenA"))
+	s.Empty(s.getPairsByDenom("TokenB"))
+	s.Equal(uint64(0), s.App.DexKeeper.GetPairRefCount(s.Ctx, pairAB))
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) aliceDepositsRange(
+	amountA, amountB int,
+	lowerTick, upperTick int64,
+	fee uint64,
+	shape types.LiquidityShape,
+) (*types.MsgDepositRangeResponse, error) {
+	// A higher tick index corresponds to a lower price
+	return s.msgServer.DepositRange(s.Ctx, &types.MsgDepositRange{
+		Creator:    s.alice.String(),
+		Receiver:   s.alice.String(),
+		TokenA:     "TokenA",
+		TokenB:     "TokenB",
+		AmountA:    sdkmath.NewInt(int64(amountA)).Mul(denomMultiple),
+		AmountB:    sdkmath.NewInt(int64(amountB)).Mul(denomMultiple),
+		LowerPrice: types.MustCalcPrice(upperTick),
+		UpperPrice: types.MustCalcPrice(lowerTick),
+		Fee:        fee,
+		Shape:      shape,
+		Options:    &types.DepositOptions{},
+	})
+}
+
+func (s *DexTestSuite) aliceWithdrawsRange(lowerTick, upperTick int64, fee uint64) error {
+	_, err := s.msgServer.WithdrawRange(s.Ctx, &types.MsgWithdrawRange{
+		Creator:    s.alice.String(),
+		Receiver:   s.alice.String(),
+		TokenA:     "TokenA",
+		TokenB:     "TokenB",
+		LowerPrice: types.MustCalcPrice(upperTick),
+		UpperPrice: types.MustCalcPrice(lowerTick),
+		Fee:        fee,
+	})
+	return err
+}
+
+func (s *DexTestSuite) assertLiquidityAtTicksInt(tickIndexes []int64, fee uint64, amountsA, amountsB []int64) {
+	for i, tickIndex := range tickIndexes {
+		s.assertLiquidityAtTickInt(sdkmath.NewInt(amountsA[i]), sdkmath.NewInt(amountsB[i]), tickIndex, fee)
+	}
+}
+
+// Tests
+
+func (s *DexTestSuite) TestDepositRangeUniform() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice deposits TokenA uniformly across ticks -10 to 10
+	resp, err := s.aliceDepositsRange(10, 0, -10, 10, 5, types.LiquidityShape_UNIFORM)
+	s.NoError(err)
+
+	// THEN the deposit is split evenly across pools spaced 2*fee ticks apart
+	s.Equal([]int64{-10, 0, 10}, resp.TickIndexesAToB)
+	s.assertLiquidityAtTicksInt(
+		[]int64{-10, 0, 10},
+		5,
+		[]int64{3_333_334, 3_333_333, 3_333_333},
+		[]int64{0, 0, 0},
+	)
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestDepositRangeNormal() {
+	s.fundAliceBalances(16, 0)
+
+	// WHEN alice deposits TokenA with a normal shape across ticks -20 to 20
+	resp, err := s.aliceDepositsRange(16, 0, -20, 20, 5, types.LiquidityShape_NORMAL)
+	s.NoError(err)
+
+	// THEN the deposit is concentrated in the middle of the range
+	s.Len(resp.TickIndexesAToB, 5)
+	s.assertLiquidityAtTicksInt(
+		resp.TickIndexesAToB,
+		5,
+		[]int64{1_000_000, 4_000_000, 6_000_000, 4_000_000, 1_000_000},
+		[]int64{0, 0, 0, 0, 0},
+	)
+}
+
+func (s *DexTestSuite) TestDepositRangeCurve() {
+	s.fundAliceBalances(0, 19)
+
+	// WHEN alice deposits TokenB with a curve shape into a pair with no liquidity
+	resp, err := s.aliceDepositsRange(0, 19, -20, 20, 5, types.LiquidityShape_CURVE)
+	s.NoError(err)
+
+	// THEN the deposit tapers off linearly from the middle of the range
+	s.Len(resp.TickIndexesAToB, 5)
+	s.assertLiquidityAtTicksInt(
+		resp.TickIndexesAToB,
+		5,
+		[]int64{0, 0, 0, 0, 0},
+		[]int64{3_000_000, 4_000_000, 5_000_000, 4_000_000, 3_000_000},
+	)
+}
+
+func (s *DexTestSuite) TestDepositRangeAvoidsBehindEnemyLines() {
+	s.fundAliceBalances(30, 30)
+	s.fundBobBalances(10, 10)
+
+	// GIVEN existing liquidity around tick 0
+	s.bobDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice deposits both tokens across ticks -20 to 20
+	resp, err := s.aliceDepositsRange(30, 30, -20, 20, 5, types.LiquidityShape_UNIFORM)
+	s.NoError(err)
+
+	// THEN TokenA is only placed below the current price and TokenB only above it
+	s.Len(resp.TickIndexesAToB, 5)
+	s.assertLiquidityAtTick(10, 0, resp.TickIndexesAToB[0], 5)
+	s.assertLiquidityAtTick(10, 0, resp.TickIndexesAToB[1], 5)
+	s.assertLiquidityAtTick(0, 10, resp.TickIndexesAToB[3], 5)
+	s.assertLiquidityAtTick(0, 10, resp.TickIndexesAToB[4], 5)
+	s.assertAliceBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestDepositRangeFailsWhenAllBehindEnemyLines() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 10)
+
+	// GIVEN existing liquidity around tick 0
+	s.bobDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice deposits TokenA only in a range above the current price
+	_, err := s.aliceDepositsRange(10, 0, 20, 40, 5, types.LiquidityShape_UNIFORM)
+
+	// THEN the deposit fails
+	s.ErrorIs(err, types.ErrDepositBehindEnemyLines)
+	s.assertAliceBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestWithdrawRange() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has a range position
+	resp, err := s.aliceDepositsRange(10, 0, -10, 10, 5, types.LiquidityShape_NORMAL)
+	s.NoError(err)
+	s.assertAliceBalances(0, 10)
+
+	// WHEN alice withdraws the range
+	err = s.aliceWithdrawsRange(-10, 10, 5)
+	s.NoError(err)
+
+	// THEN the whole position is withdrawn
+	s.assertAliceBalances(10, 10)
+	s.assertDexBalances(0, 0)
+	for _, tickIndex := range resp.TickIndexesAToB {
+		s.assertNoLiquidityAtTick(tickIndex, 5)
+	}
+}
+
+func (s *DexTestSuite) TestWithdrawRangeNoPositionFails() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice has a range position
+	_, err := s.aliceDepositsRange(10, 0, -10, 10, 5, types.LiquidityShape_UNIFORM)
+	s.NoError(err)
+
+	// WHEN alice withdraws a range she has no shares in
+	err = s.aliceWithdrawsRange(20, 40, 5)
+
+	// THEN the withdrawal fails
+	s.ErrorIs(err, types.ErrNoRangePosition)
+}
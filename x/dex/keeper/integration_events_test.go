@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) resetEvents() {
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+}
+
+// typedEvents returns all typed events of the same type as eventType that were emitted since the last resetEvents
+func (s *DexTestSuite) typedEvents(eventType proto.Message) []proto.Message {
+	var events []proto.Message
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(eventType) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		s.Require().NoError(err)
+		events = append(events, typedEvent)
+	}
+
+	return events
+}
+
+// Tests
+
+func (s *DexTestSuite) TestTypedEventDeposit() {
+	s.fundAliceBalances(10, 10)
+	s.resetEvents()
+
+	// WHEN alice deposits
+	s.aliceDeposits(NewDeposit(10, 5, 2, 1))
+
+	// THEN a typed deposit event is emitted
+	events := s.typedEvents(&types.EventDeposit{})
+	s.Require().Len(events, 1)
+	s.Equal(&types.EventDeposit{
+		Creator:            s.alice.String(),
+		Receiver:           s.alice.String(),
+		Token0:             "TokenA",
+		Token1:             "TokenB",
+		TickIndex:          2,
+		Fee:                1,
+		Reserves0Deposited: sdkmath.NewInt(10).Mul(denomMultiple),
+		Reserves1Deposited: sdkmath.NewInt(5).Mul(denomMultiple),
+		SharesMinted:       s.calcSharesMinted(2, 10_000_000, 5_000_000),
+	}, events[0])
+
+	// AND the ticks of both sides of the pool are updated
+	s.Len(s.typedEvents(&types.EventTickUpdate{}), 2)
+}
+
+func (s *DexTestSuite) TestTypedEventWithdraw() {
+	s.fundAliceBalances(10, 10)
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+	s.resetEvents()
+
+	// WHEN alice withdraws half of her shares
+	s.aliceWithdraws(NewWithdrawal(10, 0, 1))
+
+	// THEN a typed withdraw event is emitted
+	events := s.typedEvents(&types.EventWithdraw{})
+	s.Require().Len(events, 1)
+	s.Equal(&types.EventWithdraw{
+		Creator:            s.alice.String(),
+		Receiver:           s.alice.String(),
+		Token0:             "TokenA",
+		Token1:             "TokenB",
+		TickIndex:          0,
+		Fee:                1,
+		Reserves0Withdrawn: sdkmath.NewInt(5).Mul(denomMultiple),
+		Reserves1Withdrawn: sdkmath.NewInt(5).Mul(denomMultiple),
+		SharesRemoved:      sdkmath.NewInt(10).Mul(denomMultiple),
+	}, events[0])
+}
+
+func (s *DexTestSuite) TestTypedEventLimitOrderFilled() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice has a limit order
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	placeEvents := s.typedEvents(&types.EventPlaceLimitOrder{})
+	s.Require().NotEmpty(placeEvents)
+	placeEvent := placeEvents[len(placeEvents)-1].(*types.EventPlaceLimitOrder)
+	s.Equal(trancheKey, placeEvent.TrancheKey)
+	s.Equal(types.LimitOrderType_GOOD_TIL_CANCELLED, placeEvent.OrderType)
+	s.True(placeEvent.Shares.Equal(sdkmath.NewInt(10).Mul(denomMultiple)))
+	s.resetEvents()
+
+	// WHEN bob partially fills it
+	s.bobLimitSells("TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN the fill, the swap and the updated tick are reported
+	fills := s.typedEvents(&types.EventLimitOrderFilled{})
+	s.Require().Len(fills, 1)
+	s.Equal(&types.EventLimitOrderFilled{
+		Token0:                "TokenA",
+		Token1:                "TokenB",
+		MakerDenom:            "TokenA",
+		TakerDenom:            "TokenB",
+		TickIndexTakerToMaker: 0,
+		TrancheKey:            trancheKey,
+		AmountIn:              sdkmath.NewInt(5).Mul(denomMultiple),
+		AmountOut:             sdkmath.NewInt(5).Mul(denomMultiple),
+	}, fills[0])
+
+	swaps := s.typedEvents(&types.EventSwap{})
+	s.Require().Len(swaps, 1)
+	s.Equal(&types.EventSwap{
+		Creator:   s.bob.String(),
+		Receiver:  s.bob.String(),
+		TokenIn:   "TokenB",
+		TokenOut:  "TokenA",
+		AmountIn:  sdkmath.NewInt(5).Mul(denomMultiple),
+		AmountOut: sdkmath.NewInt(5).Mul(denomMultiple),
+		Route:     []string{"TokenB", "TokenA"},
+		Dust:      sdk.Coins{},
+	}, swaps[0])
+
+	tickUpdates := s.typedEvents(&types.EventTickUpdate{})
+	s.Require().Len(tickUpdates, 1)
+	tickUpdate := tickUpdates[0].(*types.EventTickUpdate)
+	s.Equal(trancheKey, tickUpdate.TrancheKey)
+	s.True(tickUpdate.Reserves.Equal(sdkmath.NewInt(5).Mul(denomMultiple)))
+
+	// WHEN alice withdraws the filled portion and cancels the rest
+	s.resetEvents()
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// THEN both are reported
+	withdrawals := s.typedEvents(&types.EventWithdrawFilledLimitOrder{})
+	s.Require().Len(withdrawals, 1)
+	s.True(withdrawals[0].(*types.EventWithdrawFilledLimitOrder).AmountOut.Equal(sdkmath.NewInt(5).Mul(denomMultiple)))
+
+	cancels := s.typedEvents(&types.EventCancelLimitOrder{})
+	s.Require().Len(cancels, 1)
+	s.True(cancels[0].(*types.EventCancelLimitOrder).AmountOut.Equal(sdkmath.NewInt(5).Mul(denomMultiple)))
+}
+
+func (s *DexTestSuite) TestTypedEventMultihopSwap() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B and B<>C
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+	)
+	s.resetEvents()
+
+	// WHEN alice swaps through both hops
+	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB", "TokenC"}}, 100, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN a single typed swap event is emitted for the whole route
+	swaps := s.typedEvents(&types.EventSwap{})
+	s.Require().Len(swaps, 1)
+	swap := swaps[0].(*types.EventSwap)
+	s.Equal("TokenA", swap.TokenIn)
+	s.Equal("TokenC", swap.TokenOut)
+	s.Equal([]string{"TokenA", "TokenB", "TokenC"}, swap.Route)
+	s.True(swap.AmountIn.Equal(sdkmath.NewInt(100).Mul(denomMultiple)))
+	s.True(swap.AmountOut.Equal(sdkmath.NewInt(100).Mul(denomMultiple)))
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) limitSellsRecordingFills(
+	account sdk.AccAddress,
+	tokenIn string,
+	tickIndexNormalized, amountIn int,
+) string {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, tokenIn)
+	tickIndexTakerToMaker := tradePairID.TickIndexTakerToMaker(int64(tickIndexNormalized))
+
+	msg, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          account.String(),
+		Receiver:         account.String(),
+		TokenIn:          tradePairID.TakerDenom,
+		TokenOut:         tradePairID.MakerDenom,
+		TickIndexInToOut: tickIndexTakerToMaker,
+		AmountIn:         sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+		RecordFills:      true,
+	})
+	s.Require().NoError(err)
+
+	return msg.TrancheKey
+}
+
+func (s *DexTestSuite) getFillRecords(account sdk.AccAddress, trancheKey string) []types.FillRecord {
+	resp, err := s.App.DexKeeper.UserFillRecordsAll(s.Ctx, &types.QueryAllUserFillRecordsRequest{
+		Address:    account.String(),
+		TrancheKey: trancheKey,
+	})
+	s.Require().NoError(err)
+
+	return resp.FillRecords
+}
+
+func (s *DexTestSuite) assertFillRecord(
+	record types.FillRecord,
+	height int64,
+	amountTakerDenom, amountMakerDenom sdkmath.Int,
+) {
+	s.Equal(height, record.Height)
+	s.True(amountTakerDenom.Equal(record.AmountTakerDenom),
+		"expected taker amount %s, got %s", amountTakerDenom, record.AmountTakerDenom)
+	s.True(amountMakerDenom.Equal(record.AmountMakerDenom),
+		"expected maker amount %s, got %s", amountMakerDenom, record.AmountMakerDenom)
+}
+
+func (s *DexTestSuite) nextBlockHeight() {
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+}
+
+// Tests
+
+func (s *DexTestSuite) TestFillRecordsNotWrittenByDefault() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 5)
+
+	// GIVEN alice has a limit order placed without recording fills
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob fills part of it
+	s.bobLimitSells("TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN no fills are recorded
+	s.Empty(s.getFillRecords(s.alice, trancheKey))
+	s.Empty(s.App.DexKeeper.GetAllFillRecord(s.Ctx))
+}
+
+func (s *DexTestSuite) TestFillRecordsMakerFills() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice has a limit order recording its fills
+	trancheKey := s.limitSellsRecordingFills(s.alice, "TokenA", 0, 10)
+
+	// WHEN bob fills part of it in two different blocks
+	height := s.Ctx.BlockHeight()
+	s.bobLimitSells("TokenB", -1, 2, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.nextBlockHeight()
+	s.bobLimitSells("TokenB", -1, 3, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN a fill is recorded for each block
+	records := s.getFillRecords(s.alice, trancheKey)
+	s.Require().Len(records, 2)
+	s.assertFillRecord(records[0], height, sdkmath.NewInt(2_000_000), sdkmath.NewInt(2_000_000))
+	s.assertFillRecord(records[1], height+1, sdkmath.NewInt(3_000_000), sdkmath.NewInt(3_000_000))
+	s.Equal(trancheKey, records[0].TrancheKey)
+	s.Equal("TokenB", records[0].TradePairId.TakerDenom)
+	s.Equal("TokenA", records[0].TradePairId.MakerDenom)
+}
+
+func (s *DexTestSuite) TestFillRecordsSameBlockAreMerged() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice has a limit order recording its fills
+	trancheKey := s.limitSellsRecordingFills(s.alice, "TokenA", 0, 10)
+
+	// WHEN bob fills part of it twice in the same block
+	s.bobLimitSells("TokenB", -1, 2, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.bobLimitSells("TokenB", -1, 3, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN a single fill is recorded
+	records := s.getFillRecords(s.alice, trancheKey)
+	s.Require().Len(records, 1)
+	s.assertFillRecord(records[0], s.Ctx.BlockHeight(), sdkmath.NewInt(5_000_000), sdkmath.NewInt(5_000_000))
+}
+
+func (s *DexTestSuite) TestFillRecordsSplitBetweenTrancheUsers() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+	s.fundCarolBalances(0, 10)
+
+	// GIVEN alice and bob share a tranche and only alice records fills
+	trancheKey := s.limitSellsRecordingFills(s.alice, "TokenA", 0, 10)
+	s.bobLimitSells("TokenA", 0, 10)
+
+	// WHEN carol fills half of the tranche
+	s.carolLimitSells("TokenB", -1, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN alice's share of the fill is recorded
+	records := s.getFillRecords(s.alice, trancheKey)
+	s.Require().Len(records, 1)
+	s.assertFillRecord(records[0], s.Ctx.BlockHeight(), sdkmath.NewInt(5_000_000), sdkmath.NewInt(5_000_000))
+
+	// AND nothing is recorded for bob
+	s.Empty(s.getFillRecords(s.bob, trancheKey))
+}
+
+func (s *DexTestSuite) TestFillRecordsIncludeTakerPortion() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places an order recording its fills that crosses alice's order
+	trancheKey := s.limitSellsRecordingFills(s.bob, "TokenB", -1, 20)
+
+	// THEN the swap is recorded for bob's order
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.bob.String(), trancheKey)
+	s.Require().True(found)
+	amountSwapped := sdkmath.NewInt(20_000_000).
+		Sub(s.App.BankKeeper.GetBalance(s.Ctx, s.bob, "TokenB").Amount).
+		Sub(trancheUser.SharesOwned)
+
+	records := s.getFillRecords(s.bob, trancheKey)
+	s.Require().Len(records, 1)
+	s.assertFillRecord(records[0], s.Ctx.BlockHeight(), sdkmath.NewInt(10_000_000), amountSwapped)
+}
+
+func (s *DexTestSuite) TestFillRecordsMaxSubscribers() {
+	s.fundAliceBalances(20, 0)
+	s.fundBobBalances(20, 0)
+
+	// GIVEN at most one user can record fills per tranche
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.MaxFillRecordSubscribers = 1
+	s.Require().NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// AND alice records the fills of her limit order
+	trancheKey := s.limitSellsRecordingFills(s.alice, "TokenA", 0, 10)
+
+	// WHEN bob places a limit order recording fills in the same tranche
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.bob.String(),
+		Receiver:         s.bob.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+		RecordFills:      true,
+	})
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrTooManyFillRecordSubscribers)
+
+	// WHEN bob places it without recording fills
+	bobTrancheKey := s.bobLimitSells("TokenA", 0, 10)
+
+	// THEN it is placed in the same tranche
+	s.Equal(trancheKey, bobTrancheKey)
+
+	// AND alice can still add to her order recording fills
+	s.Equal(trancheKey, s.limitSellsRecordingFills(s.alice, "TokenA", 0, 10))
+	s.Equal(uint64(1), s.App.DexKeeper.GetFillRecordSubscriberCount(s.Ctx, trancheKey))
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+
+	mock_types "github.com/neutron-org/neutron/v4/testutil/mocks/dex/types"
+	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
+	testutils "github.com/neutron-org/neutron/v4/x/dex/keeper/internal/testutils"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+var flashSwapContract = sdk.AccAddress("flash_swap_contract")
+
+// flashSwapMsgServer returns a MsgServer sharing the app's dex store but calling back contracts through contractKeeper
+func (s *DexTestSuite) flashSwapMsgServer(contractKeeper types.ContractKeeper) types.MsgServer {
+	k := dexkeeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.App.GetMemKey(types.MemStoreKey),
+		s.App.GetTKey(types.TStoreKey),
+		s.App.BankKeeper,
+		s.App.OracleKeeper,
+		contractKeeper,
+		authtypes.NewModuleAddress(types.ModuleName).String(),
+	)
+
+	return dexkeeper.NewMsgServerImpl(*k)
+}
+
+// mockFlashSwapContract returns a contract keeper for flashSwapContract whose flash_swap_callback runs callback
+func (s *DexTestSuite) mockFlashSwapContract(
+	callback func(ctx sdk.Context, msg types.FlashSwapCallbackMsg) error,
+) types.ContractKeeper {
+	ctrl := gomock.NewController(s.T())
+	contractKeeper := mock_types.NewMockContractKeeper(ctrl)
+	contractKeeper.EXPECT().GetContractInfo(gomock.Any(), flashSwapContract).Return(&wasmtypes.ContractInfo{}).AnyTimes()
+	contractKeeper.EXPECT().Sudo(gomock.Any(), flashSwapContract, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+			var sudoMsg types.FlashSwapCallbackSudoMsg
+			s.Require().NoError(json.Unmarshal(msg, &sudoMsg))
+
+			return nil, callback(sdk.UnwrapSDKContext(ctx), sudoMsg.FlashSwapCallback)
+		},
+	).AnyTimes()
+
+	return contractKeeper
+}
+
+func (s *DexTestSuite) flashSwaps(
+	contractKeeper types.ContractKeeper,
+	amountIn int64,
+) (*types.MsgFlashSwapResponse, error) {
+	return s.flashSwapMsgServer(contractKeeper).FlashSwap(s.Ctx, types.NewMsgFlashSwap(
+		flashSwapContract.String(),
+		"TokenA",
+		"TokenB",
+		math.NewInt(amountIn).Mul(denomMultiple),
+		nil,
+		nil,
+		[]byte(`{"arb":{}}`),
+	))
+}
+
+// Tests
+
+func (s *DexTestSuite) TestFlashSwapRepaid() {
+	s.fundBobBalances(0, 10)
+
+	// GIVEN 10 TokenB of liquidity at tick 1
+	s.bobDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN the contract flash swaps 5 TokenA and repays it in its callback
+	var callbackMsg types.FlashSwapCallbackMsg
+	contractKeeper := s.mockFlashSwapContract(func(ctx sdk.Context, msg types.FlashSwapCallbackMsg) error {
+		callbackMsg = msg
+
+		// the output of the swap has already been received
+		s.Equal(msg.CoinOut.Amount, s.App.BankKeeper.GetBalance(ctx, flashSwapContract, "TokenB").Amount.String())
+
+		coinIn, ok := math.NewIntFromString(msg.CoinIn.Amount)
+		s.True(ok)
+		testutils.FundAccount(s.App.BankKeeper, ctx, flashSwapContract, sdk.Coins{sdk.NewCoin(msg.CoinIn.Denom, coinIn)})
+
+		return nil
+	})
+	resp, err := s.flashSwaps(contractKeeper, 5)
+	s.NoError(err)
+
+	// THEN the contract keeps the output and has repaid the input
+	s.Equal(sdk.NewInt64Coin("TokenA", 5_000_000), resp.CoinIn)
+	s.Equal(sdk.NewInt64Coin("TokenB", 4_999_500), resp.CoinOut)
+	s.Equal("TokenA", callbackMsg.CoinIn.Denom)
+	s.Equal(resp.CoinIn.Amount.String(), callbackMsg.CoinIn.Amount)
+	s.Equal(`{"arb":{}}`, string(callbackMsg.Msg))
+
+	s.assertAccountBalancesInt(flashSwapContract, math.ZeroInt(), resp.CoinOut.Amount)
+	s.assertDexBalancesInt(resp.CoinIn.Amount, math.NewInt(10_000_000).Sub(resp.CoinOut.Amount))
+}
+
+func (s *DexTestSuite) TestFlashSwapNotRepaid() {
+	s.fundBobBalances(0, 10)
+
+	// GIVEN 10 TokenB of liquidity at tick 1
+	s.bobDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN the contract flash swaps 5 TokenA but does not repay it
+	contractKeeper := s.mockFlashSwapContract(func(sdk.Context, types.FlashSwapCallbackMsg) error {
+		return nil
+	})
+	_, err := s.flashSwaps(contractKeeper, 5)
+
+	// THEN the flash swap fails and is reverted
+	s.ErrorIs(err, types.ErrFlashSwapNotRepaid)
+	s.assertAccountBalances(flashSwapContract, 0, 0)
+	s.assertDexBalances(0, 10)
+	s.assertLiquidityAtTick(0, 10, 0, 1)
+}
+
+func (s *DexTestSuite) TestFlashSwapPartiallyRepaid() {
+	s.fundBobBalances(0, 10)
+
+	// GIVEN 10 TokenB of liquidity at tick 1
+	s.bobDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN the contract flash swaps 5 TokenA but only has 4 TokenA once its callback returns
+	contractKeeper := s.mockFlashSwapContract(func(ctx sdk.Context, _ types.FlashSwapCallbackMsg) error {
+		testutils.FundAccount(s.App.BankKeeper, ctx, flashSwapContract, sdk.Coins{sdk.NewInt64Coin("TokenA", 4_000_000)})
+		return nil
+	})
+	_, err := s.flashSwaps(contractKeeper, 5)
+
+	// THEN the flash swap fails and is reverted
+	s.ErrorIs(err, types.ErrFlashSwapNotRepaid)
+	s.assertAccountBalances(flashSwapContract, 0, 0)
+	s.assertDexBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestFlashSwapCallbackFails() {
+	s.fundBobBalances(0, 10)
+
+	// GIVEN 10 TokenB of liquidity at tick 1
+	s.bobDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN the contract's callback fails
+	contractKeeper := s.mockFlashSwapContract(func(sdk.Context, types.FlashSwapCallbackMsg) error {
+		return errors.New("arb not profitable")
+	})
+	_, err := s.flashSwaps(contractKeeper, 5)
+
+	// THEN the flash swap fails and is reverted
+	s.ErrorIs(err, types.ErrFlashSwapCallbackFailed)
+	s.assertAccountBalances(flashSwapContract, 0, 0)
+	s.assertDexBalances(0, 10)
+	s.assertLiquidityAtTick(0, 10, 0, 1)
+}
+
+func (s *DexTestSuite) TestFlashSwapNoLiquidity() {
+	// GIVEN no liquidity
+
+	// WHEN the contract flash swaps 5 TokenA
+	contractKeeper := s.mockFlashSwapContract(func(sdk.Context, types.FlashSwapCallbackMsg) error {
+		s.Fail("callback should not be called")
+		return nil
+	})
+	_, err := s.flashSwaps(contractKeeper, 5)
+
+	// THEN the flash swap fails
+	s.ErrorIs(err, types.ErrLimitPriceNotSatisfied)
+}
+
+func (s *DexTestSuite) TestFlashSwapNotContract() {
+	s.fundBobBalances(0, 10)
+
+	// GIVEN 10 TokenB of liquidity at tick 1
+	s.bobDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN an address that is not a contract flash swaps
+	ctrl := gomock.NewController(s.T())
+	contractKeeper := mock_types.NewMockContractKeeper(ctrl)
+	contractKeeper.EXPECT().GetContractInfo(gomock.Any(), flashSwapContract).Return(nil)
+	_, err := s.flashSwaps(contractKeeper, 5)
+
+	// THEN the flash swap fails
+	s.ErrorIs(err, types.ErrFlashSwapNotContract)
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+var errHookFailed = errors.New("hook failed")
+
+type swapHookCall struct {
+	trader  sdk.AccAddress
+	coinIn  sdk.Coin
+	coinOut sdk.Coin
+}
+
+type liquidityHookCall struct {
+	account sdk.AccAddress
+	poolID  uint64
+	amount0 sdkmath.Int
+	amount1 sdkmath.Int
+	shares  sdk.Coin
+}
+
+type limitOrderHookCall struct {
+	receiver   sdk.AccAddress
+	trancheKey string
+	amountIn   sdkmath.Int
+}
+
+type trancheFilledHookCall struct {
+	trancheKey string
+	amountIn   sdkmath.Int
+	amountOut  sdkmath.Int
+}
+
+// mockDexHooks records every hook invocation and optionally fails AfterDeposit
+type mockDexHooks struct {
+	failDeposit       bool
+	swaps             []swapHookCall
+	deposits          []liquidityHookCall
+	withdraws         []liquidityHookCall
+	limitOrdersPlaced []limitOrderHookCall
+	tranchesFilled    []trancheFilledHookCall
+}
+
+var _ types.DexHooks = &mockDexHooks{}
+
+func (h *mockDexHooks) AfterSwap(_ context.Context, trader sdk.AccAddress, coinIn, coinOut sdk.Coin) error {
+	h.swaps = append(h.swaps, swapHookCall{trader, coinIn, coinOut})
+	return nil
+}
+
+func (h *mockDexHooks) AfterDeposit(
+	_ context.Context,
+	receiver sdk.AccAddress,
+	pool *types.Pool,
+	amount0, amount1 sdkmath.Int,
+	sharesIssued sdk.Coin,
+) error {
+	if h.failDeposit {
+		return errHookFailed
+	}
+	h.deposits = append(h.deposits, liquidityHookCall{receiver, pool.Id, amount0, amount1, sharesIssued})
+	return nil
+}
+
+func (h *mockDexHooks) AfterWithdraw(
+	_ context.Context,
+	withdrawer sdk.AccAddress,
+	pool *types.Pool,
+	amount0, amount1 sdkmath.Int,
+	sharesRemoved sdk.Coin,
+) error {
+	h.withdraws = append(h.withdraws, liquidityHookCall{withdrawer, pool.Id, amount0, amount1, sharesRemoved})
+	return nil
+}
+
+func (h *mockDexHooks) AfterLimitOrderPlaced(
+	_ context.Context,
+	receiver sdk.AccAddress,
+	tranche *types.LimitOrderTranche,
+	amountIn sdkmath.Int,
+) error {
+	h.limitOrdersPlaced = append(h.limitOrdersPlaced, limitOrderHookCall{receiver, tranche.Key.TrancheKey, amountIn})
+	return nil
+}
+
+func (h *mockDexHooks) AfterTrancheFilled(
+	_ context.Context,
+	tranche *types.LimitOrderTranche,
+	amountIn, amountOut sdkmath.Int,
+) error {
+	h.tranchesFilled = append(h.tranchesFilled, trancheFilledHookCall{tranche.Key.TrancheKey, amountIn, amountOut})
+	return nil
+}
+
+// Core test helpers
+
+func (s *DexTestSuite) setMockDexHooks() *mockDexHooks {
+	hooks := &mockDexHooks{}
+	s.App.DexKeeper.ClearHooks()
+	s.App.DexKeeper.SetHooks(hooks)
+	s.msgServer = dexkeeper.NewMsgServerImpl(s.App.DexKeeper)
+
+	return hooks
+}
+
+// Tests
+
+func (s *DexTestSuite) TestHooksAfterDeposit() {
+	hooks := s.setMockDexHooks()
+	s.fundAliceBalances(10, 10)
+
+	// WHEN alice deposits into two pools
+	s.aliceDeposits(
+		NewDeposit(10, 0, 0, 1),
+		NewDeposit(0, 10, 0, 5),
+	)
+
+	// THEN AfterDeposit is called once per pool with alice as the receiver
+	s.Require().Len(hooks.deposits, 2)
+	pool0, found := s.App.DexKeeper.GetPool(s.Ctx, defaultPairID, 0, 1)
+	s.Require().True(found)
+	pool1, found := s.App.DexKeeper.GetPool(s.Ctx, defaultPairID, 0, 5)
+	s.Require().True(found)
+
+	s.Equal(s.alice, hooks.deposits[0].account)
+	s.Equal(pool0.Id, hooks.deposits[0].poolID)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), hooks.deposits[0].amount0)
+	s.True(hooks.deposits[0].amount1.IsZero())
+	s.Equal(pool0.GetPoolDenom(), hooks.deposits[0].shares.Denom)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), hooks.deposits[0].shares.Amount)
+
+	s.Equal(pool1.Id, hooks.deposits[1].poolID)
+	s.True(hooks.deposits[1].amount0.IsZero())
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), hooks.deposits[1].amount1)
+}
+
+func (s *DexTestSuite) TestHooksAfterDepositErrorFailsDeposit() {
+	hooks := s.setMockDexHooks()
+	hooks.failDeposit = true
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice deposits and the AfterDeposit hook fails
+	_, err := s.deposits(s.alice, []*Deposit{NewDeposit(10, 0, 0, 1)})
+
+	// THEN the deposit fails with the hook error
+	s.ErrorIs(err, errHookFailed)
+}
+
+func (s *DexTestSuite) TestHooksAfterWithdraw() {
+	hooks := s.setMockDexHooks()
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice has deposited into a pool
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+
+	// WHEN alice withdraws half of her shares
+	s.aliceWithdraws(NewWithdrawal(5, 0, 1))
+
+	// THEN AfterWithdraw is called with the amounts removed
+	s.Require().Len(hooks.withdraws, 1)
+	s.Equal(s.alice, hooks.withdraws[0].account)
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), hooks.withdraws[0].amount0)
+	s.True(hooks.withdraws[0].amount1.IsZero())
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), hooks.withdraws[0].shares.Amount)
+}
+
+func (s *DexTestSuite) TestHooksAfterLimitOrderPlacedAndFilled() {
+	hooks := s.setMockDexHooks()
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// WHEN alice places a maker limit order
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// THEN AfterLimitOrderPlaced is called and no swap has happened
+	s.Require().Len(hooks.limitOrdersPlaced, 1)
+	s.Equal(s.alice, hooks.limitOrdersPlaced[0].receiver)
+	s.Equal(trancheKey, hooks.limitOrdersPlaced[0].trancheKey)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), hooks.limitOrdersPlaced[0].amountIn)
+	s.Empty(hooks.swaps)
+	s.Empty(hooks.tranchesFilled)
+
+	// WHEN bob swaps through alice's tranche
+	s.bobLimitSells("TokenB", -10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN AfterTrancheFilled is called for alice's tranche and AfterSwap for bob
+	s.Require().Len(hooks.tranchesFilled, 1)
+	s.Equal(trancheKey, hooks.tranchesFilled[0].trancheKey)
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), hooks.tranchesFilled[0].amountIn)
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), hooks.tranchesFilled[0].amountOut)
+
+	s.Require().Len(hooks.swaps, 1)
+	s.Equal(s.bob, hooks.swaps[0].trader)
+	s.Equal(sdk.NewCoin("TokenB", sdkmath.NewInt(5).Mul(denomMultiple)), hooks.swaps[0].coinIn)
+	s.Equal(sdk.NewCoin("TokenA", sdkmath.NewInt(5).Mul(denomMultiple)), hooks.swaps[0].coinOut)
+
+	// AND no new limit order is placed for bob's IOC order
+	s.Len(hooks.limitOrdersPlaced, 1)
+}
+
+func (s *DexTestSuite) TestHooksAfterSwapMultiHop() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+	)
+	hooks := s.setMockDexHooks()
+
+	// WHEN alice multihopswaps A<>B => B<>C
+	route := [][]string{{"TokenA", "TokenB", "TokenC"}}
+	s.aliceMultiHopSwaps(route, 100, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN AfterSwap is called once for the full route
+	s.Require().Len(hooks.swaps, 1)
+	s.Equal(s.alice, hooks.swaps[0].trader)
+	s.Equal(sdk.NewCoin("TokenA", sdkmath.NewInt(100).Mul(denomMultiple)), hooks.swaps[0].coinIn)
+	s.Equal(sdk.NewCoin("TokenC", sdkmath.NewInt(100).Mul(denomMultiple)), hooks.swaps[0].coinOut)
+}
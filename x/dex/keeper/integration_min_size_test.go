@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) setMinSizeParams(minMakerOrderSizes, minDepositSizes sdk.Coins) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.MinMakerOrderSizes = minMakerOrderSizes
+	params.MinDepositSizes = minDepositSizes
+	err := s.App.DexKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(err)
+}
+
+func minSizes(denom string, amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount).Mul(denomMultiple)))
+}
+
+// inTx runs fn against s.Ctx and only persists its state changes if it succeeds, as a failed tx would be reverted
+func (s *DexTestSuite) inTx(fn func() error) error {
+	ctx := s.Ctx
+	cacheCtx, writeCache := ctx.CacheContext()
+	s.Ctx = cacheCtx
+	err := fn()
+	s.Ctx = ctx
+	if err == nil {
+		writeCache()
+	}
+
+	return err
+}
+
+// Tests
+
+func (s *DexTestSuite) TestMinMakerOrderSize() {
+	s.fundAliceBalances(20, 0)
+
+	// GIVEN a minimum maker order size of 10 TokenA
+	s.setMinSizeParams(minSizes("TokenA", 10), sdk.Coins{})
+
+	// WHEN alice places a smaller limit order
+	err := s.inTx(func() error {
+		_, err := s.limitSells(s.alice, "TokenA", 0, 5)
+		return err
+	})
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrMakerOrderBelowMinSize)
+	s.assertAliceBalances(20, 0)
+
+	// WHEN alice places a limit order of the minimum size
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// THEN it is placed
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertAliceBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestMinMakerOrderSizeIgnoresTakerOrders() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 5)
+
+	// GIVEN a minimum maker order size of 10 TokenB
+	s.setMinSizeParams(minSizes("TokenB", 10), sdk.Coins{})
+
+	// AND alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places a smaller IoC order
+	s.bobLimitSells("TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN it is filled
+	s.assertBobBalances(5, 0)
+}
+
+func (s *DexTestSuite) TestMinMakerOrderSizeRemainderBelowMin() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN a minimum maker order size of 5 TokenB
+	s.setMinSizeParams(minSizes("TokenB", 5), sdk.Coins{})
+
+	// AND alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places a GTC order that leaves less than the minimum after crossing alice's order
+	s.bobLimitSells("TokenB", -1, 12)
+
+	// THEN the filled portion is kept and the remainder is not placed
+	s.assertBobBalances(10, 10)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenB", -1, 0)
+}
+
+func (s *DexTestSuite) TestMinMakerOrderSizeRemainderAboveMin() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN a minimum maker order size of 5 TokenB
+	s.setMinSizeParams(minSizes("TokenB", 5), sdk.Coins{})
+
+	// AND alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places a GTC order that leaves at least the minimum after crossing alice's order
+	s.bobLimitSells("TokenB", -1, 20)
+
+	// THEN the remainder is placed
+	s.assertBobBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenB", -1, 10)
+}
+
+func (s *DexTestSuite) TestMinDepositSize() {
+	s.fundAliceBalances(20, 20)
+
+	// GIVEN a minimum deposit size of 10 TokenA
+	s.setMinSizeParams(sdk.Coins{}, minSizes("TokenA", 10))
+
+	// WHEN alice deposits less than the minimum of TokenA
+	err := s.inTx(func() error {
+		_, err := s.deposits(s.alice, []*Deposit{NewDeposit(5, 10, 0, 1)})
+		return err
+	})
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrDepositBelowMinSize)
+	s.assertAliceBalances(20, 20)
+
+	// WHEN alice deposits the minimum of TokenA and any amount of TokenB
+	s.aliceDeposits(NewDeposit(10, 1, 0, 1))
+	s.aliceDeposits(NewDeposit(0, 1, 0, 5))
+
+	// THEN the deposits succeed
+	s.assertAliceBalances(10, 18)
+}
@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) aliceReducesLimitSell(trancheKey string, amount int) (*types.MsgModifyLimitOrderResponse, error) {
+	reduceAmountIn := sdkmath.NewInt(int64(amount)).Mul(denomMultiple)
+	return s.msgServer.ModifyLimitOrder(s.Ctx, &types.MsgModifyLimitOrder{
+		Creator:        s.alice.String(),
+		TrancheKey:     trancheKey,
+		ReduceAmountIn: &reduceAmountIn,
+	})
+}
+
+func (s *DexTestSuite) aliceExtendsLimitSell(trancheKey string, expirationTime time.Time) error {
+	_, err := s.msgServer.ModifyLimitOrder(s.Ctx, &types.MsgModifyLimitOrder{
+		Creator:        s.alice.String(),
+		TrancheKey:     trancheKey,
+		ExpirationTime: &expirationTime,
+	})
+
+	return err
+}
+
+func (s *DexTestSuite) assertLimitOrderExpiration(trancheKey string, expirationTime time.Time, expectedFound bool) {
+	trancheRef := (&types.LimitOrderTrancheKey{
+		TradePairId:           defaultTradePairID1To0,
+		TickIndexTakerToMaker: 0,
+		TrancheKey:            trancheKey,
+	}).KeyMarshal()
+	_, found := s.App.DexKeeper.GetLimitOrderExpiration(s.Ctx, expirationTime, trancheRef)
+	s.Equal(expectedFound, found)
+}
+
+// Tests
+
+func (s *DexTestSuite) TestModifyLimitOrderReduce() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN alice limit sells 50 TokenA
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+
+	// WHEN alice reduces her limit order by 20 TokenA
+	resp, err := s.aliceReducesLimitSell(trancheKey, 20)
+	s.NoError(err)
+
+	// THEN she gets back 20 TokenA and the order keeps its place in the fill tranche
+	s.Equal(sdk.NewCoin("TokenA", sdkmath.NewInt(20).Mul(denomMultiple)), resp.CoinOut)
+	s.assertAliceBalances(20, 0)
+	s.assertDexBalances(30, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 30)
+	// New orders can still be added to the unfilled tranche
+	s.assertFillAndPlaceTrancheKeys("TokenA", 0, trancheKey, trancheKey)
+
+	// WHEN bob swaps through the remaining liquidity
+	s.bobLimitSells("TokenB", -10, 30, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN alice can withdraw 30 TokenB
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.assertAliceBalances(20, 30)
+	s.assertDexBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderReducePartiallyFilled() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN alice limit sells 50 TokenA and 10 TokenA of it are filled
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN alice reduces her limit order by 20 TokenA
+	_, err := s.aliceReducesLimitSell(trancheKey, 20)
+	s.NoError(err)
+
+	// THEN 20 TokenA remain unfilled
+	s.assertAliceBalances(20, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 20)
+
+	// WHEN the rest of the order is filled
+	s.bobLimitSells("TokenB", -10, 20, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN alice can withdraw 30 TokenB in total
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.assertAliceBalances(20, 30)
+	s.assertDexBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderReduceSharedTranche() {
+	s.fundAliceBalances(100, 0)
+	s.fundBobBalances(100, 0)
+	s.fundCarolBalances(0, 110)
+
+	// GIVEN alice and bob each limit sell 100 TokenA in the same tranche
+	trancheKey := s.aliceLimitSells("TokenA", 0, 100)
+	s.Equal(trancheKey, s.bobLimitSells("TokenA", 0, 100))
+
+	// WHEN alice reduces her limit order by 90 TokenA
+	_, err := s.aliceReducesLimitSell(trancheKey, 90)
+	s.NoError(err)
+
+	// AND carol swaps through all of the remaining liquidity
+	s.carolLimitSells("TokenB", -10, 110, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN alice can withdraw 10 TokenB and bob 100 TokenB
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.bobWithdrawsLimitSell(trancheKey)
+	s.assertAliceBalances(90, 10)
+	s.assertBobBalances(0, 100)
+	s.assertDexBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderReducePartiallyFilledSharedTrancheFails() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(50, 0)
+	s.fundCarolBalances(0, 10)
+
+	// GIVEN alice and bob each limit sell 50 TokenA in the same tranche and 10 TokenA of it are filled
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+	s.bobLimitSells("TokenA", 0, 50)
+	s.carolLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN alice reduces her limit order by 20 TokenA
+	_, err := s.aliceReducesLimitSell(trancheKey, 20)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidLimitOrderModification)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderReduceEntireOrderCancels() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN alice limit sells 50 TokenA with a goodTil date of tomorrow
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 50, time.Now().AddDate(0, 0, 1))
+	s.assertNLimitOrderExpiration(1)
+
+	// WHEN alice reduces her limit order by 50 TokenA
+	_, err := s.aliceReducesLimitSell(trancheKey, 50)
+	s.NoError(err)
+
+	// THEN the order is cancelled
+	s.assertAliceBalances(50, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertNLimitOrderExpiration(0)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderReduceTooMuchFails() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN alice limit sells 50 TokenA
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+
+	// WHEN alice reduces her limit order by 51 TokenA
+	_, err := s.aliceReducesLimitSell(trancheKey, 51)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidLimitOrderModification)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderReduceBelowMinSizeFails() {
+	s.fundAliceBalances(50, 0)
+	s.setMinSizeParams(minSizes("TokenA", 10), sdk.Coins{})
+
+	// GIVEN alice limit sells 50 TokenA
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+
+	// WHEN alice reduces her limit order to 5 TokenA
+	_, err := s.aliceReducesLimitSell(trancheKey, 45)
+
+	// THEN it fails because the order would be below the min maker order size
+	s.ErrorIs(err, types.ErrMakerOrderBelowMinSize)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderNotFoundFails() {
+	// WHEN alice modifies a limit order she doesn't have
+	_, err := s.aliceReducesLimitSell("BADKEY", 10)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrActiveLimitOrderNotFound)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderJITFails() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN alice places a JIT limit order
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50, types.LimitOrderType_JUST_IN_TIME)
+
+	// WHEN alice reduces it
+	_, err := s.aliceReducesLimitSell(trancheKey, 10)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidLimitOrderModification)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderExtendExpiration() {
+	s.fundAliceBalances(50, 0)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	inThreeDays := time.Now().AddDate(0, 0, 3)
+
+	// GIVEN alice limit sells 50 TokenA with a goodTil date of tomorrow
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 50, tomorrow)
+
+	// WHEN alice extends the expiration time by two days
+	err := s.aliceExtendsLimitSell(trancheKey, inThreeDays)
+	s.NoError(err)
+
+	// THEN the tranche and the LimitOrderExpiration index are updated
+	tranche, _, found := s.App.DexKeeper.FindLimitOrderTranche(s.Ctx, &types.LimitOrderTrancheKey{
+		TradePairId:           defaultTradePairID1To0,
+		TickIndexTakerToMaker: 0,
+		TrancheKey:            trancheKey,
+	})
+	s.True(found)
+	s.Equal(inThreeDays.Unix(), tranche.ExpirationTime.Unix())
+	s.assertNLimitOrderExpiration(1)
+	s.assertLimitOrderExpiration(trancheKey, tomorrow, false)
+	s.assertLimitOrderExpiration(trancheKey, inThreeDays, true)
+
+	// WHEN two days go by
+	s.beginBlockWithTime(time.Now().AddDate(0, 0, 2))
+
+	// THEN the order has not expired
+	s.assertLimitLiquidityAtTick("TokenA", 0, 50)
+
+	// WHEN four days go by
+	s.beginBlockWithTime(time.Now().AddDate(0, 0, 4))
+
+	// THEN the order has expired
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertNLimitOrderExpiration(0)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderReduceAndExtend() {
+	s.fundAliceBalances(50, 0)
+	inThreeDays := time.Now().AddDate(0, 0, 3)
+
+	// GIVEN alice limit sells 50 TokenA with a goodTil date of tomorrow
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 50, time.Now().AddDate(0, 0, 1))
+
+	// WHEN alice reduces the order and extends its expiration time at once
+	reduceAmountIn := sdkmath.NewInt(10).Mul(denomMultiple)
+	_, err := s.msgServer.ModifyLimitOrder(s.Ctx, &types.MsgModifyLimitOrder{
+		Creator:        s.alice.String(),
+		TrancheKey:     trancheKey,
+		ReduceAmountIn: &reduceAmountIn,
+		ExpirationTime: &inThreeDays,
+	})
+	s.NoError(err)
+
+	// THEN both modifications are applied
+	s.assertAliceBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 40)
+	s.assertNLimitOrderExpiration(1)
+	s.assertLimitOrderExpiration(trancheKey, inThreeDays, true)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderShortenExpirationFails() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN alice limit sells 50 TokenA with a goodTil date in two days
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 50, time.Now().AddDate(0, 0, 2))
+
+	// WHEN alice moves the expiration time to tomorrow
+	err := s.aliceExtendsLimitSell(trancheKey, time.Now().AddDate(0, 0, 1))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidLimitOrderModification)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderExpirationInPastFails() {
+	s.fundAliceBalances(50, 0)
+	s.nextBlockWithTime(time.Now())
+
+	// GIVEN alice limit sells 50 TokenA with a goodTil date of tomorrow
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 50, time.Now().AddDate(0, 0, 1))
+
+	// WHEN alice sets the expiration time to yesterday
+	err := s.aliceExtendsLimitSell(trancheKey, time.Now().AddDate(0, 0, -1))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrExpirationTimeInPast)
+}
+
+func (s *DexTestSuite) TestModifyLimitOrderExpirationGTCFails() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN alice limit sells 50 TokenA as GTC
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+
+	// WHEN alice sets an expiration time
+	err := s.aliceExtendsLimitSell(trancheKey, time.Now().AddDate(0, 0, 1))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidLimitOrderModification)
+	s.assertNLimitOrderExpiration(0)
+}
@@ -502,3 +502,99 @@ func (s *DexTestSuite) TestMultiHopSwapEventsEmitted() {
 	// 8 tickUpdateEvents are emitted 4x for pool setup 4x for two swaps
 	s.AssertNEventValuesEmitted(types.TickUpdateEventKey, 8)
 }
+
+func (s *DexTestSuite) TestMultiHopSwapExactAmountOutSingleRoute() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, 0, 1),
+	)
+
+	// WHEN alice multihopswaps A<>B => B<>C => C<>D for exactly 50 TokenD
+	route := [][]string{{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	coinIn := s.aliceMultiHopSwapsExactAmountOut(route, 50, 100, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN alice gets exactly 50 TokenD and only pays what is required
+	s.Assert().Equal(math.NewInt(50_015_003), coinIn.Amount)
+	s.assertAccountBalanceWithDenomInt(s.alice, "TokenA", math.NewInt(49_984_997))
+	s.assertAccountBalanceWithDenom(s.alice, "TokenB", 0)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenC", 0)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenD", 50)
+
+	s.assertDexBalanceWithDenomInt("TokenA", math.NewInt(50_015_003))
+	s.assertDexBalanceWithDenom("TokenB", 100)
+	s.assertDexBalanceWithDenom("TokenC", 100)
+	s.assertDexBalanceWithDenom("TokenD", 50)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactAmountOutMaxAmountInExceeded() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, 0, 1),
+	)
+
+	// THEN alice cannot get exactly 50 TokenD for at most 50 TokenA
+	route := [][]string{{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	s.aliceMultiHopSwapExactAmountOutFails(
+		types.ErrMultihopMaxAmountInExceeded,
+		route,
+		50,
+		50,
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		false,
+	)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactAmountOutInsufficientLiquidity() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D with insufficient liquidity in C<>D
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 50, 0, 1),
+	)
+
+	// THEN alice cannot get exactly 60 TokenD
+	route := [][]string{{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	s.aliceMultiHopSwapExactAmountOutFails(
+		types.ErrLimitPriceNotSatisfied,
+		route,
+		60,
+		100,
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		false,
+	)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactAmountOutMultiRoutePickBest() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in two routes where A<>C<>D is cheaper than A<>B<>D
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenD", 0, 100, 100, 1),
+		NewPoolSetup("TokenA", "TokenC", 0, 100, 0, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, 0, 1),
+	)
+
+	// WHEN alice swaps for exactly 50 TokenD picking the best route
+	routes := [][]string{
+		{"TokenA", "TokenB", "TokenD"},
+		{"TokenA", "TokenC", "TokenD"},
+	}
+	coinIn := s.aliceMultiHopSwapsExactAmountOut(routes, 50, 100, math_utils.MustNewPrecDecFromStr("0.9"), true)
+
+	// THEN the cheaper A<>C<>D route is used
+	s.Assert().Equal(math.NewInt(50_010_001), coinIn.Amount)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenD", 50)
+	s.assertDexBalanceWithDenom("TokenB", 100)
+	s.assertDexBalanceWithDenom("TokenC", 100)
+}
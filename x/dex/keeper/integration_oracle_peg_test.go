@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) setOraclePrice(currencyPair, price string) {
+	cp, err := slinkytypes.CurrencyPairFromString(currencyPair)
+	s.Require().NoError(err)
+
+	// Currency pairs without a market in the market map are reported with 8 decimals
+	quotePrice := oracletypes.QuotePrice{
+		Price:          math_utils.MustNewPrecDecFromStr(price).MulInt64(100_000_000).TruncateInt(),
+		BlockTimestamp: s.Ctx.BlockTime(),
+		BlockHeight:    uint64(s.Ctx.BlockHeight()),
+	}
+	err = s.App.OracleKeeper.SetPriceForCurrencyPair(s.Ctx, cp, quotePrice)
+	s.Require().NoError(err)
+}
+
+func (s *DexTestSuite) limitSellsPegged(
+	account sdk.AccAddress,
+	tokenIn string,
+	amountIn int,
+	currencyPair string,
+	offsetBps int64,
+) (string, error) {
+	tokenIn, tokenOut := dexkeeper.GetInOutTokens(tokenIn, "TokenA", "TokenB")
+
+	msg, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:   account.String(),
+		Receiver:  account.String(),
+		TokenIn:   tokenIn,
+		TokenOut:  tokenOut,
+		AmountIn:  sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType: types.LimitOrderType_GOOD_TIL_CANCELLED,
+		OraclePeg: &types.OraclePeg{CurrencyPair: currencyPair, OffsetBps: offsetBps},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return msg.TrancheKey, nil
+}
+
+func (s *DexTestSuite) aliceLimitSellsPegged(tokenIn string, amountIn int, currencyPair string, offsetBps int64) string {
+	trancheKey, err := s.limitSellsPegged(s.alice, tokenIn, amountIn, currencyPair, offsetBps)
+	s.Require().NoError(err)
+
+	return trancheKey
+}
+
+func (s *DexTestSuite) assertPeggedAtPrice(trancheKey string, price string, offsetBps int64) {
+	order, found := s.App.DexKeeper.GetPeggedLimitOrder(s.Ctx, trancheKey)
+	s.Require().True(found, "pegged limit order %s not found", trancheKey)
+
+	tickIndexInToOut, err := types.CalcTickIndexFromPrice(math_utils.MustNewPrecDecFromStr(price))
+	s.Require().NoError(err)
+	s.Equal((tickIndexInToOut-offsetBps)*-1, order.TrancheKey.TickIndexTakerToMaker)
+
+	tranche := s.App.DexKeeper.GetLimitOrderTranche(s.Ctx, order.TrancheKey)
+	s.NotNil(tranche)
+}
+
+// getOnlyPeggedLimitOrder returns the single pegged limit order in the store
+func (s *DexTestSuite) getOnlyPeggedLimitOrder() types.PeggedLimitOrder {
+	orders := s.App.DexKeeper.GetAllPeggedLimitOrder(s.Ctx)
+	s.Require().Len(orders, 1)
+
+	return orders[0]
+}
+
+// Tests
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderPlacedAtOraclePrice() {
+	s.fundAliceBalances(20, 0)
+
+	// GIVEN an oracle price of 2 TokenB per TokenA
+	s.setOraclePrice("TOKENA/TOKENB", "2")
+
+	// WHEN alice places pegged limit orders with and without an offset
+	trancheKey := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 0)
+	trancheKeyOffset := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 100)
+
+	// THEN the orders are placed at the oracle price plus the offset
+	s.assertPeggedAtPrice(trancheKey, "2", 0)
+	s.assertPeggedAtPrice(trancheKeyOffset, "2", 100)
+	s.assertAliceBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderNoOraclePriceFails() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice pegs a limit order to a currency pair without a price
+	_, err := s.limitSellsPegged(s.alice, "TokenA", 10, "TOKENA/TOKENB", 0)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrOraclePriceNotFound)
+	s.assertAliceBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderRepegs() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice has a limit order pegged at an oracle price of 1
+	s.setOraclePrice("TOKENA/TOKENB", "1")
+	trancheKey := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 0)
+
+	// WHEN the oracle price moves to 2
+	s.setOraclePrice("TOKENA/TOKENB", "2")
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN the order is moved to the new price
+	_, found := s.App.DexKeeper.GetPeggedLimitOrder(s.Ctx, trancheKey)
+	s.False(found)
+	order := s.getOnlyPeggedLimitOrder()
+	s.assertPeggedAtPrice(order.TrancheKey.TrancheKey, "2", 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertAliceBalances(0, 0)
+
+	// WHEN the oracle price does not move
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN the order stays in place
+	s.assertPeggedAtPrice(order.TrancheKey.TrancheKey, "2", 0)
+
+	// WHEN alice cancels the order
+	s.aliceCancelsLimitSell(order.TrancheKey.TrancheKey)
+
+	// THEN alice gets the funds back and the order is no longer pegged
+	s.assertAliceBalances(10, 0)
+	s.Empty(s.App.DexKeeper.GetAllPeggedLimitOrder(s.Ctx))
+}
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderRepegKeepsFilledPortion() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice has a limit order pegged at an oracle price of 1
+	s.setOraclePrice("TOKENA/TOKENB", "1")
+	trancheKey := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 0)
+
+	// AND bob fills half of it
+	s.bobLimitSells("TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertBobBalances(5, 5)
+
+	// WHEN the oracle price moves
+	s.setOraclePrice("TOKENA/TOKENB", "2")
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN only the unfilled portion is moved
+	order := s.getOnlyPeggedLimitOrder()
+	s.assertPeggedAtPrice(order.TrancheKey.TrancheKey, "2", 0)
+	tranche := s.App.DexKeeper.GetLimitOrderTranche(s.Ctx, order.TrancheKey)
+	s.True(tranche.ReservesMakerDenom.Equal(sdkmath.NewInt(5).Mul(denomMultiple)))
+
+	// AND the filled portion can still be withdrawn from the original tranche
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.assertAliceBalances(0, 5)
+}
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderFilledIsRemoved() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN alice has a pegged limit order
+	s.setOraclePrice("TOKENA/TOKENB", "1")
+	trancheKey := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 0)
+
+	// AND bob fills all of it
+	s.bobLimitSells("TokenB", -1, 20, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the pegged orders are re-priced
+	s.setOraclePrice("TOKENA/TOKENB", "2")
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN the filled order is no longer pegged
+	s.Empty(s.App.DexKeeper.GetAllPeggedLimitOrder(s.Ctx))
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.assertAliceBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderMaxRepegsPerBlock() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN only one pegged order can be re-priced per block
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.MaxOracleRepegsPerBlock = 1
+	s.Require().NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// AND alice and bob have pegged limit orders
+	s.setOraclePrice("TOKENA/TOKENB", "1")
+	aliceTrancheKey := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 0)
+	bobTrancheKey, err := s.limitSellsPegged(s.bob, "TokenA", 10, "TOKENA/TOKENB", 0)
+	s.Require().NoError(err)
+
+	// WHEN the oracle price moves
+	s.setOraclePrice("TOKENA/TOKENB", "2")
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN only alice's order is re-priced
+	_, found := s.App.DexKeeper.GetPeggedLimitOrder(s.Ctx, aliceTrancheKey)
+	s.False(found)
+	s.assertPeggedAtPrice(bobTrancheKey, "1", 0)
+
+	// WHEN the pegged orders are re-priced in the next block
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN bob's order is re-priced
+	_, found = s.App.DexKeeper.GetPeggedLimitOrder(s.Ctx, bobTrancheKey)
+	s.False(found)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	for _, order := range s.App.DexKeeper.GetAllPeggedLimitOrder(s.Ctx) {
+		s.assertPeggedAtPrice(order.TrancheKey.TrancheKey, "2", 0)
+	}
+}
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderRepegAllowance() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN only one pegged order can be scanned per block
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.OracleRepegAllowance = 1
+	s.Require().NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// AND alice and bob have limit orders pegged to different oracle prices
+	s.setOraclePrice("TOKENA/TOKENB", "1")
+	s.setOraclePrice("TOKENC/TOKENB", "1")
+	aliceTrancheKey := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 0)
+	bobTrancheKey, err := s.limitSellsPegged(s.bob, "TokenA", 10, "TOKENC/TOKENB", 0)
+	s.Require().NoError(err)
+
+	// WHEN only the oracle price of bob's order moves
+	s.setOraclePrice("TOKENC/TOKENB", "2")
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN the block is spent scanning alice's order and bob's order stays in place
+	s.assertPeggedAtPrice(aliceTrancheKey, "1", 0)
+	s.assertPeggedAtPrice(bobTrancheKey, "1", 0)
+
+	// WHEN the pegged orders are re-priced in the next block
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+
+	// THEN the scan resumes after alice's order and bob's order is re-priced
+	s.assertPeggedAtPrice(aliceTrancheKey, "1", 0)
+	_, found := s.App.DexKeeper.GetPeggedLimitOrder(s.Ctx, bobTrancheKey)
+	s.False(found)
+	s.Len(s.App.DexKeeper.GetAllPeggedLimitOrder(s.Ctx), 2)
+}
+
+func (s *DexTestSuite) TestOraclePeggedLimitOrderHasOwnTranche() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN alice has a limit order pegged at an oracle price of 1
+	s.setOraclePrice("TOKENA/TOKENB", "1")
+	trancheKey := s.aliceLimitSellsPegged("TokenA", 10, "TOKENA/TOKENB", 0)
+
+	// WHEN bob places a regular limit order at the same price
+	bobTrancheKey := s.bobLimitSells("TokenA", 0, 10)
+
+	// THEN it is placed in a different tranche
+	s.NotEqual(trancheKey, bobTrancheKey)
+
+	// AND it is not moved when alice's order is re-priced
+	s.setOraclePrice("TOKENA/TOKENB", "2")
+	s.App.DexKeeper.RepegLimitOrders(s.Ctx)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"github.com/neutron-org/neutron/v4/testutil/common/sample"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) setPairCircuitBreaker(tokenA, tokenB string, paused bool, maxTickDeviation uint64) {
+	_, err := s.msgServer.SetPairCircuitBreaker(s.Ctx, types.NewMsgSetPairCircuitBreaker(
+		s.App.DexKeeper.GetAuthority(),
+		tokenA,
+		tokenB,
+		paused,
+		maxTickDeviation,
+	))
+	s.Require().NoError(err)
+}
+
+// Tests
+
+func (s *DexTestSuite) TestPairCircuitBreakerPausesPair() {
+	s.fundAliceBalances(100, 100)
+	s.fundBobBalances(10, 0)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+
+	// WHEN the TokenA<>TokenB pair is paused
+	s.setPairCircuitBreaker("TokenB", "TokenA", true, 0)
+
+	// THEN all messages on the pair fail
+	s.assertAliceDepositFails(types.ErrPairPaused, NewDeposit(0, 10, 0, 1))
+	s.aliceWithdrawFails(types.ErrPairPaused, NewWithdrawal(5, 0, 1))
+	s.assertBobLimitSellFails(types.ErrPairPaused, "TokenA", 10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.aliceWithdrawLimitSellFails(types.ErrPairPaused, trancheKey)
+	s.aliceCancelsLimitSellFails(trancheKey, types.ErrPairPaused)
+
+	// WHEN the pair is unpaused
+	s.setPairCircuitBreaker("TokenA", "TokenB", false, 0)
+
+	// THEN the circuit breaker is removed and messages succeed
+	_, found := s.App.DexKeeper.GetPairCircuitBreaker(s.Ctx, defaultPairID)
+	s.False(found)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+	s.bobLimitSells("TokenA", 10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.aliceCancelsLimitSell(trancheKey)
+}
+
+func (s *DexTestSuite) TestPairCircuitBreakerOtherPairsUnaffected() {
+	s.fundAliceBalances(10, 10)
+
+	// WHEN a different pair is paused
+	s.setPairCircuitBreaker("TokenA", "TokenC", true, 0)
+
+	// THEN TokenA<>TokenB is still usable
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+	s.True(s.App.DexKeeper.IsPairPaused(s.Ctx, &types.PairID{Token0: "TokenA", Token1: "TokenC"}))
+	s.False(s.App.DexKeeper.IsPairPaused(s.Ctx, defaultPairID))
+}
+
+func (s *DexTestSuite) TestPairCircuitBreakerUnauthorized() {
+	// WHEN a random address tries to pause a pair
+	msg := types.NewMsgSetPairCircuitBreaker(s.alice.String(), "TokenA", "TokenB", true, 0)
+	_, err := s.msgServer.SetPairCircuitBreaker(s.Ctx, msg)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrUnauthorizedCircuitBreaker)
+	s.False(s.App.DexKeeper.IsPairPaused(s.Ctx, defaultPairID))
+}
+
+func (s *DexTestSuite) TestPairCircuitBreakerSecurityAddress() {
+	// GIVEN a circuit breaker address is set in params
+	securityAddr := sample.AccAddress()
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.CircuitBreakerAddress = securityAddr
+	s.Require().NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// WHEN the circuit breaker address pauses a pair
+	msg := types.NewMsgSetPairCircuitBreaker(securityAddr, "TokenA", "TokenB", true, 0)
+	_, err := s.msgServer.SetPairCircuitBreaker(s.Ctx, msg)
+
+	// THEN the pair is paused
+	s.NoError(err)
+	s.True(s.App.DexKeeper.IsPairPaused(s.Ctx, defaultPairID))
+}
+
+func (s *DexTestSuite) TestPairCircuitBreakerPriceBandRevertsSwap() {
+	s.fundAliceBalances(0, 20)
+	s.fundBobBalances(20, 0)
+
+	// GIVEN TokenB liquidity 200 ticks apart
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1), NewDeposit(0, 10, 200, 1))
+
+	// AND a price band of 100 ticks recorded at the start of the block
+	s.setPairCircuitBreaker("TokenA", "TokenB", false, 100)
+	s.App.DexKeeper.RecordOpeningTicks(s.Ctx)
+
+	// WHEN bob swaps within the first pool
+	// THEN the swap succeeds
+	s.bobLimitSells("TokenA", 300, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN bob swaps through both pools
+	// THEN the swap reverts
+	s.assertBobLimitSellFails(types.ErrPriceBandExceeded, "TokenA", 300, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertAccountBalanceWithDenom(s.bob, "TokenA", 15)
+}
+
+func (s *DexTestSuite) TestPairCircuitBreakerPriceBandWithinDeviation() {
+	s.fundAliceBalances(0, 20)
+	s.fundBobBalances(20, 0)
+
+	// GIVEN TokenB liquidity 200 ticks apart
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1), NewDeposit(0, 10, 200, 1))
+
+	// AND a price band of 250 ticks
+	s.setPairCircuitBreaker("TokenA", "TokenB", false, 250)
+	s.App.DexKeeper.RecordOpeningTicks(s.Ctx)
+
+	// WHEN bob swaps through both pools
+	// THEN the swap succeeds
+	s.bobLimitSells("TokenA", 300, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) getPositionValues(account sdk.AccAddress, quoteDenom string) []types.PositionValue {
+	resp, err := s.App.DexKeeper.UserPositionValuesAll(s.Ctx, &types.QueryAllUserPositionValuesRequest{
+		Address:    account.String(),
+		QuoteDenom: quoteDenom,
+	})
+	s.Require().NoError(err)
+
+	return resp.Positions
+}
+
+func (s *DexTestSuite) assertPositionValue(
+	position types.PositionValue,
+	amount0, amount1, costBasis0, costBasis1 int64,
+	feesEarned math_utils.PrecDec,
+) {
+	s.Equal(sdkmath.NewInt(amount0), position.Amount0)
+	s.Equal(sdkmath.NewInt(amount1), position.Amount1)
+	s.Equal(sdkmath.NewInt(costBasis0), position.CostBasis0)
+	s.Equal(sdkmath.NewInt(costBasis1), position.CostBasis1)
+	s.True(feesEarned.Equal(position.FeesEarned), "expected fees earned %s, got %s", feesEarned, position.FeesEarned)
+}
+
+// Tests
+
+func (s *DexTestSuite) TestPositionValueAfterDeposit() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice deposits 10 TokenA and 10 TokenB
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// THEN her position is worth what she deposited and has earned no fees
+	positions := s.getPositionValues(s.alice, "")
+	s.Require().Len(positions, 1)
+	s.assertPositionValue(positions[0], 10_000_000, 10_000_000, 10_000_000, 10_000_000, math_utils.ZeroPrecDec())
+	s.Equal(uint64(0), positions[0].Deposit.Pool.Id)
+	s.Nil(positions[0].ValueInQuote)
+	s.Nil(positions[0].FeesEarnedInQuote)
+}
+
+func (s *DexTestSuite) TestPositionValueEarnsFees() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(20, 0)
+
+	// GIVEN alice deposits 10 TokenB
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN bob buys all of it
+	s.bobLimitSells("TokenA", 10, 20, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN alice's position holds the TokenA paid by bob and has earned the fee on top of the TokenB price
+	positions := s.getPositionValues(s.alice, "")
+	s.Require().Len(positions, 1)
+	s.assertPositionValue(positions[0], 10_001_000, 0, 0, 10_000_000, math_utils.NewPrecDec(1000))
+}
+
+func (s *DexTestSuite) TestPositionValueInQuoteDenom() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice deposits 10 TokenA and 10 TokenB
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN her position is valued in TokenA
+	positions := s.getPositionValues(s.alice, "TokenA")
+	s.Require().Len(positions, 1)
+
+	// THEN the TokenB is valued at the best price for selling it for TokenA
+	priceB, found := s.App.DexKeeper.GetCurrPriceInQuote(s.Ctx, "TokenB", "TokenA")
+	s.Require().True(found)
+	expectedValue := math_utils.NewPrecDec(10_000_000).Add(priceB.MulInt64(10_000_000))
+	s.Require().NotNil(positions[0].ValueInQuote)
+	s.True(expectedValue.Equal(*positions[0].ValueInQuote), "expected %s, got %s", expectedValue, positions[0].ValueInQuote)
+	s.Require().NotNil(positions[0].FeesEarnedInQuote)
+	s.True(positions[0].FeesEarnedInQuote.IsZero())
+
+	// WHEN it is valued in a denom without a price
+	positions = s.getPositionValues(s.alice, "TokenC")
+
+	// THEN it has no value in the quote denom
+	s.Require().Len(positions, 1)
+	s.Nil(positions[0].ValueInQuote)
+	s.Nil(positions[0].FeesEarnedInQuote)
+}
+
+func (s *DexTestSuite) TestPositionValuePartialWithdraw() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice deposits 10 TokenA and 10 TokenB
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN she withdraws half of her shares
+	s.aliceWithdraws(NewWithdrawal(10, 0, 1))
+
+	// THEN her cost basis is halved
+	positions := s.getPositionValues(s.alice, "")
+	s.Require().Len(positions, 1)
+	s.assertPositionValue(positions[0], 5_000_000, 5_000_000, 5_000_000, 5_000_000, math_utils.ZeroPrecDec())
+
+	// WHEN she withdraws the rest
+	s.aliceWithdraws(NewWithdrawal(10, 0, 1))
+
+	// THEN she has no positions or cost basis left
+	s.Empty(s.getPositionValues(s.alice, ""))
+	s.Empty(s.App.DexKeeper.GetAllDepositCostBasis(s.Ctx))
+}
+
+func (s *DexTestSuite) TestPositionValueTransferredShares() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice deposits 10 TokenA and 10 TokenB
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN she sends half of her shares to bob
+	shares := sdk.NewCoin(types.NewPoolDenom(0), sdkmath.NewInt(10_000_000))
+	err := s.App.BankKeeper.SendCoins(s.Ctx, s.alice, s.bob, sdk.NewCoins(shares))
+	s.Require().NoError(err)
+
+	// THEN alice keeps the cost basis of her remaining shares
+	positions := s.getPositionValues(s.alice, "")
+	s.Require().Len(positions, 1)
+	s.assertPositionValue(positions[0], 5_000_000, 5_000_000, 5_000_000, 5_000_000, math_utils.ZeroPrecDec())
+
+	// AND bob's shares have no cost basis
+	positions = s.getPositionValues(s.bob, "")
+	s.Require().Len(positions, 1)
+	s.assertPositionValue(positions[0], 5_000_000, 5_000_000, 0, 0, math_utils.ZeroPrecDec())
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) setProtocolFeeParams(share math_utils.PrecDec, collector string) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.ProtocolFeeShare = share
+	params.ProtocolFeeCollector = collector
+	err := s.App.DexKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(err)
+}
+
+func (s *DexTestSuite) expectedProtocolFee(amountIn int, fee int64, share math_utils.PrecDec) sdkmath.Int {
+	lpFeeRate := math_utils.OnePrecDec().Sub(types.MustCalcPrice(fee))
+	return lpFeeRate.Mul(share).MulInt(sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple)).TruncateInt()
+}
+
+func (s *DexTestSuite) assertPoolLiquidityA(amountA sdkmath.Int, tickIndex int64, fee uint64) {
+	liquidityA, _ := s.getLiquidityAtTick(tickIndex, fee)
+	s.Assert().True(amountA.Equal(liquidityA), "liquidity A: actual %s, expected %s", liquidityA, amountA)
+}
+
+func (s *DexTestSuite) assertProtocolFee(denom string, accrued, pending sdkmath.Int) {
+	protocolFee, found := s.App.DexKeeper.GetProtocolFee(s.Ctx, denom)
+	s.Require().True(found)
+	s.Assert().True(accrued.Equal(protocolFee.Accrued), "accrued: actual %s, expected %s", protocolFee.Accrued, accrued)
+	s.Assert().True(pending.Equal(protocolFee.Pending), "pending: actual %s, expected %s", protocolFee.Pending, pending)
+}
+
+// Tests
+
+func (s *DexTestSuite) TestProtocolFeeNotTakenByDefault() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(5, 0)
+
+	// GIVEN a pool with TokenB liquidity and the default params
+	s.aliceDeposits(NewDeposit(0, 10, 0, 20))
+
+	// WHEN bob swaps through the pool
+	s.bobLimitSells("TokenA", 30, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN the full amount in stays in the pool and no protocol fee is recorded
+	s.assertPoolLiquidityA(sdkmath.NewInt(5).Mul(denomMultiple), 0, 20)
+	_, found := s.App.DexKeeper.GetProtocolFee(s.Ctx, "TokenA")
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestProtocolFeeTakenOnTakerSwap() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(5, 0)
+	share := math_utils.MustNewPrecDecFromStr("0.5")
+	s.setProtocolFeeParams(share, "")
+
+	// GIVEN a pool with TokenB liquidity
+	s.aliceDeposits(NewDeposit(0, 10, 0, 20))
+
+	// WHEN bob swaps through the pool
+	s.bobLimitSells("TokenA", 30, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN half of the LP fee is removed from the pool reserves and accrued as a protocol fee
+	expectedFee := s.expectedProtocolFee(5, 20, share)
+	s.True(expectedFee.IsPositive())
+	s.assertPoolLiquidityA(sdkmath.NewInt(5).Mul(denomMultiple).Sub(expectedFee), 0, 20)
+	s.assertProtocolFee("TokenA", expectedFee, expectedFee)
+
+	// AND the protocol fee is held by the dex until a collector is set
+	s.App.DexKeeper.DistributeProtocolFees(s.Ctx)
+	s.assertDexBalanceWithDenom("TokenA", 5)
+	s.assertProtocolFee("TokenA", expectedFee, expectedFee)
+}
+
+func (s *DexTestSuite) TestProtocolFeeTakenOnMakerSwap() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(20, 0)
+	share := math_utils.MustNewPrecDecFromStr("0.5")
+	s.setProtocolFeeParams(share, "")
+
+	// GIVEN a pool with TokenB liquidity
+	s.aliceDeposits(NewDeposit(0, 10, 0, 20))
+
+	// WHEN bob places a GTC limit order that swaps through the pool before resting on the book
+	s.bobLimitSells("TokenA", 30, 20)
+
+	// THEN a protocol fee is taken from the swapped portion
+	protocolFee, found := s.App.DexKeeper.GetProtocolFee(s.Ctx, "TokenA")
+	s.True(found)
+	s.True(protocolFee.Accrued.IsPositive())
+}
+
+func (s *DexTestSuite) TestProtocolFeeDistributedToCollector() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(5, 0)
+	share := math_utils.MustNewPrecDecFromStr("0.5")
+	s.setProtocolFeeParams(share, s.carol.String())
+
+	// GIVEN a pool with TokenB liquidity
+	s.aliceDeposits(NewDeposit(0, 10, 0, 20))
+
+	// WHEN bob swaps through the pool and the protocol fees are distributed
+	s.bobLimitSells("TokenA", 30, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.App.DexKeeper.DistributeProtocolFees(s.Ctx)
+
+	// THEN the collector receives the protocol fee and nothing is left pending
+	expectedFee := s.expectedProtocolFee(5, 20, share)
+	s.assertAccountBalanceWithDenomInt(s.carol, "TokenA", expectedFee)
+	s.assertDexBalanceWithDenomInt("TokenA", sdkmath.NewInt(5).Mul(denomMultiple).Sub(expectedFee))
+	s.assertProtocolFee("TokenA", expectedFee, sdkmath.ZeroInt())
+}
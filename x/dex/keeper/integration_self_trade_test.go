@@ -0,0 +1,331 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) limitSellsWithSelfTradePrevention(
+	account sdk.AccAddress,
+	tokenIn string,
+	tickIndexNormalized, amountIn int,
+	orderType types.LimitOrderType,
+	selfTradePrevention types.SelfTradePrevention,
+) (string, error) {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, tokenIn)
+	tickIndexTakerToMaker := tradePairID.TickIndexTakerToMaker(int64(tickIndexNormalized))
+
+	cacheCtx, writeCache := s.Ctx.CacheContext()
+	msg, err := s.msgServer.PlaceLimitOrder(cacheCtx, &types.MsgPlaceLimitOrder{
+		Creator:             account.String(),
+		Receiver:            account.String(),
+		TokenIn:             tradePairID.TakerDenom,
+		TokenOut:            tradePairID.MakerDenom,
+		TickIndexInToOut:    tickIndexTakerToMaker,
+		AmountIn:            sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:           orderType,
+		SelfTradePrevention: selfTradePrevention,
+	})
+	if err != nil {
+		return "", err
+	}
+	writeCache()
+
+	return msg.TrancheKey, nil
+}
+
+func (s *DexTestSuite) aliceLimitSellsWithSelfTradePrevention(
+	tokenIn string,
+	tickIndexNormalized, amountIn int,
+	orderType types.LimitOrderType,
+	selfTradePrevention types.SelfTradePrevention,
+) {
+	_, err := s.limitSellsWithSelfTradePrevention(s.alice, tokenIn, tickIndexNormalized, amountIn, orderType, selfTradePrevention)
+	s.Require().NoError(err)
+}
+
+func (s *DexTestSuite) aliceMultiHopSwapsWithSelfTradePrevention(
+	routes [][]string,
+	amountIn int,
+	exitLimitPrice math_utils.PrecDec,
+	selfTradePrevention types.SelfTradePrevention,
+) error {
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		routes,
+		sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		exitLimitPrice,
+		false,
+	)
+	msg.SelfTradePrevention = selfTradePrevention
+
+	cacheCtx, writeCache := s.Ctx.CacheContext()
+	_, err := s.msgServer.MultiHopSwap(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+	writeCache()
+
+	return nil
+}
+
+// Tests
+
+func (s *DexTestSuite) TestSelfTradeAllowedByDefault() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN alice places an order against it without self trade prevention
+	s.aliceLimitSellsWithSelfTradePrevention(
+		"TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL, types.SelfTradePrevention_ALLOW_SELF_TRADE,
+	)
+
+	// THEN alice's own order is filled
+	s.assertLimitLiquidityAtTick("TokenA", 0, 5)
+	s.assertAliceBalances(5, 5)
+}
+
+func (s *DexTestSuite) TestSelfTradeCancelNewestFailsWithoutFill() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN alice places an order that only crosses alice's own order with CANCEL_NEWEST
+	_, err := s.limitSellsWithSelfTradePrevention(
+		s.alice, "TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL, types.SelfTradePrevention_CANCEL_NEWEST,
+	)
+
+	// THEN the new order is rejected and the resting order is left untouched
+	s.ErrorIs(err, types.ErrSelfTradePrevented)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertAliceBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestSelfTradeCancelNewestKeepsFilledPortion() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN bob has a limit order at a better price than alice's
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.bobLimitSells("TokenA", 1, 10)
+
+	// WHEN alice places an order that crosses both with CANCEL_NEWEST
+	s.aliceLimitSellsWithSelfTradePrevention(
+		"TokenB", -1, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL, types.SelfTradePrevention_CANCEL_NEWEST,
+	)
+
+	// THEN bob's order is filled and the rest of alice's order is cancelled once it reaches the resting order
+	s.assertLimitLiquidityAtTick("TokenA", 1, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 10)
+	s.True(s.App.BankKeeper.GetBalance(s.Ctx, s.alice, "TokenB").IsPositive())
+}
+
+func (s *DexTestSuite) TestSelfTradeCancelNewestDoesNotPlaceMakerPortion() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN bob has a limit order at a better price than alice's
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.bobLimitSells("TokenA", 1, 10)
+
+	// WHEN alice places a GTC order that crosses both with CANCEL_NEWEST
+	s.aliceLimitSellsWithSelfTradePrevention(
+		"TokenB", -1, 20, types.LimitOrderType_GOOD_TIL_CANCELLED, types.SelfTradePrevention_CANCEL_NEWEST,
+	)
+
+	// THEN only bob's order is filled and no maker order is placed with the remainder
+	s.assertLimitLiquidityAtTick("TokenA", 1, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertLimitLiquidityAtTick("TokenB", -1, 0)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 10)
+}
+
+func (s *DexTestSuite) TestSelfTradeCancelOldest() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN alice has a limit order at a better price than bob's
+	s.aliceLimitSells("TokenA", 1, 10)
+	s.bobLimitSells("TokenA", 0, 10)
+
+	// WHEN alice places an order that crosses both with CANCEL_OLDEST
+	s.aliceLimitSellsWithSelfTradePrevention(
+		"TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL, types.SelfTradePrevention_CANCEL_OLDEST,
+	)
+
+	// THEN alice's resting order is cancelled and bob's order is filled instead
+	s.assertLimitLiquidityAtTick("TokenA", 1, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 5)
+	s.assertAliceBalances(15, 5)
+}
+
+func (s *DexTestSuite) TestSelfTradeCancelOldestSkipsSharedTranche() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+	s.fundCarolBalances(10, 0)
+
+	// GIVEN alice and bob share a tranche at a better price than carol's order
+	s.aliceLimitSells("TokenA", 1, 10)
+	s.bobLimitSells("TokenA", 1, 10)
+	s.carolLimitSells("TokenA", 0, 10)
+
+	// WHEN alice places an order that crosses them with CANCEL_OLDEST
+	s.aliceLimitSellsWithSelfTradePrevention(
+		"TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL, types.SelfTradePrevention_CANCEL_OLDEST,
+	)
+
+	// THEN alice's part of the shared tranche is cancelled and the rest of it is not filled
+	s.assertLimitLiquidityAtTick("TokenA", 1, 10)
+
+	// AND carol's order is filled instead
+	s.assertLimitLiquidityAtTick("TokenA", 0, 5)
+	s.assertAliceBalances(15, 5)
+}
+
+func (s *DexTestSuite) TestSelfTradeSkipOwnOrders() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN alice has a limit order at a better price than bob's
+	s.aliceLimitSells("TokenA", 1, 10)
+	s.bobLimitSells("TokenA", 0, 10)
+
+	// WHEN alice places an order that crosses both with SKIP_OWN_ORDERS
+	s.aliceLimitSellsWithSelfTradePrevention(
+		"TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL, types.SelfTradePrevention_SKIP_OWN_ORDERS,
+	)
+
+	// THEN alice's order is left untouched and bob's order is filled instead
+	s.assertLimitLiquidityAtTick("TokenA", 1, 10)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 5)
+	s.assertAliceBalances(5, 5)
+}
+
+func (s *DexTestSuite) TestSelfTradePreventionIgnoresOtherUsersOrders() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places an order against it with CANCEL_NEWEST
+	_, err := s.limitSellsWithSelfTradePrevention(
+		s.bob, "TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL, types.SelfTradePrevention_CANCEL_NEWEST,
+	)
+
+	// THEN alice's order is filled as usual
+	s.NoError(err)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 5)
+	s.assertBobBalances(5, 5)
+}
+
+func (s *DexTestSuite) TestSelfTradeMultiHopSwapCancelNewestFails() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN alice swaps through it with CANCEL_NEWEST
+	err := s.aliceMultiHopSwapsWithSelfTradePrevention(
+		[][]string{{"TokenB", "TokenA"}},
+		5,
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		types.SelfTradePrevention_CANCEL_NEWEST,
+	)
+
+	// THEN the swap fails and the resting order is left untouched
+	s.ErrorIs(err, types.ErrSelfTradePrevented)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertAliceBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestSelfTradeMultiHopSwapCancelOldest() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN alice has a limit order at a better price than bob's
+	s.aliceLimitSells("TokenA", 1, 10)
+	s.bobLimitSells("TokenA", 0, 10)
+
+	// WHEN alice swaps through both with CANCEL_OLDEST
+	err := s.aliceMultiHopSwapsWithSelfTradePrevention(
+		[][]string{{"TokenB", "TokenA"}},
+		5,
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		types.SelfTradePrevention_CANCEL_OLDEST,
+	)
+
+	// THEN alice's resting order is cancelled and bob's order is filled instead
+	s.NoError(err)
+	s.assertLimitLiquidityAtTick("TokenA", 1, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 5)
+	s.assertAliceBalances(15, 5)
+}
+
+func (s *DexTestSuite) TestSelfTradeSwapExactInSkipOwnOrders() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN alice has a limit order at a better price than bob's
+	s.aliceLimitSells("TokenA", 1, 10)
+	s.bobLimitSells("TokenA", 0, 10)
+
+	// WHEN alice swaps through both with SKIP_OWN_ORDERS
+	_, err := s.msgServer.SwapExactIn(s.Ctx, &types.MsgSwapExactIn{
+		Creator:             s.alice.String(),
+		Receiver:            s.alice.String(),
+		TokenIn:             "TokenB",
+		TokenOut:            "TokenA",
+		AmountIn:            sdkmath.NewInt(5).Mul(denomMultiple),
+		ExitLimitPrice:      math_utils.MustNewPrecDecFromStr("0.9"),
+		SelfTradePrevention: types.SelfTradePrevention_SKIP_OWN_ORDERS,
+	})
+
+	// THEN alice's order is left untouched and bob's order is filled instead
+	s.NoError(err)
+	s.assertLimitLiquidityAtTick("TokenA", 1, 10)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 5)
+	s.assertAliceBalances(5, 5)
+}
+
+func (s *DexTestSuite) TestSelfTradeEstimatesRespectSelfTradePrevention() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has a limit order
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN alice estimates orders against it with CANCEL_NEWEST
+	_, limitOrderErr := s.App.DexKeeper.EstimatePlaceLimitOrder(s.Ctx, &types.QueryEstimatePlaceLimitOrderRequest{
+		Creator:             s.alice.String(),
+		Receiver:            s.alice.String(),
+		TokenIn:             "TokenB",
+		TokenOut:            "TokenA",
+		TickIndexInToOut:    1,
+		AmountIn:            sdkmath.NewInt(5).Mul(denomMultiple),
+		OrderType:           types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		SelfTradePrevention: types.SelfTradePrevention_CANCEL_NEWEST,
+	})
+	_, multiHopErr := s.App.DexKeeper.EstimateMultiHopSwap(s.Ctx, &types.QueryEstimateMultiHopSwapRequest{
+		Creator:             s.alice.String(),
+		Receiver:            s.alice.String(),
+		Routes:              []*types.MultiHopRoute{{Hops: []string{"TokenB", "TokenA"}}},
+		AmountIn:            sdkmath.NewInt(5).Mul(denomMultiple),
+		ExitLimitPrice:      math_utils.MustNewPrecDecFromStr("0.9"),
+		SelfTradePrevention: types.SelfTradePrevention_CANCEL_NEWEST,
+	})
+
+	// THEN both estimates fail like the real transactions would
+	s.ErrorIs(limitOrderErr, types.ErrSelfTradePrevented)
+	s.ErrorIs(multiHopErr, types.ErrSelfTradePrevented)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) depositsWithMinShares(
+	account sdk.AccAddress,
+	deposit *Deposit,
+	minSharesOut int64,
+) (*types.MsgDepositResponse, error) {
+	// Only persist successful deposits, as a failed tx would be reverted
+	cacheCtx, writeCache := s.Ctx.CacheContext()
+	resp, err := s.msgServer.Deposit(cacheCtx, &types.MsgDeposit{
+		Creator:         account.String(),
+		Receiver:        account.String(),
+		TokenA:          "TokenA",
+		TokenB:          "TokenB",
+		AmountsA:        []sdkmath.Int{deposit.AmountA},
+		AmountsB:        []sdkmath.Int{deposit.AmountB},
+		TickIndexesAToB: []int64{deposit.TickIndex},
+		Fees:            []uint64{deposit.Fee},
+		Options:         []*types.DepositOptions{deposit.Options},
+		MinSharesOut:    []sdkmath.Int{sdkmath.NewInt(minSharesOut)},
+	})
+	if err == nil {
+		writeCache()
+	}
+
+	return resp, err
+}
+
+func (s *DexTestSuite) withdrawsWithMinAmounts(
+	account sdk.AccAddress,
+	withdrawal *Withdrawal,
+	minAmount0Out, minAmount1Out *sdkmath.Int,
+) error {
+	// Only persist successful withdrawals, as a failed tx would be reverted
+	cacheCtx, writeCache := s.Ctx.CacheContext()
+	_, err := s.msgServer.Withdrawal(cacheCtx, &types.MsgWithdrawal{
+		Creator:         account.String(),
+		Receiver:        account.String(),
+		TokenA:          "TokenA",
+		TokenB:          "TokenB",
+		SharesToRemove:  []sdkmath.Int{withdrawal.Shares},
+		TickIndexesAToB: []int64{withdrawal.TickIndex},
+		Fees:            []uint64{withdrawal.Fee},
+		MinAmount0Out:   minAmount0Out,
+		MinAmount1Out:   minAmount1Out,
+	})
+	if err == nil {
+		writeCache()
+	}
+
+	return err
+}
+
+// Tests
+
+func (s *DexTestSuite) TestDepositMinSharesOut() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(20, 20)
+
+	// GIVEN a balanced pool at tick 0
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN bob requires more shares than the deposit issues
+	_, err := s.depositsWithMinShares(s.bob, NewDeposit(10, 10, 0, 1), 20_000_001)
+
+	// THEN the deposit fails
+	s.ErrorIs(err, types.ErrDepositSharesBelowMin)
+	s.assertBobBalances(20, 20)
+
+	// WHEN bob requires exactly the shares the deposit issues
+	_, err = s.depositsWithMinShares(s.bob, NewDeposit(10, 10, 0, 1), 20_000_000)
+
+	// THEN the deposit succeeds
+	s.NoError(err)
+	s.assertBobBalances(10, 10)
+	s.assertBobShares(0, 1, 20)
+}
+
+func (s *DexTestSuite) TestDepositMinSharesOutFrontRunAutoswap() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(5, 5)
+	s.fundCarolBalances(5, 0)
+
+	// GIVEN a balanced pool at tick 0 where a 5/5 deposit would issue 10 shares
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// AND carol swaps through the pool ahead of bob's deposit, leaving it unbalanced
+	s.carolLimitSells("TokenA", 10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN bob deposits requiring the shares expected before carol's swap
+	_, err := s.depositsWithMinShares(s.bob, NewDeposit(5, 5, 0, 1), 10_000_000)
+
+	// THEN the deposit fails instead of being autoswapped at a loss
+	s.ErrorIs(err, types.ErrDepositSharesBelowMin)
+	s.assertBobBalances(5, 5)
+}
+
+func (s *DexTestSuite) TestWithdrawMinAmountsOut() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has deposited into a balanced pool
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice requires more TokenA than half of the shares are worth
+	minAmountTooHigh := sdkmath.NewInt(5_000_001)
+	err := s.withdrawsWithMinAmounts(s.alice, NewWithdrawal(10, 0, 1), &minAmountTooHigh, nil)
+
+	// THEN the withdrawal fails
+	s.ErrorIs(err, types.ErrWithdrawAmountBelowMin)
+	s.assertAliceShares(0, 1, 20)
+
+	// WHEN alice requires more TokenB than half of the shares are worth
+	err = s.withdrawsWithMinAmounts(s.alice, NewWithdrawal(10, 0, 1), nil, &minAmountTooHigh)
+
+	// THEN the withdrawal fails
+	s.ErrorIs(err, types.ErrWithdrawAmountBelowMin)
+
+	// WHEN the minimums are met
+	minAmount := sdkmath.NewInt(5_000_000)
+	err = s.withdrawsWithMinAmounts(s.alice, NewWithdrawal(10, 0, 1), &minAmount, &minAmount)
+
+	// THEN the withdrawal succeeds
+	s.NoError(err)
+	s.assertAliceBalances(5, 5)
+}
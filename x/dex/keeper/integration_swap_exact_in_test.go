@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceSwapsExactIn(
+	tokenIn, tokenOut string,
+	amountIn int,
+	exitLimitPrice math_utils.PrecDec,
+	maxHops uint64,
+) sdk.Coin {
+	msg := types.NewMsgSwapExactIn(
+		s.alice.String(),
+		s.alice.String(),
+		tokenIn,
+		tokenOut,
+		math.NewInt(int64(amountIn)).Mul(denomMultiple),
+		exitLimitPrice,
+		maxHops,
+	)
+	resp, err := s.msgServer.SwapExactIn(s.Ctx, msg)
+	s.Assert().NoError(err)
+
+	return resp.CoinOut
+}
+
+func (s *DexTestSuite) estimateBestRoute(
+	tokenIn, tokenOut string,
+	amountIn int,
+	maxHops uint64,
+) (*types.QueryEstimateBestRouteResponse, error) {
+	return s.App.DexKeeper.EstimateBestRoute(s.Ctx, &types.QueryEstimateBestRouteRequest{
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+		AmountIn: math.NewInt(int64(amountIn)).Mul(denomMultiple),
+		MaxHops:  maxHops,
+	})
+}
+
+func (s *DexTestSuite) setupRouteDiscoveryPools() {
+	// A<>X directly at a poor price, and via B<>C and B<>D with C<>X and D<>X at progressively better prices
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenX", 0, 1000, 2000, 1),
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+		NewPoolSetup("TokenC", "TokenX", 0, 1000, -1000, 1),
+		NewPoolSetup("TokenB", "TokenD", 0, 100, 0, 1),
+		NewPoolSetup("TokenD", "TokenX", 0, 1000, -2000, 1),
+	)
+}
+
+func (s *DexTestSuite) TestEstimateBestRouteRanksRoutes() {
+	s.setupRouteDiscoveryPools()
+
+	// WHEN estimating the best route from A to X
+	resp, err := s.estimateBestRoute("TokenA", "TokenX", 100, 0)
+	s.NoError(err)
+
+	// THEN all routes are returned ordered from best to worst
+	s.Len(resp.Routes, 3)
+	s.Equal([]string{"TokenA", "TokenB", "TokenD", "TokenX"}, resp.Routes[0].Route.Hops)
+	s.Equal([]string{"TokenA", "TokenB", "TokenC", "TokenX"}, resp.Routes[1].Route.Hops)
+	s.Equal([]string{"TokenA", "TokenX"}, resp.Routes[2].Route.Hops)
+	s.True(resp.Routes[0].CoinOut.Amount.GT(resp.Routes[1].CoinOut.Amount))
+	s.True(resp.Routes[1].CoinOut.Amount.GT(resp.Routes[2].CoinOut.Amount))
+	s.Equal("TokenX", resp.Routes[0].CoinOut.Denom)
+
+	// AND no state is changed
+	s.assertDexBalanceWithDenom("TokenA", 0)
+}
+
+func (s *DexTestSuite) TestEstimateBestRouteMaxHops() {
+	s.setupRouteDiscoveryPools()
+
+	// WHEN estimating the best route with a single hop
+	resp, err := s.estimateBestRoute("TokenA", "TokenX", 100, 1)
+	s.NoError(err)
+
+	// THEN only the direct route is returned
+	s.Len(resp.Routes, 1)
+	s.Equal([]string{"TokenA", "TokenX"}, resp.Routes[0].Route.Hops)
+}
+
+func (s *DexTestSuite) TestEstimateBestRouteNoRoute() {
+	s.setupRouteDiscoveryPools()
+
+	// WHEN estimating a route to a denom without liquidity
+	_, err := s.estimateBestRoute("TokenA", "TokenZ", 100, 0)
+
+	// THEN no route is found
+	s.ErrorIs(err, types.ErrNoRouteFound)
+
+	// WHEN estimating a route in a direction without liquidity
+	_, err = s.estimateBestRoute("TokenX", "TokenA", 100, 0)
+
+	// THEN no route is found
+	s.ErrorIs(err, types.ErrNoRouteFound)
+}
+
+func (s *DexTestSuite) TestSwapExactInUsesBestRoute() {
+	s.fundAliceBalances(100, 0)
+	s.setupRouteDiscoveryPools()
+
+	estimate, err := s.estimateBestRoute("TokenA", "TokenX", 100, 0)
+	s.NoError(err)
+
+	// WHEN alice swaps A for X without specifying a route
+	coinOut := s.aliceSwapsExactIn("TokenA", "TokenX", 100, math_utils.MustNewPrecDecFromStr("0.9"), 0)
+
+	// THEN the swap goes through A<>B, B<>D, D<>X
+	s.Equal(estimate.Routes[0].CoinOut, coinOut)
+	s.assertAccountBalanceWithDenomInt(s.alice, "TokenX", coinOut.Amount)
+	s.assertAccountBalanceWithDenomInt(s.alice, "TokenA", math.NewInt(1)) // dust left
+	s.assertLiquidityAtTickWithDenom(&types.PairID{Token0: "TokenA", Token1: "TokenX"}, 0, 1000, 2000, 1)
+	s.assertLiquidityAtTickWithDenom(&types.PairID{Token0: "TokenC", Token1: "TokenX"}, 0, 1000, -1000, 1)
+}
+
+func (s *DexTestSuite) TestSwapExactInMaxHops() {
+	s.fundAliceBalances(100, 0)
+	s.setupRouteDiscoveryPools()
+
+	// WHEN alice swaps A for X with a single hop
+	coinOut := s.aliceSwapsExactIn("TokenA", "TokenX", 100, math_utils.MustNewPrecDecFromStr("0.1"), 1)
+
+	// THEN the swap goes through the direct A<>X pool
+	s.assertAccountBalanceWithDenomInt(s.alice, "TokenX", coinOut.Amount)
+	s.assertLiquidityAtTickWithDenom(&types.PairID{Token0: "TokenA", Token1: "TokenB"}, 0, 100, 0, 1)
+}
+
+func (s *DexTestSuite) TestSwapExactInNoRouteFails() {
+	s.fundAliceBalances(100, 0)
+	s.setupRouteDiscoveryPools()
+
+	// WHEN alice swaps A for a denom without liquidity
+	msg := types.NewMsgSwapExactIn(
+		s.alice.String(),
+		s.alice.String(),
+		"TokenA",
+		"TokenZ",
+		math.NewInt(100).Mul(denomMultiple),
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		0,
+	)
+	_, err := s.msgServer.SwapExactIn(s.Ctx, msg)
+
+	// THEN the swap fails
+	s.ErrorIs(err, types.ErrNoRouteFound)
+	s.assertAliceBalances(100, 0)
+}
+
+func (s *DexTestSuite) TestFindRoutesConsumesGasPerExpansion() {
+	s.setupRouteDiscoveryPools()
+
+	// WHEN finding routes from A to X
+	ctx := s.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	routes := s.App.DexKeeper.FindRoutes(ctx, "TokenA", "TokenX", 0)
+
+	// THEN gas is charged for every expanded path, including those that do not reach X
+	// A->B, A->X, A->B->C, A->B->D, A->B->C->X, A->B->D->X
+	s.Len(routes, 3)
+	s.GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(6*types.RouteExpansionGas))
+}
+
+func (s *DexTestSuite) TestFindRoutesIgnoresPairsWithoutLiquidity() {
+	s.setupRouteDiscoveryPools()
+
+	// GIVEN an indexed A<>Z pair without any liquidity
+	s.App.DexKeeper.IncPairRefCount(s.Ctx, &types.PairID{Token0: "TokenA", Token1: "TokenZ"})
+
+	// WHEN finding routes from A to Z
+	routes := s.App.DexKeeper.FindRoutes(s.Ctx, "TokenA", "TokenZ", 0)
+
+	// THEN no route is found
+	s.Empty(routes)
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) aliceWithdrawsPosition(tokenA, tokenB, percentage string) (*types.MsgWithdrawPositionResponse, error) {
+	return s.msgServer.WithdrawPosition(s.Ctx, &types.MsgWithdrawPosition{
+		Creator:    s.alice.String(),
+		Receiver:   s.alice.String(),
+		TokenA:     tokenA,
+		TokenB:     tokenB,
+		Percentage: math_utils.MustNewPrecDecFromStr(percentage),
+	})
+}
+
+// Tests
+
+func (s *DexTestSuite) TestWithdrawPositionAll() {
+	s.fundAliceBalances(20, 20)
+
+	// GIVEN alice has positions in several pools of the pair
+	s.aliceDeposits(
+		NewDeposit(10, 0, -5, 1),
+		NewDeposit(0, 10, 5, 1),
+		NewDeposit(10, 10, 0, 5),
+	)
+	s.assertAliceBalances(0, 0)
+
+	// WHEN alice withdraws all positions
+	resp, err := s.aliceWithdrawsPosition("TokenB", "TokenA", "100")
+	s.NoError(err)
+
+	// THEN all shares are burned and the withdrawn reserves are returned
+	s.assertAliceBalances(20, 20)
+	s.assertDexBalances(0, 0)
+	s.assertAliceShares(-5, 1, 0)
+	s.assertAliceShares(5, 1, 0)
+	s.assertAliceShares(0, 5, 0)
+	s.True(resp.Reserve0Withdrawn.Equal(sdkmath.NewInt(20).Mul(denomMultiple)))
+	s.True(resp.Reserve1Withdrawn.Equal(sdkmath.NewInt(20).Mul(denomMultiple)))
+}
+
+func (s *DexTestSuite) TestWithdrawPositionPercentage() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has a position
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice withdraws 25% of it
+	resp, err := s.aliceWithdrawsPosition("TokenA", "TokenB", "25")
+	s.NoError(err)
+
+	// THEN a quarter of the shares are withdrawn
+	s.assertAliceShares(0, 1, 15)
+	s.assertAliceBalancesInt(sdkmath.NewInt(2_500_000), sdkmath.NewInt(2_500_000))
+	s.True(resp.Reserve0Withdrawn.Equal(sdkmath.NewInt(2_500_000)))
+	s.True(resp.Reserve1Withdrawn.Equal(sdkmath.NewInt(2_500_000)))
+}
+
+func (s *DexTestSuite) TestWithdrawPositionOtherPairsUntouched() {
+	s.fundAliceBalances(10, 0)
+	s.FundAcc(s.alice, sdk.NewCoins(sdk.NewCoin("TokenC", sdkmath.NewInt(10).Mul(denomMultiple))))
+
+	// GIVEN alice has positions in two pairs
+	s.aliceDeposits(NewDeposit(5, 0, 0, 1))
+	s.depositsSuccess(s.alice, []*Deposit{NewDeposit(5, 10, 0, 1)}, types.PairID{Token0: "TokenA", Token1: "TokenC"})
+
+	// WHEN alice withdraws all of the TokenA<>TokenB position
+	_, err := s.aliceWithdrawsPosition("TokenA", "TokenB", "100")
+	s.NoError(err)
+
+	// THEN the TokenA<>TokenC position is untouched
+	s.assertAliceShares(0, 1, 0)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 5)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenC", 0)
+}
+
+func (s *DexTestSuite) TestWithdrawPositionNoPositionFails() {
+	// WHEN alice withdraws from a pair without a position in it
+	_, err := s.aliceWithdrawsPosition("TokenA", "TokenB", "100")
+
+	// THEN the withdrawal fails
+	s.ErrorIs(err, types.ErrNoPosition)
+}
+
+func (s *DexTestSuite) TestWithdrawalResponseReserves() {
+	s.fundAliceBalances(10, 10)
+
+	// GIVEN alice has a position
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN alice withdraws half of it with MsgWithdrawal
+	resp, err := s.msgServer.Withdrawal(s.Ctx, &types.MsgWithdrawal{
+		Creator:         s.alice.String(),
+		Receiver:        s.alice.String(),
+		TokenA:          "TokenB",
+		TokenB:          "TokenA",
+		SharesToRemove:  []sdkmath.Int{sdkmath.NewInt(10).Mul(denomMultiple)},
+		TickIndexesAToB: []int64{0},
+		Fees:            []uint64{1},
+	})
+	s.NoError(err)
+
+	// THEN the response contains the withdrawn reserves
+	s.True(resp.Reserve0Withdrawn.Equal(sdkmath.NewInt(5).Mul(denomMultiple)))
+	s.True(resp.Reserve1Withdrawn.Equal(sdkmath.NewInt(5).Mul(denomMultiple)))
+}
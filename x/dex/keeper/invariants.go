@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// RegisterInvariants registers all dex invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-shares", PoolSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tranche-user-claims", TrancheUserClaimsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the dex module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = PoolSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TrancheUserClaimsInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the dex module account holds exactly the funds accounted for by its state:
+// pool reserves, active and inactive tranche reserves, escrowed conditional orders and pending protocol fees.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]math.Int)
+		add := func(denom string, amount math.Int) {
+			if amount.IsZero() {
+				return
+			}
+			if total, ok := expected[denom]; ok {
+				amount = total.Add(amount)
+			}
+			expected[denom] = amount
+		}
+
+		for _, tick := range k.GetAllTickLiquidity(ctx) {
+			switch liquidity := tick.Liquidity.(type) {
+			case *types.TickLiquidity_PoolReserves:
+				reserves := liquidity.PoolReserves
+				add(reserves.Key.TradePairId.MakerDenom, reserves.ReservesMakerDenom)
+			case *types.TickLiquidity_LimitOrderTranche:
+				tranche := liquidity.LimitOrderTranche
+				add(tranche.Key.TradePairId.MakerDenom, tranche.ReservesMakerDenom)
+				add(tranche.Key.TradePairId.TakerDenom, tranche.ReservesTakerDenom)
+			}
+		}
+
+		for _, tranche := range k.GetAllInactiveLimitOrderTranche(ctx) {
+			add(tranche.Key.TradePairId.MakerDenom, tranche.ReservesMakerDenom)
+			add(tranche.Key.TradePairId.TakerDenom, tranche.ReservesTakerDenom)
+		}
+
+		for _, order := range k.GetAllConditionalOrder(ctx) {
+			add(order.TradePairId.TakerDenom, order.AmountIn)
+		}
+
+		for _, protocolFee := range k.GetAllProtocolFee(ctx) {
+			add(protocolFee.Denom, protocolFee.Pending)
+		}
+
+		actual := make(map[string]math.Int)
+		k.bankKeeper.IterateAccountBalances(ctx, authtypes.NewModuleAddress(types.ModuleName), func(coin sdk.Coin) bool {
+			if !coin.IsZero() {
+				actual[coin.Denom] = coin.Amount
+			}
+			return false
+		})
+
+		denoms := make(map[string]bool)
+		for denom := range expected {
+			denoms[denom] = true
+		}
+		for denom := range actual {
+			denoms[denom] = true
+		}
+
+		var msg string
+		broken := false
+		for _, denom := range sortedKeys(denoms) {
+			expectedAmount, actualAmount := math.ZeroInt(), math.ZeroInt()
+			if amount, ok := expected[denom]; ok {
+				expectedAmount = amount
+			}
+			if amount, ok := actual[denom]; ok {
+				actualAmount = amount
+			}
+
+			if !expectedAmount.Equal(actualAmount) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: module balance %s, expected %s\n", denom, actualAmount, expectedAmount)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("dex module balance does not match dex state\n%s", msg),
+		), broken
+	}
+}
+
+// PoolSharesInvariant checks that the supply of every pool's share denom is backed by its reserves. Shares are minted
+// at no more than the value of the deposited reserves at the pool's center price, and swaps, withdrawals and protocol
+// fees never decrease the value of the remaining shares, so the total supply can never exceed the value of the
+// reserves. A pool without any shares must also be empty.
+func PoolSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, poolMetadata := range k.GetAllPoolMetadata(ctx) {
+			pool, found := k.GetPoolByID(ctx, poolMetadata.Id)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tpool %d: not found\n", poolMetadata.Id)
+				continue
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, pool.GetPoolDenom()).Amount
+			reserve0, reserve1 := pool.GetLowerReserve0(), pool.GetUpperReserve1()
+			value := types.CalcAmountAsToken0(reserve0, reserve1, pool.MustCalcPrice1To0Center())
+
+			switch {
+			case supply.IsZero() && (!reserve0.IsZero() || !reserve1.IsZero()):
+				broken = true
+				msg += fmt.Sprintf("\tpool %d: no shares but reserves %s / %s\n", pool.Id, reserve0, reserve1)
+			case reserve0.IsNegative() || reserve1.IsNegative():
+				broken = true
+				msg += fmt.Sprintf("\tpool %d: negative reserves %s / %s\n", pool.Id, reserve0, reserve1)
+			case math_utils.NewPrecDecFromInt(supply).GT(value):
+				broken = true
+				msg += fmt.Sprintf("\tpool %d: share supply %s exceeds reserve value %s\n", pool.Id, supply, value)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool-shares",
+			fmt.Sprintf("pool share supply is not backed by pool reserves\n%s", msg),
+		), broken
+	}
+}
+
+// TrancheUserClaimsInvariant checks that no LimitOrderTrancheUser can cancel or withdraw more than its tranche holds
+func TrancheUserClaimsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, trancheUser := range k.GetAllLimitOrderTrancheUser(ctx) {
+			tranche, _, found := k.FindLimitOrderTranche(ctx, &types.LimitOrderTrancheKey{
+				TradePairId:           trancheUser.TradePairId,
+				TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+				TrancheKey:            trancheUser.TrancheKey,
+			})
+			// Tranches are only removed once all of their reserves have been withdrawn
+			if !found {
+				continue
+			}
+
+			makerClaim := tranche.CalcRemoveTokenInAmount(trancheUser)
+			if makerClaim.GT(tranche.ReservesMakerDenom) {
+				broken = true
+				msg += fmt.Sprintf("\t%s in tranche %s: claims %s%s, tranche holds %s\n",
+					trancheUser.Address, trancheUser.TrancheKey,
+					makerClaim, trancheUser.TradePairId.MakerDenom, tranche.ReservesMakerDenom)
+			}
+
+			_, takerClaim := tranche.CalcWithdrawAmount(trancheUser)
+			if takerClaim.GT(tranche.ReservesTakerDenom) {
+				broken = true
+				msg += fmt.Sprintf("\t%s in tranche %s: claims %s%s, tranche holds %s\n",
+					trancheUser.Address, trancheUser.TrancheKey,
+					takerClaim, trancheUser.TradePairId.TakerDenom, tranche.ReservesTakerDenom)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "tranche-user-claims",
+			fmt.Sprintf("limit order tranche users claim more than their tranches hold\n%s", msg),
+		), broken
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Core test helpers
+
+func (s *DexTestSuite) assertInvariantsHold() {
+	msg, broken := dexkeeper.AllInvariants(s.App.DexKeeper)(s.Ctx)
+	s.False(broken, msg)
+}
+
+func (s *DexTestSuite) assertInvariantBroken(invariant sdk.Invariant) {
+	_, broken := invariant(s.Ctx)
+	s.True(broken)
+}
+
+// Tests
+
+func (s *DexTestSuite) TestInvariantsHold() {
+	s.fundAliceBalances(100, 100)
+	s.fundBobBalances(100, 100)
+	s.fundCarolBalances(100, 100)
+	s.setProtocolFeeParams(math_utils.MustNewPrecDecFromStr("0.5"), "")
+
+	// GIVEN a partially filled tranche that has been partially withdrawn and cancelled
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.bobLimitSells("TokenA", 0, 10)
+	s.carolLimitSells("TokenB", -1, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.bobCancelsLimitSell(trancheKey)
+
+	// AND a pool with balanced and autoswapped deposits
+	s.aliceDeposits(NewDeposit(10, 10, 0, 5))
+	s.bobDeposits(NewDepositWithOptions(10, 3, 0, 5, types.DepositOptions{DisableAutoswap: false}))
+
+	// AND a swap through the pool that accrues protocol fees
+	s.carolLimitSells("TokenA", 100, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// AND an escrowed conditional order and partial withdrawals
+	s.aliceConditionalSells(types.ConditionalOrderTrigger_STOP_LOSS, -1000, -1000, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.aliceWithdraws(NewWithdrawal(5, 0, 5))
+	s.bobWithdraws(NewWithdrawal(5, 0, 5))
+
+	// THEN all invariants hold
+	s.assertInvariantsHold()
+}
+
+func (s *DexTestSuite) TestAutoswapDepositDoesNotDiluteShares() {
+	s.fundAliceBalances(10, 10)
+	s.fundBobBalances(10, 1)
+
+	// GIVEN a pool away from tick 0
+	s.aliceDeposits(NewDeposit(10, 10, 2000, 10))
+
+	// WHEN bob makes an unbalanced deposit that is autoswapped
+	s.bobDeposits(NewDepositWithOptions(10, 1, 2000, 10, types.DepositOptions{DisableAutoswap: false}))
+
+	// THEN bob's shares are not worth more than the deposit
+	s.assertInvariantsHold()
+}
+
+func (s *DexTestSuite) TestModuleBalanceInvariantBroken() {
+	s.fundAliceBalances(10, 10)
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+	s.assertInvariantsHold()
+
+	// WHEN pool reserves are recorded without a matching module balance
+	pool, found := s.App.DexKeeper.GetPool(s.Ctx, defaultPairID, 0, 1)
+	s.Require().True(found)
+	pool.LowerTick0.ReservesMakerDenom = pool.LowerTick0.ReservesMakerDenom.AddRaw(1)
+	s.App.DexKeeper.SetPool(s.Ctx, pool)
+
+	// THEN the module balance invariant is broken
+	s.assertInvariantBroken(dexkeeper.ModuleBalanceInvariant(s.App.DexKeeper))
+}
+
+func (s *DexTestSuite) TestPoolSharesInvariantBroken() {
+	s.fundAliceBalances(10, 10)
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+
+	// WHEN pool shares are minted without any reserves backing them
+	pool, found := s.App.DexKeeper.GetPool(s.Ctx, defaultPairID, 0, 1)
+	s.Require().True(found)
+	shares := sdk.NewCoins(sdk.NewInt64Coin(pool.GetPoolDenom(), 1))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, shares))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, types.ModuleName, s.alice, shares))
+
+	// THEN the pool shares invariant is broken
+	s.assertInvariantBroken(dexkeeper.PoolSharesInvariant(s.App.DexKeeper))
+}
+
+func (s *DexTestSuite) TestTrancheUserClaimsInvariantBroken() {
+	s.fundAliceBalances(10, 0)
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN a tranche user owns more than was placed in the tranche
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey)
+	s.Require().True(found)
+	trancheUser.SharesOwned = trancheUser.SharesOwned.Add(sdkmath.NewInt(1))
+	s.App.DexKeeper.SetLimitOrderTrancheUser(s.Ctx, trancheUser)
+
+	// THEN the tranche user claims invariant is broken
+	s.assertInvariantBroken(dexkeeper.TrancheUserClaimsInvariant(s.App.DexKeeper))
+}
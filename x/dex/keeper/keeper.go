@@ -7,18 +7,22 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/neutron-org/neutron/v4/x/dex/types"
 )
 
 type (
 	Keeper struct {
-		cdc        codec.BinaryCodec
-		storeKey   storetypes.StoreKey
-		memKey     storetypes.StoreKey
-		tKey       storetypes.StoreKey
-		bankKeeper types.BankKeeper
-		authority  string
+		cdc            codec.BinaryCodec
+		storeKey       storetypes.StoreKey
+		memKey         storetypes.StoreKey
+		tKey           storetypes.StoreKey
+		bankKeeper     types.BankKeeper
+		oracleKeeper   types.OracleKeeper
+		contractKeeper types.ContractKeeper
+		hooks          types.DexHooks
+		authority      string
 	}
 )
 
@@ -28,15 +32,19 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	tKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
+	oracleKeeper types.OracleKeeper,
+	contractKeeper types.ContractKeeper,
 	authority string,
 ) *Keeper {
 	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		tKey:       tKey,
-		bankKeeper: bankKeeper,
-		authority:  authority,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		memKey:         memKey,
+		tKey:           tKey,
+		bankKeeper:     bankKeeper,
+		oracleKeeper:   oracleKeeper,
+		contractKeeper: contractKeeper,
+		authority:      authority,
 	}
 }
 
@@ -44,6 +52,33 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// emitTypedEvent emits a typed dex event. Typed events only fail to emit if they cannot be marshaled to JSON, which
+// would be a programming error, so a failure is logged instead of failing the action that emitted the event.
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit typed event", "event", proto.MessageName(event), "error", err)
+	}
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// Hooks gets the hooks for dex keeper
+func (k Keeper) Hooks() types.DexHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiDexHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the dex hooks. This must be called before the keeper is copied into other modules.
+func (k *Keeper) SetHooks(dh types.DexHooks) {
+	if k.hooks != nil {
+		panic("cannot set dex hooks twice")
+	}
+
+	k.hooks = dh
+}
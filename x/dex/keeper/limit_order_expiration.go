@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -145,12 +146,13 @@ func (k Keeper) PurgeExpiredLimitOrders(ctx sdk.Context, curTime time.Time) {
 			tranche, found := k.GetLimitOrderTrancheByKey(ctx, val.TrancheRef)
 			if found {
 				// Convert the tranche to an inactiveTranche
-				k.SetInactiveLimitOrderTranche(ctx, tranche)
+				k.SaveInactiveTranche(ctx, tranche)
 				k.RemoveLimitOrderTranche(ctx, tranche.Key)
 				archivedTranches[string(val.TrancheRef)] = true
 
 				pairID = *tranche.Key.TradePairId
 				ctx.EventManager().EmitEvent(types.CreateTickUpdateLimitOrderTranchePurge(tranche))
+				k.emitTypedEvent(ctx, types.NewEventTickUpdateLimitOrderTranche(tranche, math.ZeroInt()))
 			}
 		}
 
@@ -55,12 +55,14 @@ func (k Keeper) SaveTranche(ctx sdk.Context, tranche *types.LimitOrderTranche) {
 	if tranche.HasTokenIn() {
 		k.SetLimitOrderTranche(ctx, tranche)
 	} else {
-		k.SetInactiveLimitOrderTranche(ctx, tranche)
+		// Tranches without any tokens left (ie. fully cancelled) are deleted rather than archived
+		k.SaveInactiveTranche(ctx, tranche)
 		k.RemoveLimitOrderTranche(ctx, tranche.Key)
 		ctx.EventManager().EmitEvents(types.GetEventsDecTotalOrders(tranche.Key.TradePairId))
 	}
 
 	ctx.EventManager().EmitEvent(types.CreateTickUpdateLimitOrderTranche(tranche))
+	k.emitTypedEvent(ctx, types.NewEventTickUpdateLimitOrderTranche(tranche, tranche.ReservesMakerDenom))
 }
 
 func (k Keeper) SetLimitOrderTranche(ctx sdk.Context, tranche *types.LimitOrderTranche) {
@@ -134,8 +136,8 @@ func (k Keeper) GetGTCPlaceTranche(
 		var tick types.TickLiquidity
 		k.cdc.MustUnmarshal(iter.Value(), &tick)
 		tranche := tick.GetLimitOrderTranche()
-		// Make sure tranche has not been traded through and is a GTC tranche
-		if tranche.IsPlaceTranche() && !tranche.HasExpiration() {
+		// Make sure tranche has not been traded through and is a GTC tranche that is not moved by an oracle peg
+		if tranche.IsPlaceTranche() && !tranche.HasExpiration() && !k.IsPeggedTranche(sdkCtx, tranche.Key.TrancheKey) {
 			return tranche
 		}
 	}
@@ -35,6 +35,21 @@ func (k Keeper) GetOrInitLimitOrderTrancheUser(
 	return userShareData
 }
 
+// GetRestingLimitOrderTrancheUser returns the LimitOrderTrancheUser of addr in tranche if addr has an unfilled limit
+// order resting in it
+func (k Keeper) GetRestingLimitOrderTrancheUser(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	tranche *types.LimitOrderTranche,
+) (trancheUser *types.LimitOrderTrancheUser, found bool) {
+	trancheUser, found = k.GetLimitOrderTrancheUser(ctx, addr.String(), tranche.Key.TrancheKey)
+	if !found || !tranche.CalcRemoveTokenInAmount(trancheUser).IsPositive() {
+		return nil, false
+	}
+
+	return trancheUser, true
+}
+
 // SetLimitOrderTrancheUser set a specific LimitOrderTrancheUser in the store from its index
 func (k Keeper) SetLimitOrderTrancheUser(ctx sdk.Context, limitOrderTrancheUser *types.LimitOrderTrancheUser) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LimitOrderTrancheUserKeyPrefix))
@@ -91,6 +106,9 @@ func (k Keeper) RemoveLimitOrderTrancheUser(ctx sdk.Context, trancheUser *types.
 func (k Keeper) SaveTrancheUser(ctx sdk.Context, trancheUser *types.LimitOrderTrancheUser) {
 	if trancheUser.IsEmpty() {
 		k.RemoveLimitOrderTrancheUser(ctx, trancheUser)
+		if trancheUser.RecordFills {
+			k.RemoveFillRecordSubscriber(ctx, trancheUser.TrancheKey, trancheUser.Address)
+		}
 	} else {
 		k.SetLimitOrderTrancheUser(ctx, trancheUser)
 	}
@@ -15,7 +15,38 @@ func (k Keeper) Swap(
 	maxAmountMakerDenom *math.Int,
 	limitPrice *math_utils.PrecDec,
 ) (totalTakerCoin, totalMakerCoin sdk.Coin, orderFilled bool, err error) {
+	totalTakerCoin, totalMakerCoin, orderFilled, _, err = k.SwapWithSelfTradePrevention(
+		ctx,
+		tradePairID,
+		maxAmountTakerDenom,
+		maxAmountMakerDenom,
+		limitPrice,
+		nil,
+		types.SelfTradePrevention_ALLOW_SELF_TRADE,
+	)
+
+	return totalTakerCoin, totalMakerCoin, orderFilled, err
+}
+
+// SwapWithSelfTradePrevention swaps like Swap, handling tranches that hold takerAddr's own resting limit orders
+// according to selfTradePrevention. Since a maker keeps sharing in the future fills of a tranche after cancelling,
+// tranches holding takerAddr's limit orders are never filled unless self trades are allowed. selfTradeStopped is
+// true if the swap was stopped at one of takerAddr's limit orders.
+func (k Keeper) SwapWithSelfTradePrevention(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	maxAmountTakerDenom math.Int,
+	maxAmountMakerDenom *math.Int,
+	limitPrice *math_utils.PrecDec,
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
+) (totalTakerCoin, totalMakerCoin sdk.Coin, orderFilled, selfTradeStopped bool, err error) {
 	gasBefore := ctx.GasMeter().GasConsumed()
+	if err := k.AssertPairNotPaused(ctx, tradePairID.MustPairID()); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, false, false, err
+	}
+	bandLimitPrice := k.GetPriceBandLimit(ctx, tradePairID)
+
 	useMaxOut := maxAmountMakerDenom != nil
 	var remainingMakerDenom *math.Int
 	if useMaxOut {
@@ -25,11 +56,14 @@ func (k Keeper) Swap(
 
 	remainingTakerDenom := maxAmountTakerDenom
 	totalMakerDenom := math.ZeroInt()
+	totalProtocolFee := math.ZeroInt()
+	protocolFeeShare := k.GetParams(ctx).ProtocolFeeShare
 	orderFilled = false
 
 	// verify that amount left is not zero and that there are additional valid ticks to check
 	liqIter := k.NewLiquidityIterator(ctx, tradePairID)
 	defer liqIter.Close()
+loop:
 	for {
 		liq := liqIter.Next()
 		if liq == nil {
@@ -41,10 +75,46 @@ func (k Keeper) Swap(
 			break
 		}
 
+		if tranche, ok := liq.(*types.LimitOrderTranche); ok && !selfTradePrevention.AllowsSelfTrade() {
+			if trancheUser, found := k.GetRestingLimitOrderTrancheUser(ctx, takerAddr, tranche); found {
+				switch selfTradePrevention {
+				case types.SelfTradePrevention_CANCEL_NEWEST:
+					selfTradeStopped = true
+					break loop
+				case types.SelfTradePrevention_CANCEL_OLDEST:
+					if _, err := k.cancelLimitOrder(ctx, tranche, trancheUser, takerAddr); err != nil {
+						return sdk.Coin{}, sdk.Coin{}, false, false, err
+					}
+					k.SaveTranche(ctx, tranche)
+				}
+				continue
+			}
+		}
+
+		// revert if the swap would move the price further from the block's opening tick than the pair allows
+		if bandLimitPrice != nil && liq.Price().LT(*bandLimitPrice) {
+			return sdk.Coin{}, sdk.Coin{}, false, false, types.ErrPriceBandExceeded.Wrapf(
+				"pair %s", tradePairID.MustPairID().CanonicalString(),
+			)
+		}
+
 		inAmount, outAmount := liq.Swap(remainingTakerDenom, remainingMakerDenom)
 
+		if poolLiq, ok := liq.(*types.PoolLiquidity); ok {
+			protocolFee := poolLiq.Pool.TakeProtocolFee(tradePairID, inAmount, protocolFeeShare)
+			totalProtocolFee = totalProtocolFee.Add(protocolFee)
+		}
+
 		k.SaveLiquidity(ctx, liq)
 
+		if tranche, ok := liq.(*types.LimitOrderTranche); ok && inAmount.IsPositive() {
+			k.emitTypedEvent(ctx, types.NewEventLimitOrderFilled(tranche, inAmount, outAmount))
+			k.RecordTrancheFill(ctx, tranche, inAmount, outAmount)
+			if err := k.Hooks().AfterTrancheFilled(ctx, tranche, inAmount, outAmount); err != nil {
+				return sdk.Coin{}, sdk.Coin{}, false, false, err
+			}
+		}
+
 		remainingTakerDenom = remainingTakerDenom.Sub(inAmount)
 		totalMakerDenom = totalMakerDenom.Add(outAmount)
 
@@ -74,6 +144,14 @@ func (k Keeper) Swap(
 	}
 	totalTakerDenom := maxAmountTakerDenom.Sub(remainingTakerDenom)
 
+	if totalMakerDenom.IsPositive() {
+		k.UpdateTwapRecord(ctx, tradePairID)
+	}
+
+	if totalProtocolFee.IsPositive() {
+		k.AccrueProtocolFee(ctx, sdk.NewCoin(tradePairID.TakerDenom, totalProtocolFee))
+	}
+
 	gasAfter := ctx.GasMeter().GasConsumed()
 	ctx.EventManager().EmitEvents(types.GetEventsGasConsumed(gasBefore, gasAfter))
 
@@ -83,7 +161,7 @@ func (k Keeper) Swap(
 		), sdk.NewCoin(
 			tradePairID.MakerDenom,
 			totalMakerDenom,
-		), orderFilled, nil
+		), orderFilled, selfTradeStopped, nil
 }
 
 func (k Keeper) SwapWithCache(
@@ -92,19 +170,23 @@ func (k Keeper) SwapWithCache(
 	maxAmountIn math.Int,
 	maxAmountOut *math.Int,
 	limitPrice *math_utils.PrecDec,
-) (totalIn, totalOut sdk.Coin, orderFilled bool, err error) {
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
+) (totalIn, totalOut sdk.Coin, orderFilled, selfTradeStopped bool, err error) {
 	cacheCtx, writeCache := ctx.CacheContext()
-	totalIn, totalOut, orderFilled, err = k.Swap(
+	totalIn, totalOut, orderFilled, selfTradeStopped, err = k.SwapWithSelfTradePrevention(
 		cacheCtx,
 		tradePairID,
 		maxAmountIn,
 		maxAmountOut,
 		limitPrice,
+		takerAddr,
+		selfTradePrevention,
 	)
 
 	writeCache()
 
-	return totalIn, totalOut, orderFilled, err
+	return totalIn, totalOut, orderFilled, selfTradeStopped, err
 }
 
 func (k Keeper) SaveLiquidity(sdkCtx sdk.Context, liquidityI types.Liquidity) {
@@ -128,18 +210,26 @@ func (k Keeper) TakerLimitOrderSwap(
 	maxAmountOut *math.Int,
 	limitPrice math_utils.PrecDec,
 	orderType types.LimitOrderType,
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
 ) (totalInCoin, totalOutCoin sdk.Coin, err error) {
-	totalInCoin, totalOutCoin, orderFilled, err := k.SwapWithCache(
+	totalInCoin, totalOutCoin, orderFilled, selfTradeStopped, err := k.SwapWithCache(
 		ctx,
 		&tradePairID,
 		amountIn,
 		maxAmountOut,
 		&limitPrice,
+		takerAddr,
+		selfTradePrevention,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	if selfTradeStopped && totalInCoin.Amount.IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrSelfTradePrevented
+	}
+
 	if orderType.IsFoK() && !orderFilled {
 		return sdk.Coin{}, sdk.Coin{}, types.ErrFoKLimitOrderNotFilled
 	}
@@ -159,21 +249,30 @@ func (k Keeper) TakerLimitOrderSwap(
 
 // Wrapper for maker LimitOrders
 // Ensures the swap portion + maker portion of the limit order will have an output >= the limit price output
+// If the swap was stopped at one of takerAddr's own limit orders no maker portion may be placed.
 func (k Keeper) MakerLimitOrderSwap(
 	ctx sdk.Context,
 	tradePairID types.TradePairID,
 	amountIn math.Int,
 	limitPrice math_utils.PrecDec,
-) (totalInCoin, totalOutCoin sdk.Coin, filled bool, err error) {
-	totalInCoin, totalOutCoin, filled, err = k.SwapWithCache(
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
+) (totalInCoin, totalOutCoin sdk.Coin, filled, selfTradeStopped bool, err error) {
+	totalInCoin, totalOutCoin, filled, selfTradeStopped, err = k.SwapWithCache(
 		ctx,
 		&tradePairID,
 		amountIn,
 		nil,
 		&limitPrice,
+		takerAddr,
+		selfTradePrevention,
 	)
 	if err != nil {
-		return sdk.Coin{}, sdk.Coin{}, filled, err
+		return sdk.Coin{}, sdk.Coin{}, filled, selfTradeStopped, err
+	}
+
+	if selfTradeStopped && totalInCoin.Amount.IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, false, selfTradeStopped, types.ErrSelfTradePrevented
 	}
 
 	if totalInCoin.Amount.IsPositive() {
@@ -183,9 +282,9 @@ func (k Keeper) MakerLimitOrderSwap(
 		truePrice := totalExpectedOut.QuoInt(amountIn)
 
 		if truePrice.LT(limitPrice) {
-			return sdk.Coin{}, sdk.Coin{}, false, types.ErrLimitPriceNotSatisfied
+			return sdk.Coin{}, sdk.Coin{}, false, false, types.ErrLimitPriceNotSatisfied
 		}
 	}
 
-	return totalInCoin, totalOutCoin, filled, nil
+	return totalInCoin, totalOutCoin, filled, selfTradeStopped, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	v3 "github.com/neutron-org/neutron/v4/x/dex/migrations/v3"
 	v4 "github.com/neutron-org/neutron/v4/x/dex/migrations/v4"
+	v5 "github.com/neutron-org/neutron/v4/x/dex/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -26,3 +27,8 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
 }
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
@@ -58,6 +58,7 @@ func (k MsgServer) Deposit(
 		tickIndexes,
 		msg.Fees,
 		msg.Options,
+		msg.MinSharesOut,
 	)
 	if err != nil {
 		return nil, err
@@ -92,7 +93,7 @@ func (k MsgServer) Withdrawal(
 
 	tickIndexes := NormalizeAllTickIndexes(msg.TokenA, pairID.Token0, msg.TickIndexesAToB)
 
-	err = k.WithdrawCore(
+	reserve0Withdrawn, reserve1Withdrawn, err := k.WithdrawCore(
 		goCtx,
 		pairID,
 		callerAddr,
@@ -100,12 +101,17 @@ func (k MsgServer) Withdrawal(
 		msg.SharesToRemove,
 		tickIndexes,
 		msg.Fees,
+		msg.MinAmount0Out,
+		msg.MinAmount1Out,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgWithdrawalResponse{}, nil
+	return &types.MsgWithdrawalResponse{
+		Reserve0Withdrawn: reserve0Withdrawn,
+		Reserve1Withdrawn: reserve1Withdrawn,
+	}, nil
 }
 
 func (k MsgServer) PlaceLimitOrder(
@@ -136,6 +142,12 @@ func (k MsgServer) PlaceLimitOrder(
 			return &types.MsgPlaceLimitOrderResponse{}, errors.Wrapf(err, "invalid LimitSellPrice %s", msg.LimitSellPrice.String())
 		}
 	}
+	if msg.OraclePeg != nil {
+		tickIndex, err = k.GetOraclePegTickIndex(ctx, msg.OraclePeg)
+		if err != nil {
+			return &types.MsgPlaceLimitOrderResponse{}, err
+		}
+	}
 	trancheKey, coinIn, _, coinOutSwap, err := k.PlaceLimitOrderCore(
 		goCtx,
 		msg.TokenIn,
@@ -145,6 +157,9 @@ func (k MsgServer) PlaceLimitOrder(
 		msg.OrderType,
 		msg.ExpirationTime,
 		msg.MaxAmountOut,
+		msg.OraclePeg,
+		msg.SelfTradePrevention,
+		msg.RecordFills,
 		callerAddr,
 		receiverAddr,
 	)
@@ -199,7 +214,7 @@ func (k MsgServer) CancelLimitOrder(
 
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 
-	err := k.CancelLimitOrderCore(
+	_, err := k.CancelLimitOrderCore(
 		goCtx,
 		msg.TrancheKey,
 		callerAddr,
@@ -226,6 +241,24 @@ func (k MsgServer) MultiHopSwap(
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
 
+	if msg.IsExactAmountOut() {
+		coinIn, coinOut, err := k.MultiHopSwapExactAmountOutCore(
+			goCtx,
+			*msg.AmountOut,
+			msg.AmountIn,
+			msg.Routes,
+			msg.ExitLimitPrice,
+			msg.PickBestRoute,
+			callerAddr,
+			receiverAddr,
+			msg.SelfTradePrevention,
+		)
+		if err != nil {
+			return &types.MsgMultiHopSwapResponse{}, err
+		}
+		return &types.MsgMultiHopSwapResponse{CoinOut: coinOut, CoinIn: coinIn}, nil
+	}
+
 	coinOut, err := k.MultiHopSwapCore(
 		goCtx,
 		msg.AmountIn,
@@ -234,11 +267,280 @@ func (k MsgServer) MultiHopSwap(
 		msg.PickBestRoute,
 		callerAddr,
 		receiverAddr,
+		msg.SelfTradePrevention,
 	)
 	if err != nil {
 		return &types.MsgMultiHopSwapResponse{}, err
 	}
-	return &types.MsgMultiHopSwapResponse{CoinOut: coinOut}, nil
+	coinIn := sdk.NewCoin(msg.Routes[0].Hops[0], msg.AmountIn)
+	return &types.MsgMultiHopSwapResponse{CoinOut: coinOut, CoinIn: coinIn}, nil
+}
+
+func (k MsgServer) SwapExactIn(
+	goCtx context.Context,
+	msg *types.MsgSwapExactIn,
+) (*types.MsgSwapExactInResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSwapExactIn")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	routes := k.FindRoutes(ctx, msg.TokenIn, msg.TokenOut, msg.MaxHops)
+	if len(routes) == 0 {
+		return &types.MsgSwapExactInResponse{}, types.ErrNoRouteFound
+	}
+
+	coinOut, err := k.MultiHopSwapCore(
+		goCtx,
+		msg.AmountIn,
+		routes,
+		msg.ExitLimitPrice,
+		true,
+		callerAddr,
+		receiverAddr,
+		msg.SelfTradePrevention,
+	)
+	if err != nil {
+		return &types.MsgSwapExactInResponse{}, err
+	}
+	return &types.MsgSwapExactInResponse{CoinOut: coinOut}, nil
+}
+
+func (k MsgServer) BatchCancelLimitOrders(
+	goCtx context.Context,
+	msg *types.MsgBatchCancelLimitOrders,
+) (*types.MsgBatchCancelLimitOrdersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgBatchCancelLimitOrders")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	failedLimitOrders := k.BatchCancelLimitOrdersCore(goCtx, msg.TrancheKeys, callerAddr)
+
+	return &types.MsgBatchCancelLimitOrdersResponse{FailedLimitOrders: failedLimitOrders}, nil
+}
+
+func (k MsgServer) BatchWithdrawFilledLimitOrders(
+	goCtx context.Context,
+	msg *types.MsgBatchWithdrawFilledLimitOrders,
+) (*types.MsgBatchWithdrawFilledLimitOrdersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgBatchWithdrawFilledLimitOrders")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	failedLimitOrders := k.BatchWithdrawFilledLimitOrdersCore(goCtx, msg.TrancheKeys, callerAddr)
+
+	return &types.MsgBatchWithdrawFilledLimitOrdersResponse{FailedLimitOrders: failedLimitOrders}, nil
+}
+
+func (k MsgServer) CancelAllLimitOrders(
+	goCtx context.Context,
+	msg *types.MsgCancelAllLimitOrders,
+) (*types.MsgCancelAllLimitOrdersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgCancelAllLimitOrders")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	pairID, err := types.NewPairIDFromUnsorted(msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelledTrancheKeys, failedLimitOrders := k.CancelAllLimitOrdersCore(goCtx, pairID, callerAddr)
+
+	return &types.MsgCancelAllLimitOrdersResponse{
+		CancelledTrancheKeys: cancelledTrancheKeys,
+		FailedLimitOrders:    failedLimitOrders,
+	}, nil
+}
+
+func (k MsgServer) DepositRange(
+	goCtx context.Context,
+	msg *types.MsgDepositRange,
+) (*types.MsgDepositRangeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgDepositRange")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	pairID, err := types.NewPairIDFromUnsorted(msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	tickIndexesAToB, err := types.RangeTickIndexesAToB(msg.LowerPrice, msg.UpperPrice, msg.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	// sort amounts
+	amount0, amount1 := msg.AmountA, msg.AmountB
+	if msg.TokenA != pairID.Token0 {
+		amount0, amount1 = amount1, amount0
+	}
+
+	tickIndexes := NormalizeAllTickIndexes(msg.TokenA, pairID.Token0, tickIndexesAToB)
+
+	depositTickIndexes, amounts0Deposit, amounts1Deposit, failedDeposits, err := k.DepositRangeCore(
+		goCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		amount0,
+		amount1,
+		tickIndexes,
+		msg.Fee,
+		msg.Shape,
+		msg.Options,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositRangeResponse{
+		TickIndexesAToB:   NormalizeAllTickIndexes(msg.TokenA, pairID.Token0, depositTickIndexes),
+		Reserve0Deposited: amounts0Deposit,
+		Reserve1Deposited: amounts1Deposit,
+		FailedDeposits:    failedDeposits,
+	}, nil
+}
+
+func (k MsgServer) WithdrawRange(
+	goCtx context.Context,
+	msg *types.MsgWithdrawRange,
+) (*types.MsgWithdrawRangeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgWithdrawRange")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	pairID, err := types.NewPairIDFromUnsorted(msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	tickIndexesAToB, err := types.RangeTickIndexesAToB(msg.LowerPrice, msg.UpperPrice, msg.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	tickIndexes := NormalizeAllTickIndexes(msg.TokenA, pairID.Token0, tickIndexesAToB)
+
+	err = k.WithdrawRangeCore(
+		goCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		tickIndexes,
+		msg.Fee,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRangeResponse{}, nil
+}
+
+func (k MsgServer) PlaceConditionalOrder(
+	goCtx context.Context,
+	msg *types.MsgPlaceConditionalOrder,
+) (*types.MsgPlaceConditionalOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPlaceConditionalOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	limitTickIndex, err := types.CalcTickIndexFromPrice(msg.LimitSellPrice)
+	if err != nil {
+		return &types.MsgPlaceConditionalOrderResponse{}, errors.Wrapf(err, "invalid LimitSellPrice %s", msg.LimitSellPrice.String())
+	}
+
+	triggerTickIndex, err := types.CalcTickIndexFromPrice(msg.TriggerPrice)
+	if err != nil {
+		return &types.MsgPlaceConditionalOrderResponse{}, errors.Wrapf(err, "invalid TriggerPrice %s", msg.TriggerPrice.String())
+	}
+
+	id, err := k.PlaceConditionalOrderCore(
+		goCtx,
+		msg.TokenIn,
+		msg.TokenOut,
+		msg.AmountIn,
+		msg.OrderType,
+		msg.MaxAmountOut,
+		limitTickIndex,
+		msg.Trigger,
+		triggerTickIndex,
+		callerAddr,
+		receiverAddr,
+	)
+	if err != nil {
+		return &types.MsgPlaceConditionalOrderResponse{}, err
+	}
+
+	return &types.MsgPlaceConditionalOrderResponse{Id: id}, nil
+}
+
+func (k MsgServer) CancelConditionalOrder(
+	goCtx context.Context,
+	msg *types.MsgCancelConditionalOrder,
+) (*types.MsgCancelConditionalOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgCancelConditionalOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	err := k.CancelConditionalOrderCore(goCtx, msg.Id, callerAddr)
+	if err != nil {
+		return &types.MsgCancelConditionalOrderResponse{}, err
+	}
+
+	return &types.MsgCancelConditionalOrderResponse{}, nil
 }
 
 func (k MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -268,3 +570,130 @@ func (k MsgServer) AssertNotPaused(goCtx context.Context) error {
 	}
 	return nil
 }
+
+func (k MsgServer) SetPairCircuitBreaker(
+	goCtx context.Context,
+	msg *types.MsgSetPairCircuitBreaker,
+) (*types.MsgSetPairCircuitBreakerResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetPairCircuitBreaker")
+	}
+
+	pairID, err := types.NewPairIDFromUnsorted(msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err = k.SetPairCircuitBreakerCore(ctx, msg.Authority, pairID, msg.Paused, msg.MaxTickDeviation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPairCircuitBreakerResponse{}, nil
+}
+
+func (k MsgServer) WithdrawPosition(
+	goCtx context.Context,
+	msg *types.MsgWithdrawPosition,
+) (*types.MsgWithdrawPositionResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgWithdrawPosition")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	pairID, err := types.NewPairIDFromUnsorted(msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	reserve0Withdrawn, reserve1Withdrawn, err := k.WithdrawPositionCore(
+		goCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		msg.Percentage,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawPositionResponse{
+		Reserve0Withdrawn: reserve0Withdrawn,
+		Reserve1Withdrawn: reserve1Withdrawn,
+	}, nil
+}
+
+func (k MsgServer) FlashSwap(
+	goCtx context.Context,
+	msg *types.MsgFlashSwap,
+) (*types.MsgFlashSwapResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgFlashSwap")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	contractAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	coinIn, coinOut, err := k.FlashSwapCore(
+		goCtx,
+		msg.TokenIn,
+		msg.TokenOut,
+		msg.AmountIn,
+		msg.MaxAmountOut,
+		msg.LimitSellPrice,
+		msg.CallbackMsg,
+		contractAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFlashSwapResponse{
+		CoinIn:  coinIn,
+		CoinOut: coinOut,
+	}, nil
+}
+
+func (k MsgServer) ModifyLimitOrder(
+	goCtx context.Context,
+	msg *types.MsgModifyLimitOrder,
+) (*types.MsgModifyLimitOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgModifyLimitOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	if err := msg.ValidateGoodTilExpiration(ctx.BlockTime()); err != nil {
+		return nil, err
+	}
+
+	coinOut, err := k.ModifyLimitOrderCore(
+		goCtx,
+		msg.TrancheKey,
+		msg.ReduceAmountIn,
+		msg.ExpirationTime,
+		callerAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgModifyLimitOrderResponse{CoinOut: coinOut}, nil
+}
@@ -2,6 +2,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -882,6 +883,77 @@ func (s *DexTestSuite) multiHopSwaps(
 	s.Assert().Nil(err)
 }
 
+func (s *DexTestSuite) aliceMultiHopSwapsExactAmountOut(
+	routes [][]string,
+	amountOut int,
+	maxAmountIn int,
+	exitLimitPrice math_utils.PrecDec,
+	pickBest bool,
+) (coinIn sdk.Coin) {
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		routes,
+		sdkmath.NewInt(int64(maxAmountIn)).Mul(denomMultiple),
+		exitLimitPrice,
+		pickBest,
+	)
+	amountOutInt := sdkmath.NewInt(int64(amountOut)).Mul(denomMultiple)
+	msg.AmountOut = &amountOutInt
+	res, err := s.msgServer.MultiHopSwap(s.Ctx, msg)
+	s.Require().Nil(err)
+	s.Assert().Equal(amountOutInt, res.CoinOut.Amount)
+	return res.CoinIn
+}
+
+func (s *DexTestSuite) aliceMultiHopSwapExactAmountOutFails(
+	expectedErr error,
+	routes [][]string,
+	amountOut int,
+	maxAmountIn int,
+	exitLimitPrice math_utils.PrecDec,
+	pickBest bool,
+) {
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		routes,
+		sdkmath.NewInt(int64(maxAmountIn)).Mul(denomMultiple),
+		exitLimitPrice,
+		pickBest,
+	)
+	amountOutInt := sdkmath.NewInt(int64(amountOut)).Mul(denomMultiple)
+	msg.AmountOut = &amountOutInt
+	_, err := s.msgServer.MultiHopSwap(s.Ctx, msg)
+	s.Assert().ErrorIs(err, expectedErr)
+}
+
+func (s *DexTestSuite) aliceEstimatesMultiHopSwapExactAmountOut(
+	routes [][]string,
+	amountOut int,
+	maxAmountIn int,
+	exitLimitPrice math_utils.PrecDec,
+	pickBest bool,
+) (coinIn, coinOut sdk.Coin) {
+	multiHopRoutes := make([]*types.MultiHopRoute, len(routes))
+	for i, hops := range routes {
+		multiHopRoutes[i] = &types.MultiHopRoute{Hops: hops}
+	}
+	amountOutInt := sdkmath.NewInt(int64(amountOut)).Mul(denomMultiple)
+	msg := &types.QueryEstimateMultiHopSwapRequest{
+		Creator:        s.alice.String(),
+		Receiver:       s.alice.String(),
+		Routes:         multiHopRoutes,
+		AmountIn:       sdkmath.NewInt(int64(maxAmountIn)).Mul(denomMultiple),
+		ExitLimitPrice: exitLimitPrice,
+		PickBestRoute:  pickBest,
+		AmountOut:      &amountOutInt,
+	}
+	res, err := s.App.DexKeeper.EstimateMultiHopSwap(s.Ctx, msg)
+	s.Require().Nil(err)
+	return res.CoinIn, res.CoinOut
+}
+
 func (s *DexTestSuite) aliceEstimatesMultiHopSwap(
 	routes [][]string,
 	amountIn int,
@@ -1491,7 +1563,7 @@ func (s *DexTestSuite) calcAutoswapSharesMinted(
 
 	centerPrice := types.MustCalcPrice(-1 * centerTick)
 	leftPrice := types.MustCalcPrice(-1 * (centerTick - int64(fee)))
-	discountPrice := types.MustCalcPrice(-1 * int64(fee))
+	discountPrice := types.MustCalcPrice(int64(fee))
 
 	balancedValue := math_utils.NewPrecDecFromInt(balanced0Int).
 		Add(centerPrice.MulInt(balanced1Int)).
@@ -1810,6 +1882,38 @@ func TestMsgDepositValidate(t *testing.T) {
 			},
 			types.ErrInvalidFee,
 		},
+		{
+			"min shares out not balanced",
+			types.MsgDeposit{
+				Creator:         sample.AccAddress(),
+				Receiver:        sample.AccAddress(),
+				TokenA:          "TokenA",
+				TokenB:          "TokenB",
+				Fees:            []uint64{0},
+				TickIndexesAToB: []int64{0},
+				AmountsA:        []sdkmath.Int{sdkmath.OneInt()},
+				AmountsB:        []sdkmath.Int{sdkmath.OneInt()},
+				Options:         []*types.DepositOptions{{DisableAutoswap: false}},
+				MinSharesOut:    []sdkmath.Int{sdkmath.OneInt(), sdkmath.OneInt()},
+			},
+			types.ErrUnbalancedTxArray,
+		},
+		{
+			"negative min shares out",
+			types.MsgDeposit{
+				Creator:         sample.AccAddress(),
+				Receiver:        sample.AccAddress(),
+				TokenA:          "TokenA",
+				TokenB:          "TokenB",
+				Fees:            []uint64{0},
+				TickIndexesAToB: []int64{0},
+				AmountsA:        []sdkmath.Int{sdkmath.OneInt()},
+				AmountsB:        []sdkmath.Int{sdkmath.OneInt()},
+				Options:         []*types.DepositOptions{{DisableAutoswap: false}},
+				MinSharesOut:    []sdkmath.Int{sdkmath.NewInt(-1)},
+			},
+			types.ErrNegativeMinOut,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1825,6 +1929,7 @@ func TestMsgDepositValidate(t *testing.T) {
 func TestMsgWithdrawalValidate(t *testing.T) {
 	k, ctx := testkeeper.DexKeeper(t)
 	msgServer := dexkeeper.NewMsgServerImpl(*k)
+	negativeInt := sdkmath.NewInt(-1)
 
 	tests := []struct {
 		name        string
@@ -1987,6 +2092,20 @@ func TestMsgWithdrawalValidate(t *testing.T) {
 			},
 			types.ErrInvalidFee,
 		},
+		{
+			"negative min amount out",
+			types.MsgWithdrawal{
+				Creator:         sample.AccAddress(),
+				Receiver:        sample.AccAddress(),
+				TokenA:          "TokenA",
+				TokenB:          "TokenB",
+				Fees:            []uint64{0},
+				TickIndexesAToB: []int64{0},
+				SharesToRemove:  []sdkmath.Int{sdkmath.OneInt()},
+				MinAmount1Out:   &negativeInt,
+			},
+			types.ErrNegativeMinOut,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2176,6 +2295,68 @@ func TestMsgPlaceLimitOrderValidate(t *testing.T) {
 			},
 			types.ErrInvalidPriceAndTick,
 		},
+		{
+			"invalid oracle peg currency pair",
+			types.MsgPlaceLimitOrder{
+				Creator:   sample.AccAddress(),
+				Receiver:  sample.AccAddress(),
+				TokenIn:   "TokenA",
+				TokenOut:  "TokenB",
+				AmountIn:  sdkmath.OneInt(),
+				OraclePeg: &types.OraclePeg{CurrencyPair: "ATOM"},
+			},
+			types.ErrInvalidOraclePeg,
+		},
+		{
+			"invalid oracle peg offset",
+			types.MsgPlaceLimitOrder{
+				Creator:   sample.AccAddress(),
+				Receiver:  sample.AccAddress(),
+				TokenIn:   "TokenA",
+				TokenOut:  "TokenB",
+				AmountIn:  sdkmath.OneInt(),
+				OraclePeg: &types.OraclePeg{CurrencyPair: "ATOM/USD", OffsetBps: 559_681},
+			},
+			types.ErrInvalidOraclePeg,
+		},
+		{
+			"invalid oracle peg & LimitSellPrice",
+			types.MsgPlaceLimitOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				LimitSellPrice: &FIVEDEC,
+				OraclePeg:      &types.OraclePeg{CurrencyPair: "ATOM/USD"},
+			},
+			types.ErrInvalidOraclePeg,
+		},
+		{
+			"invalid oracle peg order type",
+			types.MsgPlaceLimitOrder{
+				Creator:   sample.AccAddress(),
+				Receiver:  sample.AccAddress(),
+				TokenIn:   "TokenA",
+				TokenOut:  "TokenB",
+				AmountIn:  sdkmath.OneInt(),
+				OrderType: types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				OraclePeg: &types.OraclePeg{CurrencyPair: "ATOM/USD"},
+			},
+			types.ErrInvalidOraclePeg,
+		},
+		{
+			"invalid self trade prevention",
+			types.MsgPlaceLimitOrder{
+				Creator:             sample.AccAddress(),
+				Receiver:            sample.AccAddress(),
+				TokenIn:             "TokenA",
+				TokenOut:            "TokenB",
+				AmountIn:            sdkmath.OneInt(),
+				SelfTradePrevention: types.SelfTradePrevention(99),
+			},
+			types.ErrInvalidSelfTradePrevention,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2250,6 +2431,7 @@ func TestMsgMultiHopSwapValidate(t *testing.T) {
 	k, ctx := testkeeper.DexKeeper(t)
 	msgServer := dexkeeper.NewMsgServerImpl(*k)
 
+	ZEROINT := sdkmath.ZeroInt()
 	tests := []struct {
 		name        string
 		msg         types.MsgMultiHopSwap
@@ -2377,6 +2559,18 @@ func TestMsgMultiHopSwapValidate(t *testing.T) {
 			},
 			types.ErrZeroExitPrice,
 		},
+		{
+			"zero amount out",
+			types.MsgMultiHopSwap{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				Routes:         []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB", "TokenC"}}},
+				AmountIn:       sdkmath.OneInt(),
+				ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.5"),
+				AmountOut:      &ZEROINT,
+			},
+			types.ErrZeroSwapAmountOut,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2423,3 +2617,828 @@ func TestMsgUpdateParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPlaceConditionalOrderValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	ZEROINT := sdkmath.ZeroInt()
+	ONEDEC := math_utils.OnePrecDec()
+	TINYDEC := math_utils.MustNewPrecDecFromStr("0.000000000000000000000000494")
+	tests := []struct {
+		name        string
+		msg         types.MsgPlaceConditionalOrder
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgPlaceConditionalOrder{
+				Creator:        "invalid_address",
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				LimitSellPrice: ONEDEC,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"invalid TokenIn",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "er",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				LimitSellPrice: ONEDEC,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"denoms match",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenA",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				LimitSellPrice: ONEDEC,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"zero amountIn",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.ZeroInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				LimitSellPrice: ONEDEC,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrZeroLimitOrder,
+		},
+		{
+			"maker order type",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_GOOD_TIL_CANCELLED,
+				LimitSellPrice: ONEDEC,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrInvalidConditionalOrderType,
+		},
+		{
+			"invalid trigger",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				LimitSellPrice: ONEDEC,
+				Trigger:        5,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrInvalidConditionalOrderTrigger,
+		},
+		{
+			"zero maxOut",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				MaxAmountOut:   &ZEROINT,
+				LimitSellPrice: ONEDEC,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrZeroMaxAmountOut,
+		},
+		{
+			"limit price < minPrice",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				LimitSellPrice: TINYDEC,
+				TriggerPrice:   ONEDEC,
+			},
+			types.ErrPriceOutsideRange,
+		},
+		{
+			"trigger price < minPrice",
+			types.MsgPlaceConditionalOrder{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				OrderType:      types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+				LimitSellPrice: ONEDEC,
+				TriggerPrice:   TINYDEC,
+			},
+			types.ErrPriceOutsideRange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.PlaceConditionalOrder(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgSwapExactInValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgSwapExactIn
+		expectedErr error
+	}{
+		{
+			"invalid creator address",
+			types.MsgSwapExactIn{
+				Creator:        "invalid_address",
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"invalid receiver address",
+			types.MsgSwapExactIn{
+				Creator:        sample.AccAddress(),
+				Receiver:       "invalid_address",
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"invalid token in",
+			types.MsgSwapExactIn{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "1",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"token in equals token out",
+			types.MsgSwapExactIn{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenA",
+				AmountIn:       sdkmath.OneInt(),
+				ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"max hops too large",
+			types.MsgSwapExactIn{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				MaxHops:        types.MaxRouteHops + 1,
+			},
+			types.ErrInvalidMaxHops,
+		},
+		{
+			"zero amount in",
+			types.MsgSwapExactIn{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.ZeroInt(),
+				ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			},
+			types.ErrZeroSwap,
+		},
+		{
+			"zero exit limit price",
+			types.MsgSwapExactIn{
+				Creator:        sample.AccAddress(),
+				Receiver:       sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				ExitLimitPrice: math_utils.ZeroPrecDec(),
+			},
+			types.ErrZeroExitPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.SwapExactIn(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgBatchCancelLimitOrdersValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tooManyKeys := make([]string, types.MaxLimitOrderBatchSize+1)
+	for i := range tooManyKeys {
+		tooManyKeys[i] = fmt.Sprintf("TRANCHEKEY%d", i)
+	}
+
+	tests := []struct {
+		name        string
+		msg         types.MsgBatchCancelLimitOrders
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgBatchCancelLimitOrders{
+				Creator:     "invalid_address",
+				TrancheKeys: []string{"ORDER123"},
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"no tranche keys",
+			types.MsgBatchCancelLimitOrders{
+				Creator:     sample.AccAddress(),
+				TrancheKeys: []string{},
+			},
+			types.ErrInvalidBatchSize,
+		},
+		{
+			"too many tranche keys",
+			types.MsgBatchCancelLimitOrders{
+				Creator:     sample.AccAddress(),
+				TrancheKeys: tooManyKeys,
+			},
+			types.ErrInvalidBatchSize,
+		},
+		{
+			"duplicate tranche keys",
+			types.MsgBatchCancelLimitOrders{
+				Creator:     sample.AccAddress(),
+				TrancheKeys: []string{"ORDER123", "ORDER456", "ORDER123"},
+			},
+			types.ErrDuplicateTrancheKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.BatchCancelLimitOrders(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgBatchWithdrawFilledLimitOrdersValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgBatchWithdrawFilledLimitOrders
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgBatchWithdrawFilledLimitOrders{
+				Creator:     "invalid_address",
+				TrancheKeys: []string{"ORDER123"},
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"no tranche keys",
+			types.MsgBatchWithdrawFilledLimitOrders{
+				Creator: sample.AccAddress(),
+			},
+			types.ErrInvalidBatchSize,
+		},
+		{
+			"duplicate tranche keys",
+			types.MsgBatchWithdrawFilledLimitOrders{
+				Creator:     sample.AccAddress(),
+				TrancheKeys: []string{"ORDER123", "ORDER123"},
+			},
+			types.ErrDuplicateTrancheKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.BatchWithdrawFilledLimitOrders(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgCancelAllLimitOrdersValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgCancelAllLimitOrders
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgCancelAllLimitOrders{
+				Creator: "invalid_address",
+				TokenA:  "TokenA",
+				TokenB:  "TokenB",
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"invalid denom",
+			types.MsgCancelAllLimitOrders{
+				Creator: sample.AccAddress(),
+				TokenA:  "1",
+				TokenB:  "TokenB",
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"tokenA equals tokenB",
+			types.MsgCancelAllLimitOrders{
+				Creator: sample.AccAddress(),
+				TokenA:  "TokenA",
+				TokenB:  "TokenA",
+			},
+			types.ErrInvalidDenom,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.CancelAllLimitOrders(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgDepositRangeValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgDepositRange
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgDepositRange{
+				Creator:    "invalid_address",
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				AmountA:    sdkmath.OneInt(),
+				AmountB:    sdkmath.ZeroInt(),
+				LowerPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr("1.1"),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"zero deposit",
+			types.MsgDepositRange{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				AmountA:    sdkmath.ZeroInt(),
+				AmountB:    sdkmath.ZeroInt(),
+				LowerPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr("1.1"),
+			},
+			types.ErrZeroDeposit,
+		},
+		{
+			"invalid shape",
+			types.MsgDepositRange{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				AmountA:    sdkmath.OneInt(),
+				AmountB:    sdkmath.ZeroInt(),
+				LowerPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr("1.1"),
+				Shape:      types.LiquidityShape(10),
+			},
+			types.ErrInvalidLiquidityShape,
+		},
+		{
+			"lower price above upper price",
+			types.MsgDepositRange{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				AmountA:    sdkmath.OneInt(),
+				AmountB:    sdkmath.ZeroInt(),
+				LowerPrice: math_utils.MustNewPrecDecFromStr("1.1"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			},
+			types.ErrInvalidPriceRange,
+		},
+		{
+			"price out of range",
+			types.MsgDepositRange{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				AmountA:    sdkmath.OneInt(),
+				AmountB:    sdkmath.ZeroInt(),
+				LowerPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr(types.MaxPrice).Add(math_utils.OnePrecDec()),
+			},
+			types.ErrPriceOutsideRange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.DepositRange(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgWithdrawRangeValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgWithdrawRange
+		expectedErr error
+	}{
+		{
+			"invalid receiver",
+			types.MsgWithdrawRange{
+				Creator:    sample.AccAddress(),
+				Receiver:   "invalid_address",
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				LowerPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr("1.1"),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"tokens are the same",
+			types.MsgWithdrawRange{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenA",
+				LowerPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr("1.1"),
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"lower price above upper price",
+			types.MsgWithdrawRange{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				LowerPrice: math_utils.MustNewPrecDecFromStr("1.1"),
+				UpperPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			},
+			types.ErrInvalidPriceRange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.WithdrawRange(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgSetPairCircuitBreakerValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgSetPairCircuitBreaker
+		expectedErr error
+	}{
+		{
+			"invalid authority",
+			types.MsgSetPairCircuitBreaker{
+				Authority: "invalid_address",
+				TokenA:    "TokenA",
+				TokenB:    "TokenB",
+				Paused:    true,
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"invalid denom",
+			types.MsgSetPairCircuitBreaker{
+				Authority: sample.AccAddress(),
+				TokenA:    "1",
+				TokenB:    "TokenB",
+				Paused:    true,
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"tokens are the same",
+			types.MsgSetPairCircuitBreaker{
+				Authority: sample.AccAddress(),
+				TokenA:    "TokenA",
+				TokenB:    "TokenA",
+				Paused:    true,
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"max tick deviation too large",
+			types.MsgSetPairCircuitBreaker{
+				Authority:        sample.AccAddress(),
+				TokenA:           "TokenA",
+				TokenB:           "TokenB",
+				MaxTickDeviation: 2*types.MaxTickExp + 1,
+			},
+			types.ErrTickOutsideRange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.SetPairCircuitBreaker(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgWithdrawPositionValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgWithdrawPosition
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgWithdrawPosition{
+				Creator:    "invalid_address",
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				Percentage: math_utils.NewPrecDec(100),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"tokens are the same",
+			types.MsgWithdrawPosition{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenA",
+				Percentage: math_utils.NewPrecDec(100),
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"zero percentage",
+			types.MsgWithdrawPosition{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				Percentage: math_utils.ZeroPrecDec(),
+			},
+			types.ErrInvalidWithdrawPercentage,
+		},
+		{
+			"percentage above 100",
+			types.MsgWithdrawPosition{
+				Creator:    sample.AccAddress(),
+				Receiver:   sample.AccAddress(),
+				TokenA:     "TokenA",
+				TokenB:     "TokenB",
+				Percentage: math_utils.MustNewPrecDecFromStr("100.1"),
+			},
+			types.ErrInvalidWithdrawPercentage,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.WithdrawPosition(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgFlashSwapValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+	zeroInt := sdkmath.ZeroInt()
+	TINYDEC := math_utils.MustNewPrecDecFromStr("0.000000000000000000000000494")
+
+	tests := []struct {
+		name        string
+		msg         types.MsgFlashSwap
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgFlashSwap{
+				Creator:  "invalid_address",
+				TokenIn:  "TokenA",
+				TokenOut: "TokenB",
+				AmountIn: sdkmath.OneInt(),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"invalid token in",
+			types.MsgFlashSwap{
+				Creator:  sample.AccAddress(),
+				TokenIn:  "1",
+				TokenOut: "TokenB",
+				AmountIn: sdkmath.OneInt(),
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"token in equals token out",
+			types.MsgFlashSwap{
+				Creator:  sample.AccAddress(),
+				TokenIn:  "TokenA",
+				TokenOut: "TokenA",
+				AmountIn: sdkmath.OneInt(),
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"zero amount in",
+			types.MsgFlashSwap{
+				Creator:  sample.AccAddress(),
+				TokenIn:  "TokenA",
+				TokenOut: "TokenB",
+				AmountIn: sdkmath.ZeroInt(),
+			},
+			types.ErrZeroSwap,
+		},
+		{
+			"zero max amount out",
+			types.MsgFlashSwap{
+				Creator:      sample.AccAddress(),
+				TokenIn:      "TokenA",
+				TokenOut:     "TokenB",
+				AmountIn:     sdkmath.OneInt(),
+				MaxAmountOut: &zeroInt,
+			},
+			types.ErrZeroSwapAmountOut,
+		},
+		{
+			"limit sell price out of range",
+			types.MsgFlashSwap{
+				Creator:        sample.AccAddress(),
+				TokenIn:        "TokenA",
+				TokenOut:       "TokenB",
+				AmountIn:       sdkmath.OneInt(),
+				LimitSellPrice: &TINYDEC,
+			},
+			types.ErrPriceOutsideRange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.FlashSwap(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgModifyLimitOrderValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+	zeroInt := sdkmath.ZeroInt()
+	negativeInt := sdkmath.NewInt(-1)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgModifyLimitOrder
+		expectedErr error
+	}{
+		{
+			"invalid creator",
+			types.MsgModifyLimitOrder{
+				Creator:    "invalid_address",
+				TrancheKey: "ORDER123",
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"no modification",
+			types.MsgModifyLimitOrder{
+				Creator:    sample.AccAddress(),
+				TrancheKey: "ORDER123",
+			},
+			types.ErrInvalidLimitOrderModification,
+		},
+		{
+			"zero reduce amount",
+			types.MsgModifyLimitOrder{
+				Creator:        sample.AccAddress(),
+				TrancheKey:     "ORDER123",
+				ReduceAmountIn: &zeroInt,
+			},
+			types.ErrInvalidLimitOrderModification,
+		},
+		{
+			"negative reduce amount",
+			types.MsgModifyLimitOrder{
+				Creator:        sample.AccAddress(),
+				TrancheKey:     "ORDER123",
+				ReduceAmountIn: &negativeInt,
+			},
+			types.ErrInvalidLimitOrderModification,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.ModifyLimitOrder(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -12,6 +13,10 @@ import (
 	"github.com/neutron-org/neutron/v4/x/dex/types"
 )
 
+// maxSwapAmountIn is used as the taker bound when swapping for an exact amount out. It is large enough that it is
+// never the binding constraint, while staying well within the range of PrecDec arithmetic.
+var maxSwapAmountIn = math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 128))
+
 type MultihopStep struct {
 	RemainingBestPrice math_utils.PrecDec
 	tradePairID        *types.TradePairID
@@ -72,6 +77,8 @@ func (k Keeper) MultihopStep(
 	step MultihopStep,
 	inCoin sdk.Coin,
 	stepCache map[multihopCacheKey]StepResult,
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
 ) (sdk.Coin, sdk.Coin, *types.BranchableCache, error) {
 	cacheKey := newCacheKey(step.tradePairID.TakerDenom, step.tradePairID.MakerDenom, inCoin.Amount)
 	val, ok := stepCache[cacheKey]
@@ -85,7 +92,13 @@ func (k Keeper) MultihopStep(
 	// To solve this without sending user dust we would have to pre-calculate the route such that
 	// the amount in will be used completely at each step.
 
-	dust, coinOut, err := k.SwapFullAmountIn(bCtx.Ctx, step.tradePairID, inCoin.Amount)
+	dust, coinOut, err := k.SwapFullAmountIn(
+		bCtx.Ctx,
+		step.tradePairID,
+		inCoin.Amount,
+		takerAddr,
+		selfTradePrevention,
+	)
 	ctxBranch := bCtx.Branch()
 	stepCache[cacheKey] = StepResult{Ctx: bCtx, CoinOut: coinOut, Dust: dust, Err: err}
 	if err != nil {
@@ -101,6 +114,8 @@ func (k Keeper) RunMultihopRoute(
 	initialInCoin sdk.Coin,
 	exitLimitPrice math_utils.PrecDec,
 	stepCache map[multihopCacheKey]StepResult,
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
 ) (sdk.Coins, sdk.Coin, func(), error) {
 	routeData, err := k.HopsToRouteData(ctx, route.Hops)
 	if err != nil {
@@ -127,6 +142,8 @@ func (k Keeper) RunMultihopRoute(
 			step,
 			inCoin,
 			stepCache,
+			takerAddr,
+			selfTradePrevention,
 		)
 		inCoin = stepOutCoin
 		if err != nil {
@@ -151,25 +168,95 @@ func (k Keeper) RunMultihopRoute(
 	return dustAcc, stepOutCoin, bCacheCtx.WriteCache, nil
 }
 
+// RunMultihopRouteExactAmountOut works backwards through the route, swapping at each hop for exactly the amount of
+// tokens required by the following hop. It returns the amount of the entry token required to deliver `exitCoin`.
+// NOTE: Since routes cannot contain cycles no pair is swapped through twice, so the order in which hops are executed
+// does not affect the outcome.
+func (k Keeper) RunMultihopRouteExactAmountOut(
+	ctx sdk.Context,
+	route types.MultiHopRoute,
+	exitCoin sdk.Coin,
+	maxAmountIn math.Int,
+	exitLimitPrice math_utils.PrecDec,
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
+) (sdk.Coin, func(), error) {
+	routeData, err := k.HopsToRouteData(ctx, route.Hops)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	// If we can't hit the best possible price we can greedily abort
+	if exitLimitPrice.GT(routeData[0].RemainingBestPrice) {
+		return sdk.Coin{}, nil, types.ErrLimitPriceNotSatisfied
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	stepOutAmount := exitCoin.Amount
+	var stepInCoin sdk.Coin
+	for i := len(routeData) - 1; i >= 0; i-- {
+		step := routeData[i]
+		stepInCoin, err = k.SwapExactAmountOut(
+			cacheCtx,
+			step.tradePairID,
+			stepOutAmount,
+			takerAddr,
+			selfTradePrevention,
+		)
+		if err != nil {
+			return sdk.Coin{}, nil, sdkerrors.Wrapf(
+				err,
+				"Failed at pair: %s",
+				step.tradePairID.MustPairID().CanonicalString(),
+			)
+		}
+		stepOutAmount = stepInCoin.Amount
+	}
+
+	if stepInCoin.Amount.GT(maxAmountIn) {
+		return sdk.Coin{}, nil, sdkerrors.Wrapf(
+			types.ErrMultihopMaxAmountInExceeded,
+			"required %s, max %s",
+			stepInCoin.Amount,
+			maxAmountIn,
+		)
+	}
+
+	price := math_utils.NewPrecDecFromInt(exitCoin.Amount).Quo(math_utils.NewPrecDecFromInt(stepInCoin.Amount))
+	if exitLimitPrice.GT(price) {
+		return sdk.Coin{}, nil, types.ErrLimitPriceNotSatisfied
+	}
+
+	return stepInCoin, writeCache, nil
+}
+
 // SwapFullAmountIn swaps full amount of given `amountIn` to the `tradePairID` taker denom.
 // NOTE: SwapFullAmountIn does not ensure that 100% of amountIn is used. Due to rounding it is possible that
 // a dust amount of AmountIn remains unswapped. It is the caller's responsibility to handle this appropriately.
-// It returns remaining dust as a first argument.
+// It returns remaining dust as a first argument. Tranches holding `takerAddr`'s own limit orders are handled according
+// to `selfTradePrevention`.
 func (k Keeper) SwapFullAmountIn(
 	ctx sdk.Context,
 	tradePairID *types.TradePairID,
 	amountIn math.Int,
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
 ) (dust, totalOut sdk.Coin, err error) {
-	swapAmountTakerDenom, swapAmountMakerDenom, orderFilled, err := k.Swap(
+	swapAmountTakerDenom, swapAmountMakerDenom, orderFilled, selfTradeStopped, err := k.SwapWithSelfTradePrevention(
 		ctx,
 		tradePairID,
 		amountIn,
 		nil,
 		nil,
+		takerAddr,
+		selfTradePrevention,
 	)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
+	if selfTradeStopped {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrSelfTradePrevented
+	}
 	if !orderFilled {
 		return sdk.Coin{}, sdk.Coin{}, types.ErrLimitPriceNotSatisfied
 	}
@@ -181,3 +268,35 @@ func (k Keeper) SwapFullAmountIn(
 
 	return dust, swapAmountMakerDenom, err
 }
+
+// SwapExactAmountOut swaps for exactly `amountOut` of the `tradePairID` maker denom using as little of the taker denom
+// as possible. It returns the amount of the taker denom that was used. Tranches holding `takerAddr`'s own limit orders
+// are handled according to `selfTradePrevention`.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	amountOut math.Int,
+	takerAddr sdk.AccAddress,
+	selfTradePrevention types.SelfTradePrevention,
+) (totalIn sdk.Coin, err error) {
+	swapAmountTakerDenom, swapAmountMakerDenom, _, selfTradeStopped, err := k.SwapWithSelfTradePrevention(
+		ctx,
+		tradePairID,
+		maxSwapAmountIn,
+		&amountOut,
+		nil,
+		takerAddr,
+		selfTradePrevention,
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if selfTradeStopped {
+		return sdk.Coin{}, types.ErrSelfTradePrevented
+	}
+	if swapAmountMakerDenom.Amount.LT(amountOut) {
+		return sdk.Coin{}, types.ErrLimitPriceNotSatisfied
+	}
+
+	return swapAmountTakerDenom, nil
+}
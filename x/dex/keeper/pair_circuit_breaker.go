@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// SetPairCircuitBreaker set a specific pairCircuitBreaker in the store
+func (k Keeper) SetPairCircuitBreaker(ctx sdk.Context, breaker types.PairCircuitBreaker) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&breaker)
+	store.Set(types.PairCircuitBreakerKey(breaker.PairId), b)
+}
+
+// GetPairCircuitBreaker returns the pairCircuitBreaker for a pair
+func (k Keeper) GetPairCircuitBreaker(ctx sdk.Context, pairID *types.PairID) (val types.PairCircuitBreaker, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PairCircuitBreakerKey(pairID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePairCircuitBreaker removes the pairCircuitBreaker of a pair from the store
+func (k Keeper) RemovePairCircuitBreaker(ctx sdk.Context, pairID *types.PairID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PairCircuitBreakerKey(pairID))
+}
+
+// GetAllPairCircuitBreaker returns all pairCircuitBreakers
+func (k Keeper) GetAllPairCircuitBreaker(ctx sdk.Context) (list []types.PairCircuitBreaker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PairCircuitBreakerKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairCircuitBreaker
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) IsPairPaused(ctx sdk.Context, pairID *types.PairID) bool {
+	breaker, found := k.GetPairCircuitBreaker(ctx, pairID)
+	return found && breaker.Paused
+}
+
+func (k Keeper) AssertPairNotPaused(ctx sdk.Context, pairID *types.PairID) error {
+	if k.IsPairPaused(ctx, pairID) {
+		return types.ErrPairPaused.Wrapf("pair %s", pairID.CanonicalString())
+	}
+	return nil
+}
+
+// SetPairCircuitBreakerCore handles MsgSetPairCircuitBreaker. A breaker that neither pauses the pair nor sets a
+// price band is removed.
+func (k Keeper) SetPairCircuitBreakerCore(
+	ctx sdk.Context,
+	authority string,
+	pairID *types.PairID,
+	paused bool,
+	maxTickDeviation uint64,
+) error {
+	circuitBreakerAddress := k.GetParams(ctx).CircuitBreakerAddress
+	if authority != k.GetAuthority() && (circuitBreakerAddress == "" || authority != circuitBreakerAddress) {
+		return types.ErrUnauthorizedCircuitBreaker.Wrapf("got %s", authority)
+	}
+
+	breaker := types.PairCircuitBreaker{
+		PairId:           pairID,
+		Paused:           paused,
+		MaxTickDeviation: maxTickDeviation,
+	}
+	if !paused && maxTickDeviation == 0 {
+		k.RemovePairCircuitBreaker(ctx, pairID)
+	} else {
+		k.SetPairCircuitBreaker(ctx, breaker)
+	}
+
+	// Make the price band effective from the current price rather than from the next block
+	if maxTickDeviation != 0 {
+		k.recordOpeningTicks(ctx, pairID)
+	}
+
+	ctx.EventManager().EmitEvent(types.SetPairCircuitBreakerEvent(authority, breaker))
+
+	return nil
+}
+
+// RecordOpeningTicks stores the current tick of every pair with a price band in the transient store so that swaps
+// in this block can be checked against it. It must run at the start of the block before any swaps.
+func (k Keeper) RecordOpeningTicks(ctx sdk.Context) {
+	for _, breaker := range k.GetAllPairCircuitBreaker(ctx) {
+		if breaker.MaxTickDeviation != 0 {
+			k.recordOpeningTicks(ctx, breaker.PairId)
+		}
+	}
+}
+
+func (k Keeper) recordOpeningTicks(ctx sdk.Context, pairID *types.PairID) {
+	for _, tradePairID := range []*types.TradePairID{
+		types.NewTradePairIDFromMaker(pairID, pairID.Token0),
+		types.NewTradePairIDFromMaker(pairID, pairID.Token1),
+	} {
+		store := ctx.TransientStore(k.tKey)
+		tick, found := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID)
+		if !found {
+			store.Delete(types.OpeningTickKey(tradePairID))
+			continue
+		}
+
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, uint64(tick))
+		store.Set(types.OpeningTickKey(tradePairID), bz)
+	}
+}
+
+// GetOpeningTick returns the takerToMaker tick of tradePairID recorded at the start of the block
+func (k Keeper) GetOpeningTick(ctx sdk.Context, tradePairID *types.TradePairID) (int64, bool) {
+	bz := ctx.TransientStore(k.tKey).Get(types.OpeningTickKey(tradePairID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// GetPriceBandLimit returns the lowest price that a swap through tradePairID may reach in this block, or nil if
+// the pair has no price band or had no liquidity at the start of the block.
+func (k Keeper) GetPriceBandLimit(ctx sdk.Context, tradePairID *types.TradePairID) *math_utils.PrecDec {
+	breaker, found := k.GetPairCircuitBreaker(ctx, tradePairID.MustPairID())
+	if !found || breaker.MaxTickDeviation == 0 {
+		return nil
+	}
+
+	openingTick, found := k.GetOpeningTick(ctx, tradePairID)
+	if !found {
+		return nil
+	}
+
+	// Prices fall as the takerToMaker tick rises; if the band extends past the last valid tick it never binds
+	limitPrice, err := types.CalcPrice(openingTick + int64(breaker.MaxTickDeviation))
+	if err != nil {
+		return nil
+	}
+
+	return &limitPrice
+}
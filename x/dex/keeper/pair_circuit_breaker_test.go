@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func createNPairCircuitBreaker(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PairCircuitBreaker {
+	items := make([]types.PairCircuitBreaker, n)
+	for i := range items {
+		items[i].PairId = &types.PairID{Token0: "TokenA", Token1: "token" + strconv.Itoa(i)}
+		items[i].Paused = i%2 == 0
+		items[i].MaxTickDeviation = uint64(i) * 10
+		keeper.SetPairCircuitBreaker(ctx, items[i])
+	}
+
+	return items
+}
+
+func TestPairCircuitBreakerGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPairCircuitBreaker(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetPairCircuitBreaker(ctx, item.PairId)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(item),
+			nullify.Fill(got),
+		)
+	}
+}
+
+func TestPairCircuitBreakerRemove(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPairCircuitBreaker(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePairCircuitBreaker(ctx, item.PairId)
+		_, found := keeper.GetPairCircuitBreaker(ctx, item.PairId)
+		require.False(t, found)
+	}
+}
+
+func TestPairCircuitBreakerGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNPairCircuitBreaker(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPairCircuitBreaker(ctx)),
+	)
+}
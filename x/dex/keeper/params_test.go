@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/neutron-org/neutron/v4/testutil/common/sample"
 	testkeeper "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
 	math_utils "github.com/neutron-org/neutron/v4/utils/math"
 	"github.com/neutron-org/neutron/v4/x/dex/types"
@@ -24,6 +25,7 @@ func TestSetParams(t *testing.T) {
 		FeeTiers:              []uint64{0, 1},
 		MaxJitsPerBlock:       0,
 		GoodTilPurgeAllowance: 0,
+		ProtocolFeeShare:      math_utils.ZeroPrecDec(),
 	}
 	err := k.SetParams(ctx, newParams)
 	require.NoError(t, err)
@@ -71,3 +73,28 @@ func (s *DexTestSuite) TestPauseDex() {
 	s.aliceCancelsLimitSell(trancheKey)
 	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 5, math_utils.MustNewPrecDecFromStr("0.01"), false)
 }
+
+func TestValidateProtocolFeeParams(t *testing.T) {
+	params := types.DefaultParams()
+	params.ProtocolFeeShare = math_utils.MustNewPrecDecFromStr("0.25")
+	require.NoError(t, params.Validate())
+
+	params.ProtocolFeeShare = math_utils.OnePrecDec()
+	require.Error(t, params.Validate())
+
+	params.ProtocolFeeShare = math_utils.NewPrecDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ProtocolFeeCollector = "invalid"
+	require.Error(t, params.Validate())
+}
+
+func TestValidateCircuitBreakerAddressParam(t *testing.T) {
+	params := types.DefaultParams()
+	params.CircuitBreakerAddress = sample.AccAddress()
+	require.NoError(t, params.Validate())
+
+	params.CircuitBreakerAddress = "invalid"
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// SetPeggedLimitOrder set a specific peggedLimitOrder in the store
+func (k Keeper) SetPeggedLimitOrder(ctx sdk.Context, order types.PeggedLimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&order)
+	store.Set(types.PeggedLimitOrderKey(order.TrancheKey.TrancheKey), b)
+}
+
+// GetPeggedLimitOrder returns the peggedLimitOrder held in the tranche with trancheKey
+func (k Keeper) GetPeggedLimitOrder(ctx sdk.Context, trancheKey string) (val types.PeggedLimitOrder, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PeggedLimitOrderKey(trancheKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePeggedLimitOrder removes the peggedLimitOrder held in the tranche with trancheKey from the store
+func (k Keeper) RemovePeggedLimitOrder(ctx sdk.Context, trancheKey string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PeggedLimitOrderKey(trancheKey))
+}
+
+// GetAllPeggedLimitOrder returns all peggedLimitOrders
+func (k Keeper) GetAllPeggedLimitOrder(ctx sdk.Context) (list []types.PeggedLimitOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PeggedLimitOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PeggedLimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) IsPeggedTranche(ctx sdk.Context, trancheKey string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.PeggedLimitOrderKey(trancheKey))
+}
+
+// InitPeggedPlaceTranche creates a new tranche for a pegged limit order. Pegged orders are re-priced independently of
+// all other orders so they are never placed in an existing tranche.
+func (k Keeper) InitPeggedPlaceTranche(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	tickIndexTakerToMaker int64,
+) (*types.LimitOrderTranche, error) {
+	limitOrderTrancheKey := &types.LimitOrderTrancheKey{
+		TradePairId:           tradePairID,
+		TickIndexTakerToMaker: tickIndexTakerToMaker,
+		TrancheKey:            NewTrancheKey(ctx),
+	}
+	placeTranche, err := NewLimitOrderTranche(limitOrderTrancheKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(types.GetEventsIncTotalOrders(tradePairID))
+
+	return placeTranche, nil
+}
+
+// GetOraclePrice returns the latest oracle price of currencyPair adjusted for its decimals
+func (k Keeper) GetOraclePrice(ctx sdk.Context, currencyPair string) (math_utils.PrecDec, error) {
+	if k.oracleKeeper == nil {
+		return math_utils.ZeroPrecDec(), types.ErrOraclePriceNotFound.Wrap("oracle keeper is not set")
+	}
+
+	cp, err := slinkytypes.CurrencyPairFromString(currencyPair)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), types.ErrInvalidOraclePeg.Wrapf("invalid currency pair (%s)", err)
+	}
+
+	quotePrice, err := k.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), types.ErrOraclePriceNotFound.Wrapf("%s: %s", currencyPair, err)
+	}
+
+	decimals, err := k.oracleKeeper.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), types.ErrOraclePriceNotFound.Wrapf("%s: %s", currencyPair, err)
+	}
+
+	if !quotePrice.Price.IsPositive() {
+		return math_utils.ZeroPrecDec(), types.ErrOraclePriceNotFound.Wrapf("%s: price is not positive", currencyPair)
+	}
+
+	return math_utils.NewPrecDecFromInt(quotePrice.Price).
+		QuoInt(math.NewIntWithDecimal(1, int(decimals))), nil
+}
+
+// GetOraclePegTickIndex returns the TickIndexInToOut that a limit order pegged with oraclePeg is placed at
+func (k Keeper) GetOraclePegTickIndex(ctx sdk.Context, oraclePeg *types.OraclePeg) (int64, error) {
+	price, err := k.GetOraclePrice(ctx, oraclePeg.CurrencyPair)
+	if err != nil {
+		return 0, err
+	}
+
+	return oraclePeg.TickIndexInToOut(price)
+}
+
+type peggedLimitOrderRepeg struct {
+	order            types.PeggedLimitOrder
+	tickIndexInToOut int64
+}
+
+// getPeggedLimitOrdersToRepeg scans pegged limit orders for up to maxRepegs orders whose oracle price has moved away
+// from their tick, together with the pegged orders whose tranche is no longer active. The scan starts after the order
+// that the previous scan stopped at and stops once gasCutoff is reached, so orders that do not need to move cannot
+// make every block do unbounded work. Once the last order has been scanned the next scan starts from the first one.
+func (k Keeper) getPeggedLimitOrdersToRepeg(
+	ctx sdk.Context,
+	maxRepegs uint64,
+	gasCutoff uint64,
+) (repegs []peggedLimitOrderRepeg, inactive []types.PeggedLimitOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PeggedLimitOrderKeyPrefix))
+
+	var start []byte
+	if cursor := k.getPeggedLimitOrderRepegCursor(ctx); cursor != nil {
+		start = append(cursor, 0x00)
+	}
+	iterator := store.Iterator(start, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.PeggedLimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+
+		repeg, isInactive := k.checkPeggedLimitOrder(ctx, order)
+		switch {
+		case isInactive:
+			inactive = append(inactive, order)
+		case repeg != nil:
+			repegs = append(repegs, *repeg)
+		}
+
+		// At least one order is scanned per block so that the scan always makes progress
+		if uint64(len(repegs)) >= maxRepegs || ctx.GasMeter().GasConsumed() >= gasCutoff {
+			k.setPeggedLimitOrderRepegCursor(ctx, iterator.Key())
+			return repegs, inactive
+		}
+	}
+
+	k.removePeggedLimitOrderRepegCursor(ctx)
+
+	return repegs, inactive
+}
+
+// checkPeggedLimitOrder returns the re-pricing of order if its oracle price has moved away from its tick, and whether
+// its tranche is no longer active
+func (k Keeper) checkPeggedLimitOrder(
+	ctx sdk.Context,
+	order types.PeggedLimitOrder,
+) (repeg *peggedLimitOrderRepeg, isInactive bool) {
+	// Orders that have been filled no longer need to be tracked
+	if k.GetLimitOrderTranche(ctx, order.TrancheKey) == nil {
+		return nil, true
+	}
+
+	if k.IsPairPaused(ctx, order.TrancheKey.TradePairId.MustPairID()) {
+		return nil, false
+	}
+
+	// Orders without a valid oracle price stay at their current tick until the price becomes available
+	tickIndexInToOut, err := k.GetOraclePegTickIndex(ctx, order.OraclePeg)
+	if err != nil {
+		return nil, false
+	}
+
+	if tickIndexInToOut*-1 == order.TrancheKey.TickIndexTakerToMaker {
+		return nil, false
+	}
+
+	return &peggedLimitOrderRepeg{order: order, tickIndexInToOut: tickIndexInToOut}, false
+}
+
+func (k Keeper) getPeggedLimitOrderRepegCursor(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.PeggedLimitOrderRepegCursorKey))
+}
+
+func (k Keeper) setPeggedLimitOrderRepegCursor(ctx sdk.Context, cursor []byte) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.PeggedLimitOrderRepegCursorKey), bytes.Clone(cursor))
+}
+
+func (k Keeper) removePeggedLimitOrderRepegCursor(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPrefix(types.PeggedLimitOrderRepegCursorKey))
+}
+
+// repegLimitOrder moves the unfilled portion of a pegged limit order to tickIndexInToOut. The order is cancelled
+// and placed again on behalf of its owner exactly as if the owner had done so themselves; any filled portion stays
+// withdrawable from the original tranche.
+func (k Keeper) repegLimitOrder(
+	ctx sdk.Context,
+	order types.PeggedLimitOrder,
+	tickIndexInToOut int64,
+) (trancheKey string, err error) {
+	ownerAddr := sdk.MustAccAddressFromBech32(order.Address)
+	tradePairID := order.TrancheKey.TradePairId
+
+	// The moved order keeps recording its fills if the original order did
+	trancheUser, found := k.GetLimitOrderTrancheUser(ctx, order.Address, order.TrancheKey.TrancheKey)
+	recordFills := found && trancheUser.RecordFills
+
+	amountIn, err := k.CancelLimitOrderCore(ctx, order.TrancheKey.TrancheKey, ownerAddr)
+	if err != nil {
+		return "", err
+	}
+
+	trancheKey, _, _, _, err = k.PlaceLimitOrderCore(
+		ctx,
+		tradePairID.MakerDenom,
+		tradePairID.TakerDenom,
+		amountIn,
+		tickIndexInToOut,
+		types.LimitOrderType_GOOD_TIL_CANCELLED,
+		nil,
+		nil,
+		order.OraclePeg,
+		types.SelfTradePrevention_ALLOW_SELF_TRADE,
+		recordFills,
+		ownerAddr,
+		ownerAddr,
+	)
+
+	return trancheKey, err
+}
+
+// RepegLimitOrders moves pegged limit orders whose oracle price has moved to the tick of the new price. At most
+// MaxOracleRepegsPerBlock orders are moved and at most OracleRepegAllowance gas is spent looking for them per block.
+// Orders that do not fit in either budget are looked at first in the following block.
+// If moving an order fails its peg is removed and it is left in place as a regular limit order, so that orders that
+// cannot be moved do not use up the budget of every block.
+func (k Keeper) RepegLimitOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Paused || params.MaxOracleRepegsPerBlock == 0 {
+		return
+	}
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + params.OracleRepegAllowance
+	repegs, inactive := k.getPeggedLimitOrdersToRepeg(ctx, params.MaxOracleRepegsPerBlock, gasCutoff)
+
+	for _, order := range inactive {
+		k.RemovePeggedLimitOrder(ctx, order.TrancheKey.TrancheKey)
+	}
+
+	for _, repeg := range repegs {
+		cacheCtx, writeCache := ctx.CacheContext()
+		trancheKey, err := k.repegLimitOrder(cacheCtx, repeg.order, repeg.tickIndexInToOut)
+		if err != nil {
+			k.RemovePeggedLimitOrder(ctx, repeg.order.TrancheKey.TrancheKey)
+		} else {
+			writeCache()
+		}
+
+		ctx.EventManager().EmitEvent(types.RepegLimitOrderEvent(repeg.order, trancheKey, repeg.tickIndexInToOut, err))
+	}
+}
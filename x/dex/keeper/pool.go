@@ -33,6 +33,8 @@ func (k Keeper) InitPool(
 	poolID := k.initializePoolMetadata(ctx, pairID, centerTickIndexNormalized, fee)
 
 	k.StorePoolIDRef(ctx, poolID, pairID, centerTickIndexNormalized, fee)
+	k.SetPoolByDenom(ctx, poolID, pairID)
+	k.IncPairRefCount(ctx, pairID)
 
 	return types.NewPool(pairID, centerTickIndexNormalized, fee, poolID)
 }
@@ -143,6 +145,8 @@ func (k Keeper) SetPool(ctx sdk.Context, pool *types.Pool) {
 	// This should be solved upstream by better tracking of dirty ticks
 	ctx.EventManager().EmitEvent(types.CreateTickUpdatePoolReserves(*pool.LowerTick0))
 	ctx.EventManager().EmitEvent(types.CreateTickUpdatePoolReserves(*pool.UpperTick1))
+	k.emitTypedEvent(ctx, types.NewEventTickUpdatePoolReserves(*pool.LowerTick0))
+	k.emitTypedEvent(ctx, types.NewEventTickUpdatePoolReserves(*pool.UpperTick1))
 }
 
 func (k Keeper) updatePoolReserves(ctx sdk.Context, reserves *types.PoolReserves) {
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// SetProtocolFee set a specific protocolFee in the store
+func (k Keeper) SetProtocolFee(ctx sdk.Context, protocolFee types.ProtocolFee) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&protocolFee)
+	store.Set(types.ProtocolFeeKey(protocolFee.Denom), b)
+}
+
+// GetProtocolFee returns the protocolFee for a denom
+func (k Keeper) GetProtocolFee(ctx sdk.Context, denom string) (val types.ProtocolFee, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ProtocolFeeKey(denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProtocolFee returns all protocolFees
+func (k Keeper) GetAllProtocolFee(ctx sdk.Context) (list []types.ProtocolFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProtocolFee
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AccrueProtocolFee records a protocol fee taken from pool reserves. The fee remains in the dex module
+// until it is sent to the protocol fee collector by DistributeProtocolFees.
+func (k Keeper) AccrueProtocolFee(ctx sdk.Context, fee sdk.Coin) {
+	protocolFee, found := k.GetProtocolFee(ctx, fee.Denom)
+	if !found {
+		protocolFee = types.ProtocolFee{
+			Denom:   fee.Denom,
+			Accrued: math.ZeroInt(),
+			Pending: math.ZeroInt(),
+		}
+	}
+
+	protocolFee.Accrued = protocolFee.Accrued.Add(fee.Amount)
+	protocolFee.Pending = protocolFee.Pending.Add(fee.Amount)
+	k.SetProtocolFee(ctx, protocolFee)
+}
+
+// DistributeProtocolFees sends all pending protocol fees to the protocol fee collector. If no collector
+// is set, or sending fails, the fees remain pending in the dex module.
+func (k Keeper) DistributeProtocolFees(ctx sdk.Context) {
+	collector := k.GetParams(ctx).ProtocolFeeCollector
+	if collector == "" {
+		return
+	}
+	collectorAddr, err := sdk.AccAddressFromBech32(collector)
+	if err != nil {
+		k.Logger(ctx).Error("invalid protocol fee collector", "error", err)
+		return
+	}
+
+	protocolFees := k.GetAllProtocolFee(ctx)
+	pendingCoins := sdk.NewCoins()
+	for _, protocolFee := range protocolFees {
+		pendingCoins = pendingCoins.Add(sdk.NewCoin(protocolFee.Denom, protocolFee.Pending))
+	}
+	if pendingCoins.IsZero() {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, collectorAddr, pendingCoins)
+	if err != nil {
+		k.Logger(ctx).Error("failed to send protocol fees to collector", "error", err)
+		return
+	}
+	writeCache()
+
+	for _, protocolFee := range protocolFees {
+		if protocolFee.Pending.IsPositive() {
+			protocolFee.Pending = math.ZeroInt()
+			k.SetProtocolFee(ctx, protocolFee)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/keeper"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func createNProtocolFee(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ProtocolFee {
+	items := make([]types.ProtocolFee, n)
+	for i := range items {
+		items[i].Denom = "token" + strconv.Itoa(i)
+		items[i].Accrued = math.NewInt(int64(i) + 10)
+		items[i].Pending = math.NewInt(int64(i))
+		keeper.SetProtocolFee(ctx, items[i])
+	}
+
+	return items
+}
+
+func TestProtocolFeeGet(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNProtocolFee(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetProtocolFee(ctx, item.Denom)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(item),
+			nullify.Fill(got),
+		)
+	}
+}
+
+func TestProtocolFeeGetAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := createNProtocolFee(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllProtocolFee(ctx)),
+	)
+}
+
+func TestAccrueProtocolFee(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+
+	keeper.AccrueProtocolFee(ctx, sdk.NewCoin("TokenA", math.NewInt(10)))
+	keeper.AccrueProtocolFee(ctx, sdk.NewCoin("TokenA", math.NewInt(5)))
+
+	got, found := keeper.GetProtocolFee(ctx, "TokenA")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(15), got.Accrued)
+	require.Equal(t, math.NewInt(15), got.Pending)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// GetRecommendedFee returns the fee tier recommended for pairID and the realized volatility of its price in ticks
+// over the fee recommendation window. The volatility of the pair is the greater of the volatilities of its two
+// TradePairIDs. No fee is recommended if the window is 0, there are no fee tiers or neither TradePairID has
+// TwapRecords going back to the start of the window.
+func (k Keeper) GetRecommendedFee(
+	ctx sdk.Context,
+	pairID *types.PairID,
+) (fee uint64, volatility math_utils.PrecDec, found bool) {
+	params := k.GetParams(ctx)
+	if params.FeeRecommendationWindow == 0 || len(params.FeeTiers) == 0 {
+		return 0, math_utils.ZeroPrecDec(), false
+	}
+
+	blockTime := ctx.BlockTime()
+	windowStart := blockTime.Add(-time.Duration(params.FeeRecommendationWindow) * time.Second)
+
+	volatility = math_utils.ZeroPrecDec()
+	for _, makerDenom := range []string{pairID.Token0, pairID.Token1} {
+		tradePairID := pairID.MustTradePairIDFromMaker(makerDenom)
+		startRecord, startFound := k.GetTwapRecordAtOrBefore(ctx, tradePairID, windowStart)
+		if !startFound {
+			continue
+		}
+		endRecord, _ := k.GetTwapRecordAtOrBefore(ctx, tradePairID, blockTime)
+
+		tradePairVolatility, err := types.ComputeRealizedVolatility(startRecord, endRecord)
+		if err != nil {
+			continue
+		}
+
+		found = true
+		volatility = math_utils.MaxPrecDec(volatility, tradePairVolatility)
+	}
+
+	if !found {
+		return 0, math_utils.ZeroPrecDec(), false
+	}
+
+	return types.RecommendFeeTier(params.FeeTiers, volatility), volatility, true
+}
+
+// depositFee returns the fee of the pool a deposit with fee and option is made into. Autoswap deposits that opt to
+// use the recommended fee are made into the pool with the pair's recommended fee if there is one.
+func (k Keeper) depositFee(ctx sdk.Context, pairID *types.PairID, fee uint64, option *types.DepositOptions) uint64 {
+	if option == nil || option.DisableAutoswap || !option.UseRecommendedFee {
+		return fee
+	}
+
+	if recommendedFee, _, found := k.GetRecommendedFee(ctx, pairID); found {
+		return recommendedFee
+	}
+
+	return fee
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// GetSwappableMakerDenoms returns, sorted, the denoms that takerDenom can currently be swapped for in a single hop.
+// Candidate pairs are read from the PairsByDenom index and only kept if the taker to maker direction has liquidity.
+func (k Keeper) GetSwappableMakerDenoms(ctx sdk.Context, takerDenom string) (makerDenoms []string) {
+	pairStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairByDenomPrefix(takerDenom))
+	iterator := storetypes.KVStorePrefixIterator(pairStore, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pairID := &types.PairID{}
+		k.cdc.MustUnmarshal(iterator.Value(), pairID)
+
+		// Denoms may contain "/" so the prefix of a denom can also match keys of longer denoms
+		makerDenom, ok := pairID.OppositeToken(takerDenom)
+		if !ok {
+			continue
+		}
+
+		if k.GetCurrLiq(ctx, pairID.MustTradePairIDFromMaker(makerDenom)) != nil {
+			makerDenoms = append(makerDenoms, makerDenom)
+		}
+	}
+	sort.Strings(makerDenoms)
+
+	return makerDenoms
+}
+
+// FindRoutes returns the routes from tokenIn to tokenOut with at most maxHops swaps that only
+// use TradePairIDs with liquidity. Routes are ordered by length, then lexicographically by
+// denom, and never visit the same denom twice. At most types.MaxRouteCandidates routes are returned
+// and at most types.MaxRouteExpansions paths are expanded, each costing types.RouteExpansionGas.
+func (k Keeper) FindRoutes(
+	ctx sdk.Context,
+	tokenIn string,
+	tokenOut string,
+	maxHops uint64,
+) (routes []*types.MultiHopRoute) {
+	if maxHops == 0 || maxHops > types.MaxRouteHops {
+		maxHops = types.MaxRouteHops
+	}
+
+	edges := make(map[string][]string)
+	expansions := 0
+	paths := [][]string{{tokenIn}}
+	for hop := uint64(0); hop < maxHops && len(paths) > 0; hop++ {
+		var nextPaths [][]string
+		for _, path := range paths {
+			last := path[len(path)-1]
+			makerDenoms, ok := edges[last]
+			if !ok {
+				makerDenoms = k.GetSwappableMakerDenoms(ctx, last)
+				edges[last] = makerDenoms
+			}
+
+			for _, denom := range makerDenoms {
+				if pathContains(path, denom) {
+					continue
+				}
+
+				if expansions == types.MaxRouteExpansions {
+					return routes
+				}
+				expansions++
+				ctx.GasMeter().ConsumeGas(types.RouteExpansionGas, "Route Discovery Fee")
+
+				nextPath := make([]string, len(path), len(path)+1)
+				copy(nextPath, path)
+				nextPath = append(nextPath, denom)
+
+				if denom == tokenOut {
+					routes = append(routes, &types.MultiHopRoute{Hops: nextPath})
+					if len(routes) == types.MaxRouteCandidates {
+						return routes
+					}
+				} else if hop+1 < maxHops {
+					nextPaths = append(nextPaths, nextPath)
+				}
+			}
+		}
+		paths = nextPaths
+	}
+
+	return routes
+}
+
+func pathContains(path []string, denom string) bool {
+	for _, d := range path {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// SetTwapRecord set a specific twapRecord in the store
+func (k Keeper) SetTwapRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&record)
+	store.Set(types.TwapRecordKey(record.TradePairId, record.Time), b)
+}
+
+// GetAllTwapRecord returns all twapRecords
+func (k Keeper) GetAllTwapRecord(ctx sdk.Context) (list []types.TwapRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TwapRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTwapRecordAtOrBefore returns the latest twapRecord for a TradePairID with time <= t
+func (k Keeper) GetTwapRecordAtOrBefore(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	t time.Time,
+) (val types.TwapRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.TwapRecordPrefix(tradePairID)
+	end := storetypes.PrefixEndBytes(types.TwapRecordKey(tradePairID, t))
+	iterator := store.ReverseIterator(start, end)
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+	return val, true
+}
+
+// UpdateTwapRecord writes a new twapRecord for the TradePairID at the current block time, accumulating the
+// previous spot price over the time elapsed since the last record. Multiple updates in the same block overwrite
+// each other so only the final price of the block is recorded. If the TradePairID no longer has any liquidity the
+// previous spot price is carried forward.
+func (k Keeper) UpdateTwapRecord(ctx sdk.Context, tradePairID *types.TradePairID) {
+	blockTime := ctx.BlockTime()
+	lastRecord, lastFound := k.GetTwapRecordAtOrBefore(ctx, tradePairID, blockTime)
+
+	// A record written earlier in this block is replaced, so the new record is advanced from the one before it.
+	// This keeps price moves within a block out of the squared tick change accumulator.
+	prevRecord, prevFound := lastRecord, lastFound
+	if lastFound && lastRecord.Time.Equal(blockTime) {
+		prevRecord, prevFound = k.GetTwapRecordAtOrBefore(ctx, tradePairID, blockTime.Add(-time.Nanosecond))
+	}
+
+	var price math_utils.PrecDec
+	var tickIndex int64
+	liq := k.GetCurrLiq(ctx, tradePairID)
+	switch {
+	case liq == nil && !lastFound:
+		return
+	case liq == nil:
+		price, tickIndex = lastRecord.Price, lastRecord.TickIndexTakerToMaker
+	default:
+		price, tickIndex = liq.Price(), liq.TickIndex()
+	}
+
+	var record types.TwapRecord
+	if prevFound {
+		record = prevRecord.Advance(blockTime, ctx.BlockHeight(), price, tickIndex)
+	} else {
+		record = types.NewTwapRecord(tradePairID, blockTime, ctx.BlockHeight(), price, tickIndex)
+	}
+
+	k.SetTwapRecord(ctx, record)
+	k.pruneTwapRecords(ctx, tradePairID, blockTime.Add(-types.TwapRecordHistoryKeepPeriod))
+}
+
+// pruneTwapRecords removes all twapRecords for the TradePairID older than cutoff except for the latest one,
+// which is still needed to compute TWAPs starting at the cutoff.
+func (k Keeper) pruneTwapRecords(ctx sdk.Context, tradePairID *types.TradePairID, cutoff time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.TwapRecordPrefix(tradePairID), types.TwapRecordKey(tradePairID, cutoff))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(keys) <= 1 {
+		return
+	}
+
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,150 @@
+package v5
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration sets default values for the dex params introduced in v5 and builds the pair and pool
+// indexes by denom. Inactive limit order tranches without any tokens left are deleted rather than indexed.
+// v5 also changes share minting for autoswapped deposits: residual token0 is now discounted by the pool fee
+// instead of the pool's center tick. Existing shares keep their value, so no state needs to be migrated.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	if err := migrateParams(ctx, cdc, storeKey); err != nil {
+		return err
+	}
+
+	return migrateDenomIndexes(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating dex params...")
+
+	// fetch old params
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.KeyPrefix(types.ParamsKey))
+	if bz == nil {
+		return errors.New("cannot fetch dex params from KV store")
+	}
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	// add new param values
+	params.ConditionalOrderAllowance = types.DefaultConditionalOrderAllowance
+	params.ProtocolFeeShare = types.DefaultProtocolFeeShare
+	params.ProtocolFeeCollector = types.DefaultProtocolFeeCollector
+	params.CircuitBreakerAddress = types.DefaultCircuitBreakerAddress
+	params.MaxOracleRepegsPerBlock = types.DefaultMaxOracleRepegsPerBlock
+	params.MinMakerOrderSizes = types.DefaultMinMakerOrderSizes
+	params.MinDepositSizes = types.DefaultMinDepositSizes
+	params.FeeRecommendationWindow = types.DefaultFeeRecommendationWindow
+	params.OracleRepegAllowance = types.DefaultOracleRepegAllowance
+	params.MaxFillRecordSubscribers = types.DefaultMaxFillRecordSubscribers
+	params.FillRecordPruneAllowance = types.DefaultFillRecordPruneAllowance
+
+	// set params
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	ctx.Logger().Info("Finished migrating dex params")
+
+	return nil
+}
+
+func migrateDenomIndexes(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating dex denom indexes...")
+
+	store := ctx.KVStore(storeKey)
+	pairs := make(map[string]*types.PairID)
+	refCounts := make(map[string]uint64)
+	addPair := func(pairID *types.PairID) {
+		pairs[pairID.CanonicalString()] = pairID
+		refCounts[pairID.CanonicalString()]++
+	}
+
+	// Index all pools
+	poolMetadataIterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefix(types.PoolMetadataKeyPrefix))
+	for ; poolMetadataIterator.Valid(); poolMetadataIterator.Next() {
+		var poolMetadata types.PoolMetadata
+		if err := cdc.Unmarshal(poolMetadataIterator.Value(), &poolMetadata); err != nil {
+			poolMetadataIterator.Close()
+			return err
+		}
+
+		poolIDBz := sdk.Uint64ToBigEndian(poolMetadata.Id)
+		store.Set(types.PoolByDenomKey(poolMetadata.PairId.Token0, poolMetadata.Id), poolIDBz)
+		store.Set(types.PoolByDenomKey(poolMetadata.PairId.Token1, poolMetadata.Id), poolIDBz)
+		addPair(poolMetadata.PairId)
+	}
+	poolMetadataIterator.Close()
+
+	// Count all active limit order tranches
+	tickIterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefix(types.TickLiquidityKeyPrefix))
+	for ; tickIterator.Valid(); tickIterator.Next() {
+		var tick types.TickLiquidity
+		if err := cdc.Unmarshal(tickIterator.Value(), &tick); err != nil {
+			tickIterator.Close()
+			return err
+		}
+
+		if tranche := tick.GetLimitOrderTranche(); tranche != nil {
+			addPair(tranche.Key.TradePairId.MustPairID())
+		}
+	}
+	tickIterator.Close()
+
+	// Count all inactive limit order tranches and collect the empty ones
+	var emptyTrancheKeys [][]byte
+	inactiveIterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefix(types.InactiveLimitOrderTrancheKeyPrefix))
+	for ; inactiveIterator.Valid(); inactiveIterator.Next() {
+		var tranche types.LimitOrderTranche
+		if err := cdc.Unmarshal(inactiveIterator.Value(), &tranche); err != nil {
+			inactiveIterator.Close()
+			return err
+		}
+
+		if !tranche.HasTokenIn() && !tranche.HasTokenOut() {
+			emptyTrancheKeys = append(emptyTrancheKeys, bytes.Clone(inactiveIterator.Key()))
+			continue
+		}
+		addPair(tranche.Key.TradePairId.MustPairID())
+	}
+	inactiveIterator.Close()
+
+	for _, key := range emptyTrancheKeys {
+		store.Delete(key)
+	}
+
+	pairStrs := make([]string, 0, len(pairs))
+	for pairStr := range pairs {
+		pairStrs = append(pairStrs, pairStr)
+	}
+	sort.Strings(pairStrs)
+
+	for _, pairStr := range pairStrs {
+		pairID := pairs[pairStr]
+		store.Set(types.PairRefCountKey(pairID), sdk.Uint64ToBigEndian(refCounts[pairStr]))
+
+		bz, err := cdc.Marshal(pairID)
+		if err != nil {
+			return err
+		}
+		store.Set(types.PairByDenomKey(pairID.Token0, pairID), bz)
+		store.Set(types.PairByDenomKey(pairID.Token1, pairID), bz)
+	}
+
+	ctx.Logger().Info("Finished migrating dex denom indexes")
+
+	return nil
+}
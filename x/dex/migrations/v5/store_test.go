@@ -0,0 +1,141 @@
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v4/testutil"
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	v5 "github.com/neutron-org/neutron/v4/x/dex/migrations/v5"
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+type V5DexMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V5DexMigrationTestSuite))
+}
+
+func (suite *V5DexMigrationTestSuite) TestParamsUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+	)
+
+	// Write old state
+	oldParams := types.Params{
+		FeeTiers:              []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200},
+		Paused:                true,
+		MaxJitsPerBlock:       10,
+		GoodTilPurgeAllowance: 100_000,
+	}
+	store := ctx.KVStore(storeKey)
+	bz, err := cdc.Marshal(&oldParams)
+	suite.Require().NoError(err)
+
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	// Run migration
+	suite.NoError(v5.MigrateStore(ctx, cdc, storeKey))
+
+	// Check params are correct
+	newParams := app.DexKeeper.GetParams(ctx)
+	suite.Require().EqualValues(oldParams.FeeTiers, newParams.FeeTiers)
+	suite.Require().EqualValues(oldParams.Paused, newParams.Paused)
+	suite.Require().EqualValues(oldParams.MaxJitsPerBlock, newParams.MaxJitsPerBlock)
+	suite.Require().EqualValues(oldParams.GoodTilPurgeAllowance, newParams.GoodTilPurgeAllowance)
+	suite.Require().EqualValues(types.DefaultConditionalOrderAllowance, newParams.ConditionalOrderAllowance)
+	suite.Require().Equal(types.DefaultProtocolFeeShare, newParams.ProtocolFeeShare)
+	suite.Require().Equal(types.DefaultProtocolFeeCollector, newParams.ProtocolFeeCollector)
+	suite.Require().Equal(types.DefaultCircuitBreakerAddress, newParams.CircuitBreakerAddress)
+	suite.Require().Equal(types.DefaultMaxOracleRepegsPerBlock, newParams.MaxOracleRepegsPerBlock)
+	suite.Require().Empty(newParams.MinMakerOrderSizes)
+	suite.Require().Empty(newParams.MinDepositSizes)
+	suite.Require().Equal(types.DefaultFeeRecommendationWindow, newParams.FeeRecommendationWindow)
+	suite.Require().Equal(types.DefaultOracleRepegAllowance, newParams.OracleRepegAllowance)
+	suite.Require().Equal(types.DefaultMaxFillRecordSubscribers, newParams.MaxFillRecordSubscribers)
+	suite.Require().Equal(types.DefaultFillRecordPruneAllowance, newParams.FillRecordPruneAllowance)
+}
+
+func (suite *V5DexMigrationTestSuite) TestDenomIndexesUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+		pairAB   = types.MustNewPairID("TokenA", "TokenB")
+		pairBC   = types.MustNewPairID("TokenB", "TokenC")
+	)
+
+	// Write old state
+	app.DexKeeper.SetPoolMetadata(ctx, types.PoolMetadata{Id: 0, PairId: pairAB, Tick: 0, Fee: 1})
+	app.DexKeeper.SetPoolMetadata(ctx, types.PoolMetadata{Id: 1, PairId: pairAB, Tick: 0, Fee: 5})
+	app.DexKeeper.SetLimitOrderTranche(ctx, &types.LimitOrderTranche{
+		Key: &types.LimitOrderTrancheKey{
+			TradePairId:           types.MustNewTradePairID("TokenB", "TokenC"),
+			TickIndexTakerToMaker: 0,
+			TrancheKey:            "active",
+		},
+		ReservesMakerDenom: math.NewInt(10),
+		ReservesTakerDenom: math.ZeroInt(),
+		TotalMakerDenom:    math.NewInt(10),
+		TotalTakerDenom:    math.ZeroInt(),
+		PriceTakerToMaker:  math_utils.OnePrecDec(),
+	})
+	app.DexKeeper.SetInactiveLimitOrderTranche(ctx, &types.LimitOrderTranche{
+		Key: &types.LimitOrderTrancheKey{
+			TradePairId:           types.MustNewTradePairID("TokenC", "TokenB"),
+			TickIndexTakerToMaker: 0,
+			TrancheKey:            "inactive",
+		},
+		ReservesMakerDenom: math.ZeroInt(),
+		ReservesTakerDenom: math.NewInt(10),
+		TotalMakerDenom:    math.NewInt(10),
+		TotalTakerDenom:    math.NewInt(10),
+		PriceTakerToMaker:  math_utils.OnePrecDec(),
+	})
+	emptyTrancheKey := &types.LimitOrderTrancheKey{
+		TradePairId:           types.MustNewTradePairID("TokenD", "TokenC"),
+		TickIndexTakerToMaker: 0,
+		TrancheKey:            "empty",
+	}
+	app.DexKeeper.SetInactiveLimitOrderTranche(ctx, &types.LimitOrderTranche{
+		Key:                emptyTrancheKey,
+		ReservesMakerDenom: math.ZeroInt(),
+		ReservesTakerDenom: math.ZeroInt(),
+		TotalMakerDenom:    math.NewInt(10),
+		TotalTakerDenom:    math.ZeroInt(),
+		PriceTakerToMaker:  math_utils.OnePrecDec(),
+	})
+
+	// Run migration
+	suite.NoError(v5.MigrateStore(ctx, cdc, storeKey))
+
+	// Check the pair and pool indexes by denom are built
+	pairsResp, err := app.DexKeeper.PairsByDenom(ctx, &types.QueryPairsByDenomRequest{Denom: "TokenB"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.PairID{pairAB, pairBC}, pairsResp.PairIds)
+	suite.Require().Equal(uint64(2), app.DexKeeper.GetPairRefCount(ctx, pairAB))
+	suite.Require().Equal(uint64(2), app.DexKeeper.GetPairRefCount(ctx, pairBC))
+
+	// Check the empty inactive tranche is deleted instead of indexed
+	_, found := app.DexKeeper.GetInactiveLimitOrderTranche(ctx, emptyTrancheKey)
+	suite.Require().False(found)
+	pairsResp, err = app.DexKeeper.PairsByDenom(ctx, &types.QueryPairsByDenomRequest{Denom: "TokenD"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(pairsResp.PairIds)
+
+	poolsResp, err := app.DexKeeper.PoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "TokenA"})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolsResp.Pools, 2)
+
+	poolsResp, err = app.DexKeeper.PoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "TokenC"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(poolsResp.Pools)
+}
@@ -162,10 +162,16 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/dex from version 3 to 4: %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dex from version 4 to 5: %v", err))
+	}
 }
 
-// RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the dex module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -195,12 +201,18 @@ func (AppModule) ConsensusVersion() uint64 { return types.ConsensusVersion }
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(wctx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(wctx)
+	am.keeper.RecordOpeningTicks(ctx)
 	am.keeper.PurgeExpiredLimitOrders(ctx, ctx.BlockTime())
+	am.keeper.RepegLimitOrders(ctx)
+	am.keeper.ExecuteTriggeredConditionalOrders(ctx)
+	am.keeper.PruneFillRecords(ctx, ctx.BlockTime().Add(-types.FillRecordHistoryKeepPeriod))
 	return nil
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ context.Context) ([]abci.ValidatorUpdate, error) {
+func (am AppModule) EndBlock(wctx context.Context) ([]abci.ValidatorUpdate, error) {
+	ctx := sdk.UnwrapSDKContext(wctx)
+	am.keeper.DistributeProtocolFees(ctx)
 	return []abci.ValidatorUpdate{}, nil
 }
@@ -14,6 +14,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawFilledLimitOrder{}, "dex/WithdrawFilledLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "dex/CancelLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgMultiHopSwap{}, "dex/MultiHopSwap", nil)
+	cdc.RegisterConcrete(&MsgPlaceConditionalOrder{}, "dex/PlaceConditionalOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelConditionalOrder{}, "dex/CancelConditionalOrder", nil)
+	cdc.RegisterConcrete(&MsgSwapExactIn{}, "dex/SwapExactIn", nil)
+	cdc.RegisterConcrete(&MsgBatchCancelLimitOrders{}, "dex/BatchCancelLimitOrders", nil)
+	cdc.RegisterConcrete(&MsgBatchWithdrawFilledLimitOrders{}, "dex/BatchWithdrawFilledLimitOrders", nil)
+	cdc.RegisterConcrete(&MsgCancelAllLimitOrders{}, "dex/CancelAllLimitOrders", nil)
+	cdc.RegisterConcrete(&MsgDepositRange{}, "dex/DepositRange", nil)
+	cdc.RegisterConcrete(&MsgWithdrawRange{}, "dex/WithdrawRange", nil)
+	cdc.RegisterConcrete(&MsgSetPairCircuitBreaker{}, "dex/SetPairCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "dex/WithdrawPosition", nil)
+	cdc.RegisterConcrete(&MsgFlashSwap{}, "dex/FlashSwap", nil)
+	cdc.RegisterConcrete(&MsgModifyLimitOrder{}, "dex/ModifyLimitOrder", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -36,6 +48,36 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMultiHopSwap{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceConditionalOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelConditionalOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSwapExactIn{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchCancelLimitOrders{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchWithdrawFilledLimitOrders{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelAllLimitOrders{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDepositRange{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawRange{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPairCircuitBreaker{},
+		&MsgWithdrawPosition{},
+		&MsgFlashSwap{},
+		&MsgModifyLimitOrder{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
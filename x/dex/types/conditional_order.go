@@ -0,0 +1,19 @@
+package types
+
+// IsTriggered returns true if the order should be executed given the current TakerToMaker tick index of its TradePairID.
+// Since price decreases as tick index increases, a STOP_LOSS order (price <= trigger price) is triggered once the
+// current tick is at or above the trigger tick and a TAKE_PROFIT order once the current tick is at or below it.
+func (o ConditionalOrder) IsTriggered(currTickIndexTakerToMaker int64) bool {
+	switch o.Trigger {
+	case ConditionalOrderTrigger_STOP_LOSS:
+		return currTickIndexTakerToMaker >= o.TriggerTickIndex
+	case ConditionalOrderTrigger_TAKE_PROFIT:
+		return currTickIndexTakerToMaker <= o.TriggerTickIndex
+	default:
+		return false
+	}
+}
+
+func (o ConditionalOrder) TriggerKey() []byte {
+	return ConditionalOrderTriggerKey(o.TradePairId, o.Trigger, o.TriggerTickIndex, o.Id)
+}
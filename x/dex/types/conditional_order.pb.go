@@ -0,0 +1,728 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/conditional_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	cosmossdk_io_math "cosmossdk.io/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ConditionalOrder struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator  string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// TradePairID of the liquidity the order will trade against once triggered
+	TradePairId           *TradePairID            `protobuf:"bytes,4,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	AmountIn              cosmossdk_io_math.Int   `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	OrderType             LimitOrderType          `protobuf:"varint,6,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	MaxAmountOut          *cosmossdk_io_math.Int  `protobuf:"bytes,7,opt,name=max_amount_out,json=maxAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_out" yaml:"max_amount_out"`
+	LimitTickIndexInToOut int64                   `protobuf:"varint,8,opt,name=limit_tick_index_in_to_out,json=limitTickIndexInToOut,proto3" json:"limit_tick_index_in_to_out,omitempty"`
+	Trigger               ConditionalOrderTrigger `protobuf:"varint,9,opt,name=trigger,proto3,enum=neutron.dex.ConditionalOrderTrigger" json:"trigger,omitempty"`
+	// trigger_tick_index is expressed as a TakerToMaker tick index of trade_pair_id
+	TriggerTickIndex int64 `protobuf:"varint,10,opt,name=trigger_tick_index,json=triggerTickIndex,proto3" json:"trigger_tick_index,omitempty"`
+}
+
+func (m *ConditionalOrder) Reset()         { *m = ConditionalOrder{} }
+func (m *ConditionalOrder) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrder) ProtoMessage()    {}
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957d2a1529950c93, []int{0}
+}
+func (m *ConditionalOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionalOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrder.Merge(m, src)
+}
+func (m *ConditionalOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalOrder proto.InternalMessageInfo
+
+func (m *ConditionalOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *ConditionalOrder) GetOrderType() LimitOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return LimitOrderType_GOOD_TIL_CANCELLED
+}
+
+func (m *ConditionalOrder) GetLimitTickIndexInToOut() int64 {
+	if m != nil {
+		return m.LimitTickIndexInToOut
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetTrigger() ConditionalOrderTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return ConditionalOrderTrigger_STOP_LOSS
+}
+
+func (m *ConditionalOrder) GetTriggerTickIndex() int64 {
+	if m != nil {
+		return m.TriggerTickIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ConditionalOrder)(nil), "neutron.dex.ConditionalOrder")
+}
+
+func init() {
+	proto.RegisterFile("neutron/dex/conditional_order.proto", fileDescriptor_957d2a1529950c93)
+}
+
+var fileDescriptor_957d2a1529950c93 = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0x8e, 0xd2, 0xac, 0x49, 0x94, 0x2d, 0x04, 0xd1, 0x80, 0xc8, 0xc0, 0x0e, 0xd9, 0x0e, 0x39,
+	0xac, 0x36, 0x74, 0xbb, 0xac, 0x8c, 0xc2, 0xba, 0xc1, 0x16, 0x18, 0x74, 0x98, 0x9c, 0xb6, 0x83,
+	0x50, 0x2d, 0xe1, 0x8a, 0xc4, 0x52, 0x50, 0xe4, 0xe2, 0xfc, 0x8b, 0xfd, 0xac, 0x1c, 0x7b, 0x1c,
+	0x3b, 0x98, 0x91, 0xdc, 0x76, 0xec, 0x2f, 0x18, 0x96, 0x9d, 0x34, 0xee, 0xa1, 0xb7, 0xef, 0xbd,
+	0xf7, 0xbd, 0xf7, 0x7d, 0x4f, 0x7a, 0xf0, 0x95, 0xe4, 0x89, 0xd1, 0x4a, 0xfa, 0x8c, 0xa7, 0x7e,
+	0xa8, 0x24, 0x13, 0x46, 0x28, 0x49, 0xe7, 0x44, 0x69, 0xc6, 0xb5, 0xb7, 0xd0, 0xca, 0x28, 0xd4,
+	0x29, 0x49, 0x1e, 0xe3, 0xe9, 0xe0, 0x24, 0x52, 0x91, 0xb2, 0x79, 0x3f, 0x47, 0x05, 0x65, 0xe0,
+	0x1e, 0xce, 0x31, 0x9a, 0x32, 0x4e, 0x16, 0x54, 0x68, 0x22, 0x58, 0x49, 0x38, 0xa9, 0x10, 0xd2,
+	0x22, 0x3b, 0x5a, 0x37, 0x60, 0xef, 0xd3, 0x83, 0xea, 0x55, 0x2e, 0x8a, 0xba, 0xb0, 0x2e, 0x18,
+	0x06, 0x43, 0x30, 0x6e, 0x04, 0x75, 0xc1, 0x10, 0x86, 0xcd, 0x50, 0x73, 0x6a, 0x94, 0xc6, 0xf5,
+	0x21, 0x18, 0xb7, 0x83, 0x5d, 0x88, 0x06, 0xb0, 0xa5, 0x79, 0xc8, 0xc5, 0x2d, 0xd7, 0xf8, 0xc8,
+	0x96, 0xf6, 0x31, 0xfa, 0x00, 0x5f, 0x54, 0x7c, 0xe0, 0xc6, 0x10, 0x8c, 0x3b, 0x67, 0xd8, 0x3b,
+	0x58, 0xc6, 0x9b, 0xe6, 0x8c, 0xef, 0x54, 0xe8, 0xc9, 0xe7, 0xa0, 0x63, 0xf6, 0x01, 0x43, 0x3f,
+	0x61, 0x9b, 0xc6, 0x2a, 0x91, 0x86, 0x08, 0x89, 0x9f, 0xe5, 0xa3, 0x2f, 0x2f, 0xd6, 0x99, 0x5b,
+	0xfb, 0x93, 0xb9, 0xfd, 0x50, 0x2d, 0x63, 0xb5, 0x5c, 0xb2, 0x99, 0x27, 0x94, 0x1f, 0x53, 0x73,
+	0xe3, 0x4d, 0xa4, 0xf9, 0x97, 0xb9, 0x0f, 0x1d, 0xf7, 0x99, 0xdb, 0x5b, 0xd1, 0x78, 0x7e, 0x3e,
+	0xda, 0xa7, 0x46, 0x41, 0xab, 0xc0, 0x13, 0x89, 0xce, 0x21, 0xb4, 0xcf, 0x4b, 0xcc, 0x6a, 0xc1,
+	0xf1, 0xf1, 0x10, 0x8c, 0xbb, 0x67, 0x2f, 0x2b, 0xbe, 0xbe, 0x89, 0x58, 0x18, 0xfb, 0x1a, 0xd3,
+	0xd5, 0x82, 0x07, 0x6d, 0xb5, 0x83, 0x48, 0xc2, 0x6e, 0x4c, 0x53, 0x52, 0xce, 0x55, 0x89, 0xc1,
+	0x4d, 0xeb, 0xee, 0xeb, 0x3a, 0x73, 0xc1, 0x53, 0xee, 0x1e, 0xb5, 0xdd, 0x67, 0x6e, 0xbf, 0xb0,
+	0x58, 0xcd, 0x8f, 0x82, 0xe7, 0x31, 0x4d, 0x3f, 0xda, 0xf8, 0x2a, 0x31, 0xe8, 0x3d, 0x1c, 0xcc,
+	0x73, 0x33, 0xc4, 0x88, 0x70, 0x46, 0x84, 0x64, 0x3c, 0x25, 0x42, 0x12, 0xa3, 0xac, 0x76, 0x6b,
+	0x08, 0xc6, 0x47, 0x41, 0xdf, 0x32, 0xa6, 0x22, 0x9c, 0x4d, 0xf2, 0xfa, 0x44, 0x4e, 0x55, 0xde,
+	0x7a, 0x01, 0x9b, 0x46, 0x8b, 0x28, 0xe2, 0x1a, 0xb7, 0xed, 0x8e, 0xaf, 0x2b, 0x3b, 0x3e, 0xfe,
+	0xf7, 0x69, 0xc1, 0x0d, 0x76, 0x4d, 0xe8, 0x0d, 0x44, 0x25, 0x3c, 0x10, 0xc7, 0xd0, 0x4a, 0xf6,
+	0xca, 0xca, 0x5e, 0xf4, 0xf2, 0xcb, 0x7a, 0xe3, 0x80, 0xbb, 0x8d, 0x03, 0xfe, 0x6e, 0x1c, 0xf0,
+	0x6b, 0xeb, 0xd4, 0xee, 0xb6, 0x4e, 0xed, 0xf7, 0xd6, 0xa9, 0xfd, 0x38, 0x8d, 0x84, 0xb9, 0x49,
+	0xae, 0xbd, 0x50, 0xc5, 0x7e, 0x69, 0xe0, 0x54, 0xe9, 0x68, 0x87, 0xfd, 0xdb, 0x77, 0x7e, 0x5a,
+	0x9c, 0xe5, 0x6a, 0xc1, 0x97, 0xd7, 0xc7, 0xf6, 0x34, 0xdf, 0xfe, 0x1f, 0x00, 0xbe, 0xf5, 0xb7,
+	0xa8, 0x1b, 0x03, 0x00, 0x00,
+}
+
+func (m *ConditionalOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionalOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionalOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerTickIndex != 0 {
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(m.TriggerTickIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Trigger != 0 {
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LimitTickIndexInToOut != 0 {
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(m.LimitTickIndexInToOut))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConditionalOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConditionalOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintConditionalOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConditionalOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConditionalOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConditionalOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovConditionalOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovConditionalOrder(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovConditionalOrder(uint64(l))
+	}
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovConditionalOrder(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovConditionalOrder(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovConditionalOrder(uint64(m.OrderType))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovConditionalOrder(uint64(l))
+	}
+	if m.LimitTickIndexInToOut != 0 {
+		n += 1 + sovConditionalOrder(uint64(m.LimitTickIndexInToOut))
+	}
+	if m.Trigger != 0 {
+		n += 1 + sovConditionalOrder(uint64(m.Trigger))
+	}
+	if m.TriggerTickIndex != 0 {
+		n += 1 + sovConditionalOrder(uint64(m.TriggerTickIndex))
+	}
+	return n
+}
+
+func sovConditionalOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConditionalOrder(x uint64) (n int) {
+	return sovConditionalOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConditionalOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConditionalOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitTickIndexInToOut", wireType)
+			}
+			m.LimitTickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitTickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			m.Trigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trigger |= ConditionalOrderTrigger(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTickIndex", wireType)
+			}
+			m.TriggerTickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerTickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConditionalOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConditionalOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConditionalOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConditionalOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConditionalOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConditionalOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConditionalOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConditionalOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConditionalOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConditionalOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConditionalOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,3 +1,38 @@
 package types
 
-const ConsensusVersion = 4
+import "time"
+
+const ConsensusVersion = 5
+
+// TwapRecordHistoryKeepPeriod is how long TwapRecords are retained. The latest record older than
+// the keep period is always retained so that TWAPs can be computed over the full period.
+const TwapRecordHistoryKeepPeriod = 48 * time.Hour
+
+// FillRecordHistoryKeepPeriod is how long FillRecords are retained
+const FillRecordHistoryKeepPeriod = 30 * 24 * time.Hour
+
+const (
+	// MaxRouteHops is the maximum number of swaps in a route found by route discovery
+	MaxRouteHops = 3
+	// MaxRouteCandidates is the maximum number of routes evaluated by route discovery. Shorter routes are
+	// considered first.
+	MaxRouteCandidates = 20
+	// MaxRouteExpansions is the maximum number of partial routes expanded by route discovery, bounding its cost
+	// regardless of how many pairs exist
+	MaxRouteExpansions = 1000
+	// RouteExpansionGas is the gas consumed for each partial route expanded by route discovery
+	RouteExpansionGas = 1_000
+)
+
+const (
+	// MaxOrderBookDepthBuckets is the maximum number of buckets returned by the OrderBookDepth query
+	MaxOrderBookDepthBuckets = 100
+	// MaxOrderBookDepthBucketWidth is the maximum bucket width (in ticks) for the OrderBookDepth query
+	MaxOrderBookDepthBucketWidth = 2 * MaxTickExp
+)
+
+// MaxLimitOrderBatchSize is the maximum number of tranche keys in a single batch cancel or withdraw message
+const MaxLimitOrderBatchSize = 100
+
+// MaxRangeDepositPools is the maximum number of pools a single range deposit is spread across
+const MaxRangeDepositPools = 100
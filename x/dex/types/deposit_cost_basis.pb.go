@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/deposit_cost_basis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	cosmossdk_io_math "cosmossdk.io/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DepositCostBasis is the amount of each token an address deposited into a pool for the shares it still holds.
+// It is reduced pro-rata as shares are withdrawn. Shares received by transfer have no cost basis.
+type DepositCostBasis struct {
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PoolId  uint64                `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Shares  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.Int" json:"shares" yaml:"shares"`
+	Amount0 cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
+	Amount1 cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount1,proto3,customtype=cosmossdk.io/math.Int" json:"amount1" yaml:"amount1"`
+}
+
+func (m *DepositCostBasis) Reset()         { *m = DepositCostBasis{} }
+func (m *DepositCostBasis) String() string { return proto.CompactTextString(m) }
+func (*DepositCostBasis) ProtoMessage()    {}
+func (*DepositCostBasis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a71f663aede9041, []int{0}
+}
+func (m *DepositCostBasis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositCostBasis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositCostBasis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositCostBasis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositCostBasis.Merge(m, src)
+}
+func (m *DepositCostBasis) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositCostBasis) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositCostBasis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositCostBasis proto.InternalMessageInfo
+
+func (m *DepositCostBasis) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DepositCostBasis) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DepositCostBasis)(nil), "neutron.dex.DepositCostBasis")
+}
+
+func init() {
+	proto.RegisterFile("neutron/dex/deposit_cost_basis.proto", fileDescriptor_0a71f663aede9041)
+}
+
+var fileDescriptor_0a71f663aede9041 = []byte{
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x4b, 0xc3, 0x50,
+	0x14, 0xc5, 0x93, 0x5a, 0x5b, 0x8c, 0x28, 0x12, 0x14, 0x1f, 0x0e, 0x2f, 0x25, 0x38, 0x74, 0x69,
+	0x9e, 0x41, 0x07, 0xe9, 0x58, 0x05, 0xe9, 0x26, 0xc1, 0xc9, 0xa5, 0xbc, 0xf6, 0x3d, 0xd2, 0x60,
+	0x93, 0x1b, 0x72, 0x5f, 0xa5, 0xfd, 0x16, 0x7e, 0x27, 0x97, 0x8e, 0x1d, 0xc5, 0x21, 0x48, 0xb3,
+	0x39, 0xf6, 0x13, 0x48, 0xfe, 0x29, 0xb8, 0x88, 0xdb, 0x3d, 0xf7, 0x9c, 0xf3, 0x5b, 0x8e, 0x71,
+	0x1e, 0xc9, 0xb9, 0x4a, 0x20, 0x62, 0x42, 0x2e, 0x98, 0x90, 0x31, 0x60, 0xa0, 0x46, 0x13, 0x40,
+	0x35, 0x1a, 0x73, 0x0c, 0xd0, 0x89, 0x13, 0x50, 0x60, 0xee, 0x57, 0x29, 0x47, 0xc8, 0xc5, 0xd9,
+	0xb1, 0x0f, 0x3e, 0x14, 0x7f, 0x96, 0x5f, 0x65, 0xc4, 0x7e, 0x6d, 0x18, 0x47, 0xb7, 0x65, 0xff,
+	0x06, 0x50, 0x0d, 0xf2, 0xb6, 0x49, 0x8c, 0x36, 0x17, 0x22, 0x91, 0x88, 0x44, 0xef, 0xe8, 0xdd,
+	0x3d, 0xaf, 0x96, 0xe6, 0xa9, 0xd1, 0x8e, 0x01, 0x66, 0xa3, 0x40, 0x90, 0x46, 0x47, 0xef, 0x36,
+	0xbd, 0x56, 0x2e, 0x87, 0xc2, 0xbc, 0x37, 0x5a, 0x38, 0xe5, 0x89, 0x44, 0xb2, 0x93, 0x37, 0x06,
+	0xd7, 0xab, 0xd4, 0xd2, 0xde, 0x53, 0xeb, 0x64, 0x02, 0x18, 0x02, 0xa2, 0x78, 0x72, 0x02, 0x60,
+	0x21, 0x57, 0x53, 0x67, 0x18, 0xa9, 0xcf, 0xd4, 0xaa, 0xe2, 0xdb, 0xd4, 0x3a, 0x58, 0xf2, 0x70,
+	0xd6, 0xb7, 0x4b, 0x6d, 0x7b, 0x95, 0x61, 0x3e, 0x18, 0x6d, 0x1e, 0xc2, 0x3c, 0x52, 0x17, 0xa4,
+	0x59, 0x20, 0xfb, 0x7f, 0x21, 0xeb, 0xfc, 0x36, 0xb5, 0x0e, 0x4b, 0x66, 0xf5, 0xb0, 0xbd, 0xda,
+	0xfa, 0xa1, 0xba, 0x64, 0xf7, 0x5f, 0x54, 0xf7, 0x37, 0xd5, 0xfd, 0xa6, 0xba, 0x83, 0xbb, 0xd5,
+	0x86, 0xea, 0xeb, 0x0d, 0xd5, 0x3f, 0x36, 0x54, 0x7f, 0xc9, 0xa8, 0xb6, 0xce, 0xa8, 0xf6, 0x96,
+	0x51, 0xed, 0xb1, 0xe7, 0x07, 0x6a, 0x3a, 0x1f, 0x3b, 0x13, 0x08, 0x59, 0xb5, 0x46, 0x0f, 0x12,
+	0xbf, 0xbe, 0xd9, 0xf3, 0x15, 0x5b, 0x14, 0x23, 0xaa, 0x65, 0x2c, 0x71, 0xdc, 0x2a, 0x56, 0xb9,
+	0xfc, 0x1a, 0x00, 0x2c, 0x17, 0xb2, 0x3b, 0xe0, 0x01, 0x00, 0x00,
+}
+
+func (m *DepositCostBasis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositCostBasis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositCostBasis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDepositCostBasis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDepositCostBasis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDepositCostBasis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintDepositCostBasis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDepositCostBasis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDepositCostBasis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDepositCostBasis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DepositCostBasis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDepositCostBasis(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDepositCostBasis(uint64(m.PoolId))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovDepositCostBasis(uint64(l))
+	l = m.Amount0.Size()
+	n += 1 + l + sovDepositCostBasis(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovDepositCostBasis(uint64(l))
+	return n
+}
+
+func sovDepositCostBasis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDepositCostBasis(x uint64) (n int) {
+	return sovDepositCostBasis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DepositCostBasis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDepositCostBasis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositCostBasis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositCostBasis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositCostBasis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositCostBasis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositCostBasis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositCostBasis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDepositCostBasis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDepositCostBasis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDepositCostBasis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDepositCostBasis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDepositCostBasis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDepositCostBasis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDepositCostBasis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDepositCostBasis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDepositCostBasis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDepositCostBasis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDepositCostBasis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDepositCostBasis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDepositCostBasis = fmt.Errorf("proto: unexpected end of group")
+)
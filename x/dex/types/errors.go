@@ -224,4 +224,184 @@ var (
 		1163,
 		"Cannot convert price to int64 tick value",
 	)
+	ErrInvalidConditionalOrderType = sdkerrors.Register(
+		ModuleName,
+		1164,
+		"Conditional orders must be FILL_OR_KILL or IMMEDIATE_OR_CANCEL",
+	)
+	ErrConditionalOrderNotFound = sdkerrors.Register(
+		ModuleName,
+		1165,
+		"No conditional order found with the given ID for the creator",
+	)
+	ErrInvalidConditionalOrderTrigger = sdkerrors.Register(
+		ModuleName,
+		1166,
+		"Invalid conditional order trigger",
+	)
+	ErrMultihopMaxAmountInExceeded = sdkerrors.Register(
+		ModuleName,
+		1167,
+		"Route requires more than MaxAmountIn to deliver the requested AmountOut",
+	)
+	ErrZeroSwapAmountOut = sdkerrors.Register(
+		ModuleName,
+		1168,
+		"AmountOut must be nil or > 0 for swap.",
+	)
+	ErrNoTwapRecord = sdkerrors.Register(
+		ModuleName,
+		1169,
+		"No TWAP record exists for the trade pair at or before the start time",
+	)
+	ErrInvalidTwapTimeRange = sdkerrors.Register(
+		ModuleName,
+		1170,
+		"Invalid TWAP time range; must have start_time <= end_time <= block time",
+	)
+	ErrNoRouteFound = sdkerrors.Register(
+		ModuleName,
+		1171,
+		"No route with liquidity found between TokenIn and TokenOut",
+	)
+	ErrInvalidMaxHops = sdkerrors.Register(
+		ModuleName,
+		1172,
+		"MaxHops exceeds the maximum allowed number of hops",
+	)
+	ErrInvalidBucketWidth = sdkerrors.Register(
+		ModuleName,
+		1173,
+		"BucketWidth exceeds the maximum allowed bucket width",
+	)
+	ErrInvalidMaxBuckets = sdkerrors.Register(
+		ModuleName,
+		1174,
+		"MaxBuckets exceeds the maximum allowed number of buckets",
+	)
+	ErrInvalidBatchSize = sdkerrors.Register(
+		ModuleName,
+		1175,
+		"Number of tranche keys must be greater than 0 and less than or equal to MaxLimitOrderBatchSize",
+	)
+	ErrDuplicateTrancheKey = sdkerrors.Register(
+		ModuleName,
+		1176,
+		"Tranche keys must be unique",
+	)
+	ErrInvalidPriceRange = sdkerrors.Register(
+		ModuleName,
+		1177,
+		"LowerPrice must be less than or equal to UpperPrice",
+	)
+	ErrInvalidLiquidityShape = sdkerrors.Register(
+		ModuleName,
+		1178,
+		"Invalid liquidity shape",
+	)
+	ErrNoRangePosition = sdkerrors.Register(
+		ModuleName,
+		1179,
+		"No shares found in the pools of the range",
+	)
+	ErrPairPaused = sdkerrors.Register(
+		ModuleName,
+		1180,
+		"Pair has been paused, all messages for this pair are disabled at this time",
+	)
+	ErrPriceBandExceeded = sdkerrors.Register(
+		ModuleName,
+		1181,
+		"Swap would move the price further from the block's opening tick than the pair's max tick deviation",
+	)
+	ErrUnauthorizedCircuitBreaker = sdkerrors.Register(
+		ModuleName,
+		1182,
+		"Only the module authority or the circuit breaker address can set pair circuit breakers",
+	)
+	ErrDepositSharesBelowMin = sdkerrors.Register(
+		ModuleName,
+		1183,
+		"Deposit issued fewer shares than min_shares_out",
+	)
+	ErrWithdrawAmountBelowMin = sdkerrors.Register(
+		ModuleName,
+		1184,
+		"Withdrawal returned less than the minimum amount out",
+	)
+	ErrNegativeMinOut = sdkerrors.Register(
+		ModuleName,
+		1185,
+		"Minimum out amounts cannot be negative",
+	)
+	ErrNoPosition = sdkerrors.Register(
+		ModuleName,
+		1186,
+		"No shares found in the pools of the pair",
+	)
+	ErrInvalidWithdrawPercentage = sdkerrors.Register(
+		ModuleName,
+		1187,
+		"Withdraw percentage must be greater than 0 and at most 100",
+	)
+	ErrInvalidOraclePeg = sdkerrors.Register(
+		ModuleName,
+		1188,
+		"Invalid oracle peg",
+	)
+	ErrOraclePriceNotFound = sdkerrors.Register(
+		ModuleName,
+		1189,
+		"Oracle price not found",
+	)
+	ErrSelfTradePrevented = sdkerrors.Register(
+		ModuleName,
+		1190,
+		"Limit order cancelled to prevent a self trade",
+	)
+	ErrInvalidSelfTradePrevention = sdkerrors.Register(
+		ModuleName,
+		1191,
+		"Invalid self trade prevention mode",
+	)
+	ErrMakerOrderBelowMinSize = sdkerrors.Register(
+		ModuleName,
+		1192,
+		"Maker limit order is below the minimum order size",
+	)
+	ErrDepositBelowMinSize = sdkerrors.Register(
+		ModuleName,
+		1193,
+		"Deposit is below the minimum deposit size",
+	)
+	ErrFlashSwapNotContract = sdkerrors.Register(
+		ModuleName,
+		1194,
+		"Flash swaps can only be made by contracts",
+	)
+	ErrFlashSwapCallbackFailed = sdkerrors.Register(
+		ModuleName,
+		1195,
+		"Flash swap callback failed",
+	)
+	ErrFlashSwapNotRepaid = sdkerrors.Register(
+		ModuleName,
+		1196,
+		"Flash swap was not repaid",
+	)
+	ErrInvalidLimitOrderModification = sdkerrors.Register(
+		ModuleName,
+		1197,
+		"Invalid limit order modification",
+	)
+	ErrNoRecommendedFee = sdkerrors.Register(
+		ModuleName,
+		1198,
+		"No fee is recommended for the pair",
+	)
+	ErrTooManyFillRecordSubscribers = sdkerrors.Register(
+		ModuleName,
+		1199,
+		"Limit order tranche has too many users recording fills",
+	)
 )
@@ -236,6 +236,47 @@ func CreateTickUpdateLimitOrderTranchePurge(tranche *LimitOrderTranche) sdk.Even
 	)
 }
 
+func NewEventTickUpdatePoolReserves(tick PoolReserves) *EventTickUpdate {
+	tradePairID := tick.Key.TradePairId
+	pairID := tradePairID.MustPairID()
+	return &EventTickUpdate{
+		Token0:                pairID.Token0,
+		Token1:                pairID.Token1,
+		MakerDenom:            tradePairID.MakerDenom,
+		TickIndexTakerToMaker: tick.Key.TickIndexTakerToMaker,
+		Reserves:              tick.ReservesMakerDenom,
+		Fee:                   tick.Key.Fee,
+	}
+}
+
+func NewEventTickUpdateLimitOrderTranche(tranche *LimitOrderTranche, reserves math.Int) *EventTickUpdate {
+	tradePairID := tranche.Key.TradePairId
+	pairID := tradePairID.MustPairID()
+	return &EventTickUpdate{
+		Token0:                pairID.Token0,
+		Token1:                pairID.Token1,
+		MakerDenom:            tradePairID.MakerDenom,
+		TickIndexTakerToMaker: tranche.Key.TickIndexTakerToMaker,
+		Reserves:              reserves,
+		TrancheKey:            tranche.Key.TrancheKey,
+	}
+}
+
+func NewEventLimitOrderFilled(tranche *LimitOrderTranche, amountIn, amountOut math.Int) *EventLimitOrderFilled {
+	tradePairID := tranche.Key.TradePairId
+	pairID := tradePairID.MustPairID()
+	return &EventLimitOrderFilled{
+		Token0:                pairID.Token0,
+		Token1:                pairID.Token1,
+		MakerDenom:            tradePairID.MakerDenom,
+		TakerDenom:            tradePairID.TakerDenom,
+		TickIndexTakerToMaker: tranche.Key.TickIndexTakerToMaker,
+		TrancheKey:            tranche.Key.TrancheKey,
+		AmountIn:              amountIn,
+		AmountOut:             amountOut,
+	}
+}
+
 func GoodTilPurgeHitLimitEvent(gas types.Gas) sdk.Event {
 	attrs := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
@@ -245,6 +286,68 @@ func GoodTilPurgeHitLimitEvent(gas types.Gas) sdk.Event {
 	return sdk.NewEvent(EventTypeGoodTilPurgeHitGasLimit, attrs...)
 }
 
+func CreatePlaceConditionalOrderEvent(order ConditionalOrder) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, PlaceConditionalOrderEventKey),
+		sdk.NewAttribute(ConditionalOrderEventID, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(ConditionalOrderEventCreator, order.Creator),
+		sdk.NewAttribute(ConditionalOrderEventReceiver, order.Receiver),
+		sdk.NewAttribute(ConditionalOrderEventTokenIn, order.TradePairId.TakerDenom),
+		sdk.NewAttribute(ConditionalOrderEventTokenOut, order.TradePairId.MakerDenom),
+		sdk.NewAttribute(ConditionalOrderEventAmountIn, order.AmountIn.String()),
+		sdk.NewAttribute(ConditionalOrderEventOrderType, order.OrderType.String()),
+		sdk.NewAttribute(ConditionalOrderEventTrigger, order.Trigger.String()),
+		sdk.NewAttribute(ConditionalOrderEventTriggerTick, strconv.FormatInt(order.TriggerTickIndex, 10)),
+		sdk.NewAttribute(ConditionalOrderEventLimitTick, strconv.FormatInt(order.LimitTickIndexInToOut, 10)),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CancelConditionalOrderEvent(order ConditionalOrder) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, CancelConditionalOrderEventKey),
+		sdk.NewAttribute(ConditionalOrderEventID, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(ConditionalOrderEventCreator, order.Creator),
+		sdk.NewAttribute(ConditionalOrderEventTokenIn, order.TradePairId.TakerDenom),
+		sdk.NewAttribute(ConditionalOrderEventAmountOut, order.AmountIn.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+// ExecuteConditionalOrderEvent is emitted once a triggered conditional order has been processed.
+// If execution failed the escrowed funds are returned to the creator and execErr is included in the event.
+func ExecuteConditionalOrderEvent(order ConditionalOrder, amountIn, amountOut math.Int, execErr error) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, ExecuteConditionalOrderEventKey),
+		sdk.NewAttribute(ConditionalOrderEventID, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(ConditionalOrderEventCreator, order.Creator),
+		sdk.NewAttribute(ConditionalOrderEventReceiver, order.Receiver),
+		sdk.NewAttribute(ConditionalOrderEventTokenIn, order.TradePairId.TakerDenom),
+		sdk.NewAttribute(ConditionalOrderEventTokenOut, order.TradePairId.MakerDenom),
+		sdk.NewAttribute(ConditionalOrderEventAmountIn, amountIn.String()),
+		sdk.NewAttribute(ConditionalOrderEventAmountOut, amountOut.String()),
+	}
+	if execErr != nil {
+		attrs = append(attrs, sdk.NewAttribute(ConditionalOrderEventError, execErr.Error()))
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func ConditionalOrderHitLimitEvent(gas types.Gas) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(ConditionalOrderHitGasLimitEventGas, strconv.FormatUint(gas, 10)),
+	}
+
+	return sdk.NewEvent(EventTypeConditionalOrderHitGasLimit, attrs...)
+}
+
 func GetEventsWithdrawnAmount(coins sdk.Coins) sdk.Events {
 	events := sdk.Events{}
 	for _, coin := range coins {
@@ -346,3 +449,38 @@ func GetEventsDecTotalPoolReserves(pairID PairID) sdk.Events {
 		),
 	}
 }
+
+func SetPairCircuitBreakerEvent(authority string, breaker PairCircuitBreaker) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, SetPairCircuitBreakerEventKey),
+		sdk.NewAttribute(PairCircuitBreakerEventAuthority, authority),
+		sdk.NewAttribute(PairCircuitBreakerEventToken0, breaker.PairId.Token0),
+		sdk.NewAttribute(PairCircuitBreakerEventToken1, breaker.PairId.Token1),
+		sdk.NewAttribute(PairCircuitBreakerEventPaused, strconv.FormatBool(breaker.Paused)),
+		sdk.NewAttribute(PairCircuitBreakerEventMaxTickDeviation, strconv.FormatUint(breaker.MaxTickDeviation, 10)),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+// RepegLimitOrderEvent is emitted when a pegged limit order is moved to the tick of its oracle price.
+// If re-pricing failed the order is left in place without its peg and execErr is included in the event.
+func RepegLimitOrderEvent(order PeggedLimitOrder, newTrancheKey string, tickIndexInToOut int64, execErr error) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, RepegLimitOrderEventKey),
+		sdk.NewAttribute(RepegLimitOrderEventAddress, order.Address),
+		sdk.NewAttribute(RepegLimitOrderEventTokenIn, order.TrancheKey.TradePairId.MakerDenom),
+		sdk.NewAttribute(RepegLimitOrderEventTokenOut, order.TrancheKey.TradePairId.TakerDenom),
+		sdk.NewAttribute(RepegLimitOrderEventCurrencyPair, order.OraclePeg.CurrencyPair),
+		sdk.NewAttribute(RepegLimitOrderEventOldTrancheKey, order.TrancheKey.TrancheKey),
+		sdk.NewAttribute(RepegLimitOrderEventNewTrancheKey, newTrancheKey),
+		sdk.NewAttribute(RepegLimitOrderEventTickIndex, strconv.FormatInt(tickIndexInToOut, 10)),
+	}
+	if execErr != nil {
+		attrs = append(attrs, sdk.NewAttribute(RepegLimitOrderEventError, execErr.Error()))
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
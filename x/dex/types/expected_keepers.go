@@ -3,7 +3,11 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -15,4 +19,31 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(sdk.Coin) bool)
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// OracleKeeper defines the expected interface needed to price oracle pegged limit orders.
+type OracleKeeper interface {
+	GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (oracletypes.QuotePrice, error)
+	GetDecimalsForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (uint64, error)
+}
+
+// ContractKeeper defines the expected interface needed to call back contracts making flash swaps.
+type ContractKeeper interface {
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+// DexHooks event hooks for dex activity
+type DexHooks interface {
+	// Called after a swap is executed on behalf of trader
+	AfterSwap(ctx context.Context, trader sdk.AccAddress, coinIn, coinOut sdk.Coin) error
+	// Called after liquidity is added to a pool and the pool shares are minted to receiver
+	AfterDeposit(ctx context.Context, receiver sdk.AccAddress, pool *Pool, amount0, amount1 math.Int, sharesIssued sdk.Coin) error
+	// Called after withdrawer's pool shares are burned and liquidity is removed from the pool
+	AfterWithdraw(ctx context.Context, withdrawer sdk.AccAddress, pool *Pool, amount0, amount1 math.Int, sharesRemoved sdk.Coin) error
+	// Called after the maker portion of a limit order owned by receiver is added to a tranche
+	AfterLimitOrderPlaced(ctx context.Context, receiver sdk.AccAddress, tranche *LimitOrderTranche, amountIn math.Int) error
+	// Called after a tranche is (partially) filled by a swap
+	AfterTrancheFilled(ctx context.Context, tranche *LimitOrderTranche, amountIn, amountOut math.Int) error
 }
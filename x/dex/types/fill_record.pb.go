@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/fill_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	cosmossdk_io_math "cosmossdk.io/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FillRecord is the portion of a LimitOrderTrancheUser's order filled in a single block. Records are only
+// written for limit orders placed with record_fills set and are kept for FillRecordHistoryKeepPeriod.
+type FillRecord struct {
+	Address     string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TrancheKey  string       `protobuf:"bytes,2,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	TradePairId *TradePairID `protobuf:"bytes,3,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	Height      int64        `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time        time.Time    `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// Amount of the taker denom received for the fill
+	AmountTakerDenom cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount_taker_denom,json=amountTakerDenom,proto3,customtype=cosmossdk.io/math.Int" json:"amount_taker_denom" yaml:"amount_taker_denom"`
+	// Amount of the maker denom sold in the fill
+	AmountMakerDenom cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=amount_maker_denom,json=amountMakerDenom,proto3,customtype=cosmossdk.io/math.Int" json:"amount_maker_denom" yaml:"amount_maker_denom"`
+}
+
+func (m *FillRecord) Reset()         { *m = FillRecord{} }
+func (m *FillRecord) String() string { return proto.CompactTextString(m) }
+func (*FillRecord) ProtoMessage()    {}
+func (*FillRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b001efd19388eeb9, []int{0}
+}
+func (m *FillRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FillRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FillRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FillRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FillRecord.Merge(m, src)
+}
+func (m *FillRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FillRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FillRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FillRecord proto.InternalMessageInfo
+
+func (m *FillRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FillRecord) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+func (m *FillRecord) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *FillRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FillRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*FillRecord)(nil), "neutron.dex.FillRecord")
+}
+
+func init() { proto.RegisterFile("neutron/dex/fill_record.proto", fileDescriptor_b001efd19388eeb9) }
+
+var fileDescriptor_b001efd19388eeb9 = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x8b, 0xd4, 0x30,
+	0x18, 0xc6, 0x1b, 0x67, 0x9d, 0xd5, 0x14, 0x41, 0x82, 0x4a, 0x1c, 0xb0, 0x1d, 0xe6, 0x34, 0x97,
+	0x4d, 0x40, 0x3d, 0x88, 0x78, 0x1a, 0x16, 0x65, 0x11, 0x61, 0x29, 0x73, 0xf2, 0x52, 0x32, 0x4d,
+	0xb6, 0x0d, 0xd3, 0x34, 0x25, 0x4d, 0x65, 0x7a, 0xf1, 0x33, 0xec, 0xc7, 0xda, 0xe3, 0xde, 0x14,
+	0x0f, 0x55, 0x66, 0x6e, 0x1e, 0xfd, 0x04, 0xd2, 0x7f, 0x4b, 0xc5, 0x45, 0xf0, 0x96, 0xe7, 0x79,
+	0x9f, 0xb7, 0xbf, 0x3e, 0x6d, 0xe0, 0xb3, 0x4c, 0x94, 0xd6, 0xe8, 0x8c, 0x72, 0xb1, 0xa3, 0x17,
+	0x32, 0x4d, 0x43, 0x23, 0x22, 0x6d, 0x38, 0xc9, 0x8d, 0xb6, 0x1a, 0xb9, 0xfd, 0x98, 0x70, 0xb1,
+	0x9b, 0x3d, 0x8a, 0x75, 0xac, 0x5b, 0x9f, 0x36, 0xa7, 0x2e, 0x32, 0xf3, 0x63, 0xad, 0xe3, 0x54,
+	0xd0, 0x56, 0x6d, 0xca, 0x0b, 0x6a, 0xa5, 0x12, 0x85, 0x65, 0x2a, 0x1f, 0x02, 0x63, 0x84, 0x35,
+	0x8c, 0x8b, 0x30, 0x67, 0xd2, 0x84, 0xb2, 0x87, 0x2c, 0xbe, 0x4c, 0x20, 0x7c, 0x2b, 0xd3, 0x34,
+	0x68, 0xc9, 0x08, 0xc3, 0x63, 0xc6, 0xb9, 0x11, 0x45, 0x81, 0xc1, 0x1c, 0x2c, 0xef, 0x07, 0x83,
+	0x44, 0x3e, 0x74, 0xad, 0x61, 0x59, 0x94, 0x88, 0x70, 0x2b, 0x2a, 0x7c, 0xa7, 0x9d, 0xc2, 0xde,
+	0x7a, 0x2f, 0x2a, 0xf4, 0x06, 0x3e, 0xf8, 0x03, 0x80, 0x27, 0x73, 0xb0, 0x74, 0x9f, 0x63, 0x32,
+	0xaa, 0x41, 0xd6, 0x4d, 0xe2, 0x9c, 0x49, 0x73, 0x76, 0x1a, 0xb8, 0xf6, 0x46, 0x70, 0xf4, 0x04,
+	0x4e, 0x13, 0x21, 0xe3, 0xc4, 0xe2, 0xa3, 0x39, 0x58, 0x4e, 0x82, 0x5e, 0xa1, 0x57, 0xf0, 0xa8,
+	0xe9, 0x84, 0xef, 0xb6, 0x0f, 0x9b, 0x91, 0xae, 0x30, 0x19, 0x0a, 0x93, 0xf5, 0x50, 0x78, 0x75,
+	0xef, 0xaa, 0xf6, 0x9d, 0xcb, 0xef, 0x3e, 0x08, 0xda, 0x0d, 0xf4, 0x19, 0x22, 0xa6, 0x74, 0x99,
+	0xd9, 0xd0, 0xb2, 0xad, 0x30, 0x21, 0x17, 0x99, 0x56, 0x78, 0xda, 0xbc, 0xf7, 0xea, 0xbc, 0xc9,
+	0x7e, 0xab, 0xfd, 0xc7, 0x91, 0x2e, 0x94, 0x2e, 0x0a, 0xbe, 0x25, 0x52, 0x53, 0xc5, 0x6c, 0x42,
+	0xce, 0x32, 0xfb, 0xb3, 0xf6, 0x6f, 0x59, 0xfd, 0x55, 0xfb, 0x4f, 0x2b, 0xa6, 0xd2, 0xd7, 0x8b,
+	0xbf, 0x67, 0x8b, 0xe0, 0x61, 0x67, 0xae, 0x1b, 0xef, 0xb4, 0xb1, 0x46, 0x7c, 0x35, 0xe2, 0x1f,
+	0xff, 0x1f, 0x5f, 0xfd, 0x83, 0xaf, 0x6e, 0xe1, 0x7f, 0xb8, 0xe1, 0xaf, 0xde, 0x5d, 0xed, 0x3d,
+	0x70, 0xbd, 0xf7, 0xc0, 0x8f, 0xbd, 0x07, 0x2e, 0x0f, 0x9e, 0x73, 0x7d, 0xf0, 0x9c, 0xaf, 0x07,
+	0xcf, 0xf9, 0x78, 0x12, 0x4b, 0x9b, 0x94, 0x1b, 0x12, 0x69, 0x45, 0xfb, 0x9f, 0x73, 0xa2, 0x4d,
+	0x3c, 0x9c, 0xe9, 0xa7, 0x97, 0x74, 0xd7, 0x5d, 0x98, 0x2a, 0x17, 0xc5, 0x66, 0xda, 0x7e, 0xec,
+	0x17, 0xbf, 0x07, 0x00, 0x6a, 0xa3, 0xbf, 0x8e, 0xaf, 0x02, 0x00, 0x00,
+}
+
+func (m *FillRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FillRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FillRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AmountMakerDenom.Size()
+		i -= size
+		if _, err := m.AmountMakerDenom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFillRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.AmountTakerDenom.Size()
+		i -= size
+		if _, err := m.AmountTakerDenom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFillRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFillRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintFillRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFillRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintFillRecord(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFillRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFillRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFillRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FillRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFillRecord(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovFillRecord(uint64(l))
+	}
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovFillRecord(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFillRecord(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFillRecord(uint64(l))
+	l = m.AmountTakerDenom.Size()
+	n += 1 + l + sovFillRecord(uint64(l))
+	l = m.AmountMakerDenom.Size()
+	n += 1 + l + sovFillRecord(uint64(l))
+	return n
+}
+
+func sovFillRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFillRecord(x uint64) (n int) {
+	return sovFillRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FillRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFillRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FillRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FillRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountTakerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountTakerDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountMakerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountMakerDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFillRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFillRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFillRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFillRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFillRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFillRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFillRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFillRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFillRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFillRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFillRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// FlashSwapCallbackSudoMsg is sent to the sudo entry point of a contract making a flash swap once it has received the
+// output of the swap.
+type FlashSwapCallbackSudoMsg struct {
+	FlashSwapCallback FlashSwapCallbackMsg `json:"flash_swap_callback"`
+}
+
+type FlashSwapCallbackMsg struct {
+	// Amount of token in the contract must hold once the callback returns. It is taken from its balance to repay the swap.
+	CoinIn wasmvmtypes.Coin `json:"coin_in"`
+	// Amount of token out sent to the contract
+	CoinOut wasmvmtypes.Coin `json:"coin_out"`
+	// CallbackMsg of the MsgFlashSwap
+	Msg []byte `json:"msg"`
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -11,6 +13,13 @@ func DefaultGenesis() *GenesisState {
 		TickLiquidityList:             []*TickLiquidity{},
 		InactiveLimitOrderTrancheList: []*LimitOrderTranche{},
 		PoolMetadataList:              []PoolMetadata{},
+		ConditionalOrderList:          []ConditionalOrder{},
+		TwapRecordList:                []TwapRecord{},
+		ProtocolFeeList:               []ProtocolFee{},
+		PairCircuitBreakerList:        []PairCircuitBreaker{},
+		PeggedLimitOrderList:          []PeggedLimitOrder{},
+		FillRecordList:                []FillRecord{},
+		DepositCostBasisList:          []DepositCostBasis{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -68,6 +77,98 @@ func (gs GenesisState) Validate() error {
 		}
 		poolMetadataIDMap[elem.Id] = true
 	}
+	// Check for duplicated ID in conditionalOrder
+	conditionalOrderIDMap := make(map[uint64]bool)
+	conditionalOrderCount := gs.GetConditionalOrderCount()
+	for _, elem := range gs.ConditionalOrderList {
+		if _, ok := conditionalOrderIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for conditionalOrder")
+		}
+		if elem.Id >= conditionalOrderCount {
+			return fmt.Errorf("conditionalOrder id should be lower or equal than the last id")
+		}
+		conditionalOrderIDMap[elem.Id] = true
+	}
+	// Check for duplicated index in twapRecord
+	twapRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TwapRecordList {
+		if elem.TradePairId == nil {
+			return fmt.Errorf("twapRecord is missing tradePairID")
+		}
+		index := string(TwapRecordKey(elem.TradePairId, elem.Time))
+		if _, ok := twapRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for twapRecord")
+		}
+		twapRecordIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in protocolFee
+	protocolFeeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProtocolFeeList {
+		if err := sdk.ValidateDenom(elem.Denom); err != nil {
+			return fmt.Errorf("invalid protocolFee denom: %w", err)
+		}
+		if elem.Accrued.IsNil() || elem.Pending.IsNil() || elem.Pending.GT(elem.Accrued) {
+			return fmt.Errorf("invalid protocolFee amounts for %s", elem.Denom)
+		}
+		if _, ok := protocolFeeIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for protocolFee")
+		}
+		protocolFeeIndexMap[elem.Denom] = struct{}{}
+	}
+	// Check for duplicated index in pairCircuitBreaker
+	pairCircuitBreakerIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PairCircuitBreakerList {
+		if elem.PairId == nil {
+			return fmt.Errorf("pairCircuitBreaker is missing pairID")
+		}
+		index := string(PairCircuitBreakerKey(elem.PairId))
+		if _, ok := pairCircuitBreakerIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pairCircuitBreaker")
+		}
+		pairCircuitBreakerIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in peggedLimitOrder
+	peggedLimitOrderIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PeggedLimitOrderList {
+		if elem.TrancheKey == nil || elem.OraclePeg == nil {
+			return fmt.Errorf("peggedLimitOrder is missing trancheKey or oraclePeg")
+		}
+		if err := elem.OraclePeg.Validate(); err != nil {
+			return fmt.Errorf("invalid peggedLimitOrder: %w", err)
+		}
+		index := string(PeggedLimitOrderKey(elem.TrancheKey.TrancheKey))
+		if _, ok := peggedLimitOrderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for peggedLimitOrder")
+		}
+		peggedLimitOrderIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in fillRecord
+	fillRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FillRecordList {
+		if elem.TradePairId == nil {
+			return fmt.Errorf("fillRecord is missing tradePairID")
+		}
+		if elem.AmountTakerDenom.IsNil() || elem.AmountMakerDenom.IsNil() {
+			return fmt.Errorf("invalid fillRecord amounts for %s", elem.Address)
+		}
+		index := string(FillRecordKey(elem.Address, elem.TrancheKey, elem.Height))
+		if _, ok := fillRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for fillRecord")
+		}
+		fillRecordIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in depositCostBasis
+	depositCostBasisIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DepositCostBasisList {
+		if elem.Shares.IsNil() || elem.Amount0.IsNil() || elem.Amount1.IsNil() {
+			return fmt.Errorf("invalid depositCostBasis amounts for %s", elem.Address)
+		}
+		index := string(DepositCostBasisKey(elem.Address, elem.PoolId))
+		if _, ok := depositCostBasisIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for depositCostBasis")
+		}
+		depositCostBasisIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -32,6 +32,14 @@ type GenesisState struct {
 	LimitOrderTrancheUserList     []*LimitOrderTrancheUser `protobuf:"bytes,4,rep,name=limit_order_tranche_user_list,json=limitOrderTrancheUserList,proto3" json:"limit_order_tranche_user_list,omitempty"`
 	PoolMetadataList              []PoolMetadata           `protobuf:"bytes,5,rep,name=pool_metadata_list,json=poolMetadataList,proto3" json:"pool_metadata_list"`
 	PoolCount                     uint64                   `protobuf:"varint,6,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	ConditionalOrderList          []ConditionalOrder       `protobuf:"bytes,7,rep,name=conditional_order_list,json=conditionalOrderList,proto3" json:"conditional_order_list"`
+	ConditionalOrderCount         uint64                   `protobuf:"varint,8,opt,name=conditional_order_count,json=conditionalOrderCount,proto3" json:"conditional_order_count,omitempty"`
+	TwapRecordList                []TwapRecord             `protobuf:"bytes,9,rep,name=twap_record_list,json=twapRecordList,proto3" json:"twap_record_list"`
+	ProtocolFeeList               []ProtocolFee            `protobuf:"bytes,10,rep,name=protocol_fee_list,json=protocolFeeList,proto3" json:"protocol_fee_list"`
+	PairCircuitBreakerList        []PairCircuitBreaker     `protobuf:"bytes,11,rep,name=pair_circuit_breaker_list,json=pairCircuitBreakerList,proto3" json:"pair_circuit_breaker_list"`
+	PeggedLimitOrderList          []PeggedLimitOrder       `protobuf:"bytes,12,rep,name=pegged_limit_order_list,json=peggedLimitOrderList,proto3" json:"pegged_limit_order_list"`
+	FillRecordList                []FillRecord             `protobuf:"bytes,13,rep,name=fill_record_list,json=fillRecordList,proto3" json:"fill_record_list"`
+	DepositCostBasisList          []DepositCostBasis       `protobuf:"bytes,14,rep,name=deposit_cost_basis_list,json=depositCostBasisList,proto3" json:"deposit_cost_basis_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +117,62 @@ func (m *GenesisState) GetPoolCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetConditionalOrderList() []ConditionalOrder {
+	if m != nil {
+		return m.ConditionalOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConditionalOrderCount() uint64 {
+	if m != nil {
+		return m.ConditionalOrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTwapRecordList() []TwapRecord {
+	if m != nil {
+		return m.TwapRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProtocolFeeList() []ProtocolFee {
+	if m != nil {
+		return m.ProtocolFeeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPairCircuitBreakerList() []PairCircuitBreaker {
+	if m != nil {
+		return m.PairCircuitBreakerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeggedLimitOrderList() []PeggedLimitOrder {
+	if m != nil {
+		return m.PeggedLimitOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFillRecordList() []FillRecord {
+	if m != nil {
+		return m.FillRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDepositCostBasisList() []DepositCostBasis {
+	if m != nil {
+		return m.DepositCostBasisList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.dex.GenesisState")
 }
@@ -116,33 +180,50 @@ func init() {
 func init() { proto.RegisterFile("neutron/dex/genesis.proto", fileDescriptor_0c051a8a0d58cd8b) }
 
 var fileDescriptor_0c051a8a0d58cd8b = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0xae, 0xda, 0x30,
-	0x14, 0x86, 0x93, 0x42, 0x91, 0x6a, 0x3a, 0xb4, 0xa1, 0x43, 0x88, 0x44, 0x48, 0x91, 0x2a, 0xa1,
-	0x4a, 0x24, 0x2a, 0xed, 0x13, 0xd0, 0x81, 0x05, 0x54, 0x44, 0xe9, 0xd2, 0x25, 0x32, 0x89, 0x15,
-	0x5c, 0x92, 0x38, 0x75, 0x4e, 0x10, 0xbc, 0x45, 0x1f, 0x8b, 0x91, 0xb1, 0x4b, 0xab, 0x2b, 0x78,
-	0x91, 0xab, 0xd8, 0x46, 0x4a, 0x74, 0xb9, 0xf7, 0x6e, 0xd6, 0x39, 0x9f, 0xff, 0xcf, 0x3e, 0x36,
-	0xea, 0xa6, 0xa4, 0x00, 0xce, 0x52, 0x2f, 0x24, 0x7b, 0x2f, 0x22, 0x29, 0xc9, 0x69, 0xee, 0x66,
-	0x9c, 0x01, 0x33, 0xda, 0xaa, 0xe5, 0x86, 0x64, 0x6f, 0xbd, 0x8b, 0x58, 0xc4, 0x44, 0xdd, 0x2b,
-	0x57, 0x12, 0xb1, 0x3e, 0x54, 0x77, 0xc7, 0x34, 0xa1, 0xe0, 0x33, 0x1e, 0x12, 0xee, 0x03, 0xc7,
-	0x69, 0xb0, 0x21, 0x0a, 0xfb, 0xf8, 0x0c, 0xe6, 0x17, 0x39, 0xe1, 0x8a, 0x35, 0xab, 0x6c, 0x86,
-	0x39, 0x4e, 0xd4, 0x79, 0xac, 0x7e, 0xad, 0xc3, 0x58, 0xec, 0x27, 0x04, 0x70, 0x88, 0x01, 0x2b,
-	0xc0, 0xa9, 0x02, 0x40, 0x83, 0xad, 0x1f, 0xd3, 0xdf, 0x05, 0x0d, 0x29, 0x1c, 0x24, 0x31, 0xf8,
-	0xd7, 0x40, 0xaf, 0xa7, 0xf2, 0x92, 0xdf, 0x01, 0x03, 0x31, 0x3e, 0xa1, 0x96, 0x74, 0x98, 0xba,
-	0xa3, 0x0f, 0xdb, 0xe3, 0x8e, 0x5b, 0xb9, 0xb4, 0xbb, 0x10, 0xad, 0x49, 0xf3, 0xf8, 0xbf, 0xaf,
-	0x2d, 0x15, 0x68, 0x2c, 0x50, 0xa7, 0x9e, 0xed, 0xc7, 0x34, 0x07, 0xf3, 0x85, 0xd3, 0x18, 0xb6,
-	0xc7, 0x56, 0x6d, 0xff, 0x8a, 0x06, 0xdb, 0xd9, 0x15, 0x13, 0x31, 0xfa, 0xf2, 0x2d, 0x54, 0x8b,
-	0x33, 0x9a, 0x83, 0x91, 0xa2, 0xf7, 0x34, 0xc5, 0x01, 0xd0, 0x1d, 0xf1, 0x6f, 0x4d, 0x47, 0xe4,
-	0x37, 0x44, 0xbe, 0x5d, 0xcb, 0x9f, 0x95, 0xf0, 0xb7, 0x92, 0x5d, 0x49, 0x54, 0x39, 0x7a, 0xd7,
-	0xb8, 0x07, 0x80, 0xf0, 0xfd, 0x42, 0xbd, 0xc7, 0x1e, 0x41, 0xba, 0x9a, 0xc2, 0x35, 0x78, 0xda,
-	0xf5, 0x23, 0x27, 0x5c, 0xf9, 0xba, 0xf1, 0xad, 0xa6, 0x70, 0xcd, 0x91, 0x51, 0x7b, 0x2a, 0x29,
-	0x78, 0x29, 0x04, 0xdd, 0xfa, 0xb0, 0x19, 0x8b, 0xe7, 0x8a, 0x52, 0x23, 0x7f, 0x93, 0x55, 0x6a,
-	0x22, 0xae, 0x87, 0x90, 0x88, 0x0b, 0x58, 0x91, 0x82, 0xd9, 0x72, 0xf4, 0x61, 0x73, 0xf9, 0xaa,
-	0xac, 0x7c, 0x2d, 0x0b, 0x93, 0xe9, 0xf1, 0x6c, 0xeb, 0xa7, 0xb3, 0xad, 0xdf, 0x9d, 0x6d, 0xfd,
-	0xcf, 0xc5, 0xd6, 0x4e, 0x17, 0x5b, 0xfb, 0x7b, 0xb1, 0xb5, 0x9f, 0xa3, 0x88, 0xc2, 0xa6, 0x58,
-	0xbb, 0x01, 0x4b, 0x3c, 0x65, 0x1d, 0x31, 0x1e, 0x5d, 0xd7, 0xde, 0xee, 0x8b, 0xb7, 0x97, 0xff,
-	0xe6, 0x90, 0x91, 0x7c, 0xdd, 0x12, 0xff, 0xe5, 0xf3, 0x7d, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2,
-	0x99, 0xcb, 0x87, 0x1f, 0x03, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5f, 0x4f, 0xdb, 0x3c,
+	0x14, 0xc6, 0x9b, 0x17, 0x5e, 0x5e, 0x70, 0x79, 0x19, 0x14, 0x06, 0x6d, 0xa5, 0x86, 0x8e, 0xfd,
+	0x11, 0x9a, 0x44, 0xab, 0xb1, 0x69, 0x1f, 0xa0, 0x9d, 0xa8, 0x34, 0x81, 0x86, 0x3a, 0x76, 0x31,
+	0x6e, 0x3c, 0xd7, 0x31, 0xc1, 0x23, 0x8d, 0x33, 0xc7, 0xe5, 0xcf, 0xb7, 0xd8, 0xc7, 0xe2, 0x92,
+	0xcb, 0x5d, 0x4d, 0x13, 0xdc, 0xec, 0x63, 0x4c, 0x39, 0x76, 0x8a, 0xdd, 0x66, 0xdb, 0x5d, 0x74,
+	0xce, 0x2f, 0xcf, 0xf3, 0xe4, 0x9c, 0xd8, 0xa8, 0x16, 0xb3, 0x91, 0x92, 0x22, 0x6e, 0x07, 0xec,
+	0xb2, 0x1d, 0xb2, 0x98, 0xa5, 0x3c, 0x6d, 0x25, 0x52, 0x28, 0x51, 0x29, 0x9b, 0x56, 0x2b, 0x60,
+	0x97, 0xf5, 0xb5, 0x50, 0x84, 0x02, 0xea, 0xed, 0xec, 0x49, 0x23, 0xf5, 0xc7, 0xf6, 0xdb, 0x54,
+	0xc4, 0x01, 0x57, 0x5c, 0xc4, 0x24, 0xc2, 0x42, 0x06, 0x4c, 0x1a, 0xe8, 0x89, 0x0d, 0x05, 0x2c,
+	0x11, 0x29, 0x57, 0x98, 0x8a, 0x54, 0xe1, 0x01, 0x19, 0xbb, 0xd5, 0x1b, 0x36, 0x75, 0xc2, 0xa3,
+	0x08, 0x4b, 0x46, 0x85, 0x0c, 0x4c, 0xfb, 0xa9, 0xdd, 0x8e, 0xf8, 0x90, 0x2b, 0xed, 0x81, 0x95,
+	0x24, 0x31, 0x3d, 0x65, 0x06, 0x7b, 0xfe, 0x17, 0x0c, 0x8f, 0xd2, 0x71, 0xae, 0x67, 0x36, 0x9b,
+	0x10, 0x2e, 0x31, 0xe5, 0x92, 0x8e, 0xb8, 0xc2, 0x03, 0xc9, 0xc8, 0xd9, 0x98, 0xab, 0xba, 0x9c,
+	0x24, 0xc3, 0xb4, 0xe8, 0xcb, 0x12, 0x16, 0x86, 0x2c, 0xc0, 0x96, 0xa9, 0xa1, 0x36, 0x1d, 0x4a,
+	0x88, 0x08, 0x0f, 0x99, 0x22, 0x01, 0x51, 0xc4, 0x00, 0xbe, 0x03, 0x64, 0x25, 0x2a, 0x22, 0x7c,
+	0xc2, 0xf2, 0x8f, 0x6a, 0xda, 0x7d, 0xc5, 0xe9, 0x19, 0x8e, 0xf8, 0x97, 0x11, 0x0f, 0xb8, 0xba,
+	0x2a, 0x1a, 0x9e, 0xba, 0x20, 0x89, 0x33, 0xbc, 0xad, 0x9f, 0xf3, 0x68, 0xb1, 0xa7, 0x77, 0xfb,
+	0x5e, 0x11, 0xc5, 0x2a, 0x2f, 0xd0, 0x9c, 0xfe, 0x90, 0xaa, 0xd7, 0xf4, 0xb6, 0xcb, 0xbb, 0xab,
+	0x2d, 0x6b, 0xd7, 0xad, 0x43, 0x68, 0x75, 0x66, 0xaf, 0xbf, 0x6f, 0x96, 0xfa, 0x06, 0xac, 0x1c,
+	0xa2, 0x55, 0xd7, 0x1a, 0x47, 0x3c, 0x55, 0xd5, 0x7f, 0x9a, 0x33, 0xdb, 0xe5, 0xdd, 0xba, 0xf3,
+	0xfe, 0x11, 0xa7, 0x67, 0xfb, 0x39, 0x06, 0x32, 0x5e, 0x7f, 0x45, 0xd9, 0xc5, 0x7d, 0x9e, 0xaa,
+	0x4a, 0x8c, 0x1e, 0xf1, 0x98, 0x50, 0xc5, 0xcf, 0x19, 0x2e, 0x5a, 0x15, 0xe8, 0xcf, 0x80, 0xbe,
+	0xef, 0xe8, 0xef, 0x67, 0xf0, 0xbb, 0x8c, 0x3d, 0xd2, 0xa8, 0xf1, 0x68, 0xe4, 0x72, 0x53, 0x00,
+	0xf8, 0x7d, 0x46, 0x8d, 0xdf, 0xfd, 0x11, 0xda, 0x6b, 0x16, 0xbc, 0xb6, 0xfe, 0xec, 0xf5, 0x21,
+	0x65, 0xd2, 0xf8, 0xd5, 0xa2, 0xa2, 0x26, 0x78, 0x1d, 0xa0, 0x8a, 0xb3, 0x69, 0x6d, 0xf0, 0x2f,
+	0x18, 0xd4, 0xdc, 0x61, 0x0b, 0x11, 0x1d, 0x18, 0xca, 0x8c, 0x7c, 0x39, 0xb1, 0x6a, 0x20, 0xd7,
+	0x40, 0x08, 0xe4, 0xa8, 0x18, 0xc5, 0xaa, 0x3a, 0xd7, 0xf4, 0xb6, 0x67, 0xfb, 0x0b, 0x59, 0xa5,
+	0x9b, 0x15, 0x2a, 0x1f, 0xd1, 0xfa, 0xd4, 0xe1, 0xd3, 0x8e, 0xff, 0x81, 0x63, 0xc3, 0x71, 0xec,
+	0xde, 0xa3, 0x90, 0xdd, 0xb8, 0xae, 0xd1, 0x89, 0x3a, 0x38, 0xbf, 0x46, 0x1b, 0xd3, 0xd2, 0x3a,
+	0xc6, 0x3c, 0xc4, 0x78, 0x38, 0xf9, 0x9a, 0x8e, 0xd4, 0x43, 0xcb, 0xd6, 0x7f, 0xa8, 0xc3, 0x2c,
+	0x40, 0x98, 0x0d, 0xf7, 0x5f, 0xb9, 0x20, 0x49, 0x1f, 0x18, 0x13, 0x63, 0x49, 0x8d, 0x2b, 0x10,
+	0xe0, 0x2d, 0x5a, 0xb1, 0x8f, 0x84, 0x56, 0x42, 0xa0, 0x54, 0x75, 0x07, 0x69, 0xa8, 0x3d, 0xc6,
+	0x8c, 0xd4, 0x83, 0xe4, 0xbe, 0x04, 0x5a, 0x9f, 0x50, 0xad, 0xe8, 0x9c, 0x6b, 0xcd, 0x32, 0x68,
+	0x6e, 0x4e, 0x9c, 0x04, 0x2e, 0xbb, 0x1a, 0xee, 0x68, 0xd6, 0x48, 0xaf, 0x27, 0x53, 0x1d, 0x70,
+	0x38, 0x46, 0x1b, 0xd3, 0xf7, 0x80, 0xd6, 0x5f, 0x2c, 0x58, 0xc5, 0x21, 0xb0, 0xf7, 0xff, 0x58,
+	0xbe, 0x8a, 0x64, 0xa2, 0x0e, 0xda, 0x3d, 0xb4, 0x6c, 0xdd, 0x8b, 0x5a, 0xf4, 0xff, 0x82, 0x91,
+	0xee, 0xf1, 0x28, 0x72, 0x47, 0x7a, 0x32, 0xae, 0xe4, 0x21, 0xa7, 0xaf, 0x61, 0xad, 0xb7, 0x54,
+	0x10, 0xf2, 0x8d, 0x66, 0xbb, 0x22, 0x55, 0x9d, 0x8c, 0xcc, 0x43, 0x06, 0x13, 0xf5, 0x4c, 0xbb,
+	0xd3, 0xbb, 0xbe, 0xf5, 0xbd, 0x9b, 0x5b, 0xdf, 0xfb, 0x71, 0xeb, 0x7b, 0x5f, 0xef, 0xfc, 0xd2,
+	0xcd, 0x9d, 0x5f, 0xfa, 0x76, 0xe7, 0x97, 0x8e, 0x77, 0x42, 0xae, 0x4e, 0x47, 0x83, 0x16, 0x15,
+	0xc3, 0xb6, 0x91, 0xdf, 0x11, 0x32, 0xcc, 0x9f, 0xdb, 0xe7, 0xaf, 0xda, 0x97, 0xfa, 0xfe, 0xba,
+	0x4a, 0x58, 0x3a, 0x98, 0x83, 0xe5, 0xbd, 0xfc, 0x35, 0x00, 0xe4, 0xd1, 0xcf, 0x41, 0xa1, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -165,6 +246,109 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DepositCostBasisList) > 0 {
+		for iNdEx := len(m.DepositCostBasisList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCostBasisList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FillRecordList) > 0 {
+		for iNdEx := len(m.FillRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FillRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PeggedLimitOrderList) > 0 {
+		for iNdEx := len(m.PeggedLimitOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeggedLimitOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PairCircuitBreakerList) > 0 {
+		for iNdEx := len(m.PairCircuitBreakerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairCircuitBreakerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ProtocolFeeList) > 0 {
+		for iNdEx := len(m.ProtocolFeeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFeeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TwapRecordList) > 0 {
+		for iNdEx := len(m.TwapRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ConditionalOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConditionalOrderCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ConditionalOrderList) > 0 {
+		for iNdEx := len(m.ConditionalOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.PoolCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
 		i--
@@ -285,6 +469,51 @@ func (m *GenesisState) Size() (n int) {
 	if m.PoolCount != 0 {
 		n += 1 + sovGenesis(uint64(m.PoolCount))
 	}
+	if len(m.ConditionalOrderList) > 0 {
+		for _, e := range m.ConditionalOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ConditionalOrderCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ConditionalOrderCount))
+	}
+	if len(m.TwapRecordList) > 0 {
+		for _, e := range m.TwapRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProtocolFeeList) > 0 {
+		for _, e := range m.ProtocolFeeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairCircuitBreakerList) > 0 {
+		for _, e := range m.PairCircuitBreakerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeggedLimitOrderList) > 0 {
+		for _, e := range m.PeggedLimitOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FillRecordList) > 0 {
+		for _, e := range m.FillRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositCostBasisList) > 0 {
+		for _, e := range m.DepositCostBasisList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -511,6 +740,263 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalOrderList = append(m.ConditionalOrderList, ConditionalOrder{})
+			if err := m.ConditionalOrderList[len(m.ConditionalOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderCount", wireType)
+			}
+			m.ConditionalOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecordList = append(m.TwapRecordList, TwapRecord{})
+			if err := m.TwapRecordList[len(m.TwapRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeList = append(m.ProtocolFeeList, ProtocolFee{})
+			if err := m.ProtocolFeeList[len(m.ProtocolFeeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairCircuitBreakerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairCircuitBreakerList = append(m.PairCircuitBreakerList, PairCircuitBreaker{})
+			if err := m.PairCircuitBreakerList[len(m.PairCircuitBreakerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeggedLimitOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeggedLimitOrderList = append(m.PeggedLimitOrderList, PeggedLimitOrder{})
+			if err := m.PeggedLimitOrderList[len(m.PeggedLimitOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FillRecordList = append(m.FillRecordList, FillRecord{})
+			if err := m.FillRecordList[len(m.FillRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCostBasisList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCostBasisList = append(m.DepositCostBasisList, DepositCostBasis{})
+			if err := m.DepositCostBasisList[len(m.DepositCostBasisList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
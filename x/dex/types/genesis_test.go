@@ -2,7 +2,10 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/neutron-org/neutron/v4/x/dex/types"
@@ -83,6 +86,101 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				PoolCount: 2,
+				ConditionalOrderList: []types.ConditionalOrder{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				ConditionalOrderCount: 2,
+				TwapRecordList: []types.TwapRecord{
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						Time:        time.Unix(0, 0),
+					},
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						Time:        time.Unix(1, 0),
+					},
+				},
+				ProtocolFeeList: []types.ProtocolFee{
+					{
+						Denom:   "TokenA",
+						Accrued: math.NewInt(10),
+						Pending: math.NewInt(5),
+					},
+					{
+						Denom:   "TokenB",
+						Accrued: math.NewInt(10),
+						Pending: math.ZeroInt(),
+					},
+				},
+				PairCircuitBreakerList: []types.PairCircuitBreaker{
+					{
+						PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"},
+						Paused: true,
+					},
+					{
+						PairId:           &types.PairID{Token0: "TokenA", Token1: "TokenC"},
+						MaxTickDeviation: 100,
+					},
+				},
+				PeggedLimitOrderList: []types.PeggedLimitOrder{
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey: &types.LimitOrderTrancheKey{
+							TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+							TickIndexTakerToMaker: 0,
+							TrancheKey:            "0",
+						},
+						OraclePeg: &types.OraclePeg{CurrencyPair: "TOKENB/TOKENA"},
+					},
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey: &types.LimitOrderTrancheKey{
+							TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+							TickIndexTakerToMaker: 0,
+							TrancheKey:            "1",
+						},
+						OraclePeg: &types.OraclePeg{CurrencyPair: "TOKENB/TOKENA", OffsetBps: 100},
+					},
+				},
+				FillRecordList: []types.FillRecord{
+					{
+						Address:          "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey:       "0",
+						TradePairId:      &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+						Height:           1,
+						AmountTakerDenom: math.NewInt(1),
+						AmountMakerDenom: math.NewInt(1),
+					},
+					{
+						Address:          "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey:       "0",
+						TradePairId:      &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+						Height:           2,
+						AmountTakerDenom: math.NewInt(1),
+						AmountMakerDenom: math.NewInt(1),
+					},
+				},
+				DepositCostBasisList: []types.DepositCostBasis{
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						PoolId:  0,
+						Shares:  math.NewInt(10),
+						Amount0: math.NewInt(5),
+						Amount1: math.NewInt(5),
+					},
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						PoolId:  1,
+						Shares:  math.NewInt(10),
+						Amount0: math.NewInt(10),
+						Amount1: math.ZeroInt(),
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -183,6 +281,214 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated conditionalOrder",
+			genState: &types.GenesisState{
+				ConditionalOrderList: []types.ConditionalOrder{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				ConditionalOrderCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid conditionalOrderCount",
+			genState: &types.GenesisState{
+				ConditionalOrderList: []types.ConditionalOrder{
+					{
+						Id: 1,
+					},
+				},
+				ConditionalOrderCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated twapRecord",
+			genState: &types.GenesisState{
+				TwapRecordList: []types.TwapRecord{
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						Time:        time.Unix(0, 0),
+					},
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						Time:        time.Unix(0, 0),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated protocolFee",
+			genState: &types.GenesisState{
+				ProtocolFeeList: []types.ProtocolFee{
+					{
+						Denom:   "TokenA",
+						Accrued: math.NewInt(10),
+						Pending: math.ZeroInt(),
+					},
+					{
+						Denom:   "TokenA",
+						Accrued: math.NewInt(10),
+						Pending: math.ZeroInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "protocolFee pending exceeds accrued",
+			genState: &types.GenesisState{
+				ProtocolFeeList: []types.ProtocolFee{
+					{
+						Denom:   "TokenA",
+						Accrued: math.NewInt(5),
+						Pending: math.NewInt(10),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pairCircuitBreaker",
+			genState: &types.GenesisState{
+				PairCircuitBreakerList: []types.PairCircuitBreaker{
+					{
+						PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"},
+						Paused: true,
+					},
+					{
+						PairId:           &types.PairID{Token0: "TokenA", Token1: "TokenB"},
+						MaxTickDeviation: 100,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pairCircuitBreaker without pair",
+			genState: &types.GenesisState{
+				PairCircuitBreakerList: []types.PairCircuitBreaker{
+					{
+						Paused: true,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated peggedLimitOrder",
+			genState: &types.GenesisState{
+				PeggedLimitOrderList: []types.PeggedLimitOrder{
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey: &types.LimitOrderTrancheKey{
+							TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+							TickIndexTakerToMaker: 0,
+							TrancheKey:            "0",
+						},
+						OraclePeg: &types.OraclePeg{CurrencyPair: "TOKENB/TOKENA"},
+					},
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey: &types.LimitOrderTrancheKey{
+							TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+							TickIndexTakerToMaker: 0,
+							TrancheKey:            "0",
+						},
+						OraclePeg: &types.OraclePeg{CurrencyPair: "TOKENB/TOKENA"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "peggedLimitOrder with invalid peg",
+			genState: &types.GenesisState{
+				PeggedLimitOrderList: []types.PeggedLimitOrder{
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey: &types.LimitOrderTrancheKey{
+							TradePairId:           &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+							TickIndexTakerToMaker: 0,
+							TrancheKey:            "0",
+						},
+						OraclePeg: &types.OraclePeg{CurrencyPair: "TOKENB"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated fillRecord",
+			genState: &types.GenesisState{
+				FillRecordList: []types.FillRecord{
+					{
+						Address:          "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey:       "0",
+						TradePairId:      &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+						Height:           1,
+						AmountTakerDenom: math.NewInt(1),
+						AmountMakerDenom: math.NewInt(1),
+					},
+					{
+						Address:          "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						TrancheKey:       "0",
+						TradePairId:      &types.TradePairID{TakerDenom: "TokenA", MakerDenom: "TokenB"},
+						Height:           1,
+						AmountTakerDenom: math.NewInt(2),
+						AmountMakerDenom: math.NewInt(2),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated depositCostBasis",
+			genState: &types.GenesisState{
+				DepositCostBasisList: []types.DepositCostBasis{
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						PoolId:  0,
+						Shares:  math.NewInt(10),
+						Amount0: math.NewInt(5),
+						Amount1: math.NewInt(5),
+					},
+					{
+						Address: "cosmos1ats6dxtlu2l5dkjfwj3x6jlstgftgaycs3m3jm",
+						PoolId:  0,
+						Shares:  math.NewInt(1),
+						Amount0: math.NewInt(1),
+						Amount1: math.NewInt(1),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid min deposit sizes",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MinDepositSizes: sdk.Coins{sdk.Coin{Denom: "TokenA", Amount: math.NewInt(-1)}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid fee recommendation window",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					FeeRecommendationWindow: 49 * 60 * 60,
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple dex hooks, all hook functions are run in array sequence
+var _ DexHooks = &MultiDexHooks{}
+
+type MultiDexHooks []DexHooks
+
+func NewMultiDexHooks(hooks ...DexHooks) MultiDexHooks {
+	return hooks
+}
+
+func (h MultiDexHooks) AfterSwap(ctx context.Context, trader sdk.AccAddress, coinIn, coinOut sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterSwap(ctx, trader, coinIn, coinOut); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiDexHooks) AfterDeposit(
+	ctx context.Context,
+	receiver sdk.AccAddress,
+	pool *Pool,
+	amount0, amount1 math.Int,
+	sharesIssued sdk.Coin,
+) error {
+	for i := range h {
+		if err := h[i].AfterDeposit(ctx, receiver, pool, amount0, amount1, sharesIssued); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiDexHooks) AfterWithdraw(
+	ctx context.Context,
+	withdrawer sdk.AccAddress,
+	pool *Pool,
+	amount0, amount1 math.Int,
+	sharesRemoved sdk.Coin,
+) error {
+	for i := range h {
+		if err := h[i].AfterWithdraw(ctx, withdrawer, pool, amount0, amount1, sharesRemoved); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiDexHooks) AfterLimitOrderPlaced(
+	ctx context.Context,
+	receiver sdk.AccAddress,
+	tranche *LimitOrderTranche,
+	amountIn math.Int,
+) error {
+	for i := range h {
+		if err := h[i].AfterLimitOrderPlaced(ctx, receiver, tranche, amountIn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiDexHooks) AfterTrancheFilled(
+	ctx context.Context,
+	tranche *LimitOrderTranche,
+	amountIn, amountOut math.Int,
+) error {
+	for i := range h {
+		if err := h[i].AfterTrancheFilled(ctx, tranche, amountIn, amountOut); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -56,6 +56,60 @@ const (
 
 	// JITPerBlock is the key to retrieve the number of JIT limit orders place in a single block
 	JITsInBlockKey = "JITsInBlock/count/"
+
+	// ConditionalOrderKeyPrefix is the prefix to retrieve all ConditionalOrders
+	ConditionalOrderKeyPrefix = "ConditionalOrder/value/"
+
+	// ConditionalOrderCountKey is the key to retrieve the ConditionalOrder count
+	ConditionalOrderCountKey = "ConditionalOrder/count/"
+
+	// ConditionalOrderTriggerKeyPrefix is the prefix of the ConditionalOrder index sorted by trigger tick
+	ConditionalOrderTriggerKeyPrefix = "ConditionalOrderTrigger/value/"
+
+	// TwapRecordKeyPrefix is the prefix to retrieve all TwapRecords
+	TwapRecordKeyPrefix = "TwapRecord/value/"
+
+	// ProtocolFeeKeyPrefix is the prefix to retrieve all ProtocolFees
+	ProtocolFeeKeyPrefix = "ProtocolFee/value/"
+
+	// PairCircuitBreakerKeyPrefix is the prefix to retrieve all PairCircuitBreakers
+	PairCircuitBreakerKeyPrefix = "PairCircuitBreaker/value/"
+
+	// OpeningTickKeyPrefix is the transient store prefix of the ticks recorded at the start of the block
+	OpeningTickKeyPrefix = "OpeningTick/value/"
+
+	// PeggedLimitOrderKeyPrefix is the prefix to retrieve all PeggedLimitOrders
+	PeggedLimitOrderKeyPrefix = "PeggedLimitOrder/value/"
+
+	// PeggedLimitOrderRepegCursorKey is the key of the PeggedLimitOrder that the last re-pricing scan stopped at
+	PeggedLimitOrderRepegCursorKey = "PeggedLimitOrderRepegCursor/value/"
+
+	// FillRecordKeyPrefix is the prefix to retrieve all FillRecords
+	FillRecordKeyPrefix = "FillRecord/value/"
+
+	// FillRecordTimeKeyPrefix is the prefix of the FillRecord index sorted by time, used for pruning
+	FillRecordTimeKeyPrefix = "FillRecordTime/value/"
+
+	// FillRecordSubscriberKeyPrefix is the prefix of the addresses recording fills in each tranche
+	FillRecordSubscriberKeyPrefix = "FillRecordSubscriber/value/"
+
+	// FillRecordSubscriberCountKeyPrefix is the prefix of the number of addresses recording fills in each tranche
+	FillRecordSubscriberCountKeyPrefix = "FillRecordSubscriberCount/value/"
+
+	// FillRecordPruneCursorKey is the key of the FillRecord time index entry that the last pruning stopped at
+	FillRecordPruneCursorKey = "FillRecordPruneCursor/value/"
+
+	// PairRefCountKeyPrefix is the prefix to retrieve the number of pools and limit order tranches of all PairIDs
+	PairRefCountKeyPrefix = "PairRefCount/value/"
+
+	// PairByDenomKeyPrefix is the prefix of the PairID index by denom
+	PairByDenomKeyPrefix = "PairByDenom/value/"
+
+	// PoolByDenomKeyPrefix is the prefix of the PoolID index by denom
+	PoolByDenomKeyPrefix = "PoolByDenom/value/"
+
+	// DepositCostBasisKeyPrefix is the prefix to retrieve all DepositCostBases
+	DepositCostBasisKeyPrefix = "DepositCostBasis/value/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -175,6 +229,53 @@ func LimitOrderExpirationKey(
 	return key
 }
 
+// ConditionalOrderTriggerPrefix returns the prefix for all ConditionalOrders with the same
+// TradePairID and trigger, ordered by their trigger tick
+func ConditionalOrderTriggerPrefix(tradePairID *TradePairID, trigger ConditionalOrderTrigger) []byte {
+	key := KeyPrefix(ConditionalOrderTriggerKeyPrefix)
+	key = append(key, KeyPrefix(tradePairID.MustPairID().CanonicalString())...)
+	key = append(key, KeyPrefix(tradePairID.MakerDenom)...)
+	key = append(key, KeyPrefix(trigger.String())...)
+
+	return key
+}
+
+func ConditionalOrderTriggerKey(
+	tradePairID *TradePairID,
+	trigger ConditionalOrderTrigger,
+	triggerTickIndex int64,
+	id uint64,
+) []byte {
+	key := ConditionalOrderTriggerPrefix(tradePairID, trigger)
+
+	tickIndexBytes := TickIndexToBytes(triggerTickIndex)
+	key = append(key, tickIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	idBytes := sdk.Uint64ToBigEndian(id)
+	key = append(key, idBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// TwapRecordPrefix returns the prefix for all TwapRecords of a TradePairID, ordered by time
+func TwapRecordPrefix(tradePairID *TradePairID) []byte {
+	key := KeyPrefix(TwapRecordKeyPrefix)
+	key = append(key, KeyPrefix(tradePairID.MustPairID().CanonicalString())...)
+	key = append(key, KeyPrefix(tradePairID.MakerDenom)...)
+
+	return key
+}
+
+func TwapRecordKey(tradePairID *TradePairID, recordTime time.Time) []byte {
+	key := TwapRecordPrefix(tradePairID)
+	key = append(key, sdk.FormatTimeBytes(recordTime)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
 func PoolIDKey(
 	pairID *PairID,
 	tickIndex int64,
@@ -194,6 +295,53 @@ func PoolIDKey(
 	return key
 }
 
+func PairRefCountKey(pairID *PairID) []byte {
+	key := KeyPrefix(PairRefCountKeyPrefix)
+	key = append(key, KeyPrefix(pairID.CanonicalString())...)
+
+	return key
+}
+
+// PairByDenomPrefix returns the prefix for all PairIDs trading denom
+func PairByDenomPrefix(denom string) []byte {
+	key := KeyPrefix(PairByDenomKeyPrefix)
+	key = append(key, KeyPrefix(denom)...)
+
+	return key
+}
+
+func PairByDenomKey(denom string, pairID *PairID) []byte {
+	key := PairByDenomPrefix(denom)
+	key = append(key, KeyPrefix(pairID.CanonicalString())...)
+
+	return key
+}
+
+// PoolByDenomPrefix returns the prefix for the IDs of all pools holding denom
+func PoolByDenomPrefix(denom string) []byte {
+	key := KeyPrefix(PoolByDenomKeyPrefix)
+	key = append(key, KeyPrefix(denom)...)
+
+	return key
+}
+
+func PoolByDenomKey(denom string, poolID uint64) []byte {
+	key := PoolByDenomPrefix(denom)
+	key = append(key, sdk.Uint64ToBigEndian(poolID)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func DepositCostBasisKey(address string, poolID uint64) []byte {
+	key := KeyPrefix(DepositCostBasisKeyPrefix)
+	key = append(key, KeyPrefix(address)...)
+	key = append(key, sdk.Uint64ToBigEndian(poolID)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
 // Deposit Event Attributes
 const (
 	DepositEventKey                = "DepositLP"
@@ -293,6 +441,50 @@ const (
 	GoodTilPurgeHitGasLimitEventGas  = "Gas"
 )
 
+// Conditional Order Event Attributes
+const (
+	PlaceConditionalOrderEventKey        = "PlaceConditionalOrder"
+	CancelConditionalOrderEventKey       = "CancelConditionalOrder"
+	ExecuteConditionalOrderEventKey      = "ExecuteConditionalOrder"
+	ConditionalOrderEventID              = "ID"
+	ConditionalOrderEventCreator         = "Creator"
+	ConditionalOrderEventReceiver        = "Receiver"
+	ConditionalOrderEventTokenIn         = "TokenIn"
+	ConditionalOrderEventTokenOut        = "TokenOut"
+	ConditionalOrderEventAmountIn        = "AmountIn"
+	ConditionalOrderEventAmountOut       = "AmountOut"
+	ConditionalOrderEventOrderType       = "OrderType"
+	ConditionalOrderEventTrigger         = "Trigger"
+	ConditionalOrderEventTriggerTick     = "TriggerTick"
+	ConditionalOrderEventLimitTick       = "LimitTick"
+	ConditionalOrderEventError           = "Error"
+	EventTypeConditionalOrderHitGasLimit = "ConditionalOrderHitGasLimit"
+	ConditionalOrderHitGasLimitEventGas  = "Gas"
+)
+
+// Pair Circuit Breaker Event Attributes
+const (
+	SetPairCircuitBreakerEventKey           = "SetPairCircuitBreaker"
+	PairCircuitBreakerEventAuthority        = "Authority"
+	PairCircuitBreakerEventToken0           = "TokenZero"
+	PairCircuitBreakerEventToken1           = "TokenOne"
+	PairCircuitBreakerEventPaused           = "Paused"
+	PairCircuitBreakerEventMaxTickDeviation = "MaxTickDeviation"
+)
+
+// Pegged Limit Order Event Attributes
+const (
+	RepegLimitOrderEventKey           = "RepegLimitOrder"
+	RepegLimitOrderEventAddress       = "Address"
+	RepegLimitOrderEventTokenIn       = "TokenIn"
+	RepegLimitOrderEventTokenOut      = "TokenOut"
+	RepegLimitOrderEventCurrencyPair  = "CurrencyPair"
+	RepegLimitOrderEventOldTrancheKey = "OldTrancheKey"
+	RepegLimitOrderEventNewTrancheKey = "NewTrancheKey"
+	RepegLimitOrderEventTickIndex     = "TickIndex"
+	RepegLimitOrderEventError         = "Error"
+)
+
 const (
 	// NOTE: have to add letter so that LP deposits are indexed ahead of LimitOrders
 	LiquidityTypePoolReserves = "A_PoolDeposit"
@@ -305,4 +497,91 @@ func JITGoodTilTime() time.Time {
 
 const (
 	ExpiringLimitOrderGas = 10_000
+	ConditionalOrderGas   = 10_000
 )
+
+func ProtocolFeeKey(denom string) []byte {
+	key := KeyPrefix(ProtocolFeeKeyPrefix)
+	key = append(key, KeyPrefix(denom)...)
+
+	return key
+}
+
+func PairCircuitBreakerKey(pairID *PairID) []byte {
+	key := KeyPrefix(PairCircuitBreakerKeyPrefix)
+	key = append(key, KeyPrefix(pairID.CanonicalString())...)
+
+	return key
+}
+
+func OpeningTickKey(tradePairID *TradePairID) []byte {
+	key := KeyPrefix(OpeningTickKeyPrefix)
+	key = append(key, KeyPrefix(tradePairID.MustPairID().CanonicalString())...)
+	key = append(key, KeyPrefix(tradePairID.MakerDenom)...)
+
+	return key
+}
+
+func PeggedLimitOrderKey(trancheKey string) []byte {
+	key := KeyPrefix(PeggedLimitOrderKeyPrefix)
+	key = append(key, KeyPrefix(trancheKey)...)
+
+	return key
+}
+
+// FillRecordAddressPrefix returns the prefix for all FillRecords of an address, ordered by tranche key
+func FillRecordAddressPrefix(address string) []byte {
+	key := KeyPrefix(FillRecordKeyPrefix)
+	key = append(key, KeyPrefix(address)...)
+
+	return key
+}
+
+// FillRecordTrancheUserPrefix returns the prefix for all FillRecords of an address in a tranche, ordered by height
+func FillRecordTrancheUserPrefix(address, trancheKey string) []byte {
+	key := FillRecordAddressPrefix(address)
+	key = append(key, KeyPrefix(trancheKey)...)
+
+	return key
+}
+
+func FillRecordKey(address, trancheKey string, height int64) []byte {
+	key := FillRecordTrancheUserPrefix(address, trancheKey)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// FillRecordTimeKey returns the key of a FillRecord in the time index. fillRecordKey is appended so that records
+// with the same time have distinct keys.
+func FillRecordTimeKey(recordTime time.Time, fillRecordKey []byte) []byte {
+	key := KeyPrefix(FillRecordTimeKeyPrefix)
+	key = append(key, sdk.FormatTimeBytes(recordTime)...)
+	key = append(key, []byte("/")...)
+	key = append(key, fillRecordKey...)
+
+	return key
+}
+
+// FillRecordSubscriberPrefix returns the prefix for all addresses recording fills in a tranche
+func FillRecordSubscriberPrefix(trancheKey string) []byte {
+	key := KeyPrefix(FillRecordSubscriberKeyPrefix)
+	key = append(key, KeyPrefix(trancheKey)...)
+
+	return key
+}
+
+func FillRecordSubscriberKey(trancheKey, address string) []byte {
+	key := FillRecordSubscriberPrefix(trancheKey)
+	key = append(key, KeyPrefix(address)...)
+
+	return key
+}
+
+func FillRecordSubscriberCountKey(trancheKey string) []byte {
+	key := KeyPrefix(FillRecordSubscriberCountKeyPrefix)
+	key = append(key, KeyPrefix(trancheKey)...)
+
+	return key
+}
@@ -131,14 +131,19 @@ func (t LimitOrderTranche) HasLiquidity() bool {
 	return t.ReservesMakerDenom.GT(math.ZeroInt())
 }
 
-func (t *LimitOrderTranche) RemoveTokenIn(
-	trancheUser *LimitOrderTrancheUser,
-) (amountToRemove math.Int) {
+func (t *LimitOrderTranche) CalcRemoveTokenInAmount(trancheUser *LimitOrderTrancheUser) (amountToRemove math.Int) {
 	amountUnfilled := t.AmountUnfilled()
 	maxAmountToRemove := amountUnfilled.MulInt(trancheUser.SharesOwned).
 		QuoInt(t.TotalMakerDenom).
 		TruncateInt()
-	amountToRemove = maxAmountToRemove.Sub(trancheUser.SharesCancelled)
+
+	return maxAmountToRemove.Sub(trancheUser.SharesCancelled)
+}
+
+func (t *LimitOrderTranche) RemoveTokenIn(
+	trancheUser *LimitOrderTrancheUser,
+) (amountToRemove math.Int) {
+	amountToRemove = t.CalcRemoveTokenInAmount(trancheUser)
 	t.ReservesMakerDenom = t.ReservesMakerDenom.Sub(amountToRemove)
 
 	return amountToRemove
@@ -102,6 +102,8 @@ type LimitOrderTranche struct {
 	// Order deletion still functions the same and the orders will be deleted at the end of the block
 	ExpirationTime    *time.Time                                           `protobuf:"bytes,6,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
 	PriceTakerToMaker github_com_neutron_org_neutron_v4_utils_math.PrecDec `protobuf:"bytes,7,opt,name=price_taker_to_maker,json=priceTakerToMaker,proto3,customtype=github.com/neutron-org/neutron/v4/utils/math.PrecDec" json:"price_taker_to_maker" yaml:"price_taker_to_maker"`
+	// True if any LimitOrderTrancheUser of the tranche records its fills
+	RecordFills bool `protobuf:"varint,8,opt,name=record_fills,json=recordFills,proto3" json:"record_fills,omitempty"`
 }
 
 func (m *LimitOrderTranche) Reset()         { *m = LimitOrderTranche{} }
@@ -151,6 +153,13 @@ func (m *LimitOrderTranche) GetExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *LimitOrderTranche) GetRecordFills() bool {
+	if m != nil {
+		return m.RecordFills
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LimitOrderTrancheKey)(nil), "neutron.dex.LimitOrderTrancheKey")
 	proto.RegisterType((*LimitOrderTranche)(nil), "neutron.dex.LimitOrderTranche")
@@ -161,43 +170,44 @@ func init() {
 }
 
 var fileDescriptor_8c2ded67c80756d1 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x8f, 0xd2, 0x40,
-	0x14, 0xc7, 0x19, 0x51, 0x74, 0x87, 0xe8, 0x86, 0x86, 0x4d, 0xba, 0x98, 0xb4, 0xd8, 0xc4, 0x84,
-	0xcb, 0xb6, 0x89, 0xbb, 0x07, 0x63, 0x3c, 0x11, 0x12, 0x43, 0x14, 0xdd, 0x34, 0x3d, 0x79, 0x69,
-	0x4a, 0x3b, 0x96, 0x09, 0xb4, 0xd3, 0x4c, 0x1f, 0x1b, 0xf0, 0x03, 0x78, 0xde, 0x0f, 0xe1, 0xdd,
-	0xaf, 0xc1, 0x71, 0x8f, 0xc6, 0x43, 0x35, 0x70, 0xf3, 0xb8, 0x9f, 0xc0, 0xcc, 0x14, 0x96, 0x22,
-	0xd5, 0xcd, 0x9e, 0x98, 0xbe, 0xf7, 0x7f, 0xbc, 0xdf, 0xff, 0x75, 0x5e, 0xf1, 0xf3, 0x98, 0x4c,
-	0x81, 0xb3, 0xd8, 0x0a, 0xc8, 0xcc, 0x9a, 0xd0, 0x88, 0x82, 0xcb, 0x78, 0x40, 0xb8, 0x0b, 0xdc,
-	0x8b, 0xfd, 0x11, 0x31, 0x13, 0xce, 0x80, 0x29, 0xf5, 0xb5, 0xcc, 0x0c, 0xc8, 0xac, 0xd5, 0x0c,
-	0x59, 0xc8, 0x64, 0xdc, 0x12, 0xa7, 0x5c, 0xd2, 0xd2, 0x43, 0xc6, 0xc2, 0x09, 0xb1, 0xe4, 0xd3,
-	0x70, 0xfa, 0xc9, 0x02, 0x1a, 0x91, 0x14, 0xbc, 0x28, 0x59, 0x0b, 0x8e, 0x8b, 0xad, 0x12, 0x8f,
-	0x72, 0x97, 0x06, 0x9b, 0xda, 0x62, 0x0a, 0xb8, 0x17, 0x10, 0x77, 0x47, 0x60, 0x7c, 0x43, 0xb8,
-	0xf9, 0x4e, 0xd0, 0x7d, 0x10, 0x70, 0x4e, 0xce, 0xf6, 0x96, 0xcc, 0x95, 0xd7, 0xf8, 0xf1, 0x8e,
-	0x5e, 0x45, 0x6d, 0xd4, 0xa9, 0xbf, 0x50, 0xcd, 0x02, 0xb0, 0xe9, 0x08, 0xc5, 0xb9, 0x47, 0x79,
-	0xbf, 0x67, 0xd7, 0xe1, 0xe6, 0x21, 0x50, 0x5e, 0xe2, 0x63, 0xa0, 0xfe, 0xd8, 0xa5, 0x71, 0x40,
-	0x66, 0x2e, 0x78, 0x63, 0x61, 0x9c, 0xb9, 0x91, 0x38, 0xa8, 0xf7, 0xda, 0xa8, 0x53, 0xb5, 0x8f,
-	0x84, 0xa0, 0x2f, 0xf2, 0x8e, 0x88, 0x3a, 0x6c, 0x20, 0x7e, 0x14, 0x1d, 0xd7, 0xd7, 0x13, 0x72,
-	0xc7, 0x64, 0xae, 0x56, 0xdb, 0xa8, 0x73, 0x60, 0x63, 0xb8, 0x01, 0x33, 0x16, 0x35, 0xdc, 0xd8,
-	0x23, 0x56, 0x4e, 0x71, 0x55, 0xc8, 0x73, 0xc8, 0x67, 0x3b, 0x90, 0x65, 0xf6, 0x6c, 0xa1, 0x56,
-	0xbe, 0x20, 0xdc, 0xe4, 0x24, 0x25, 0xfc, 0x82, 0xa4, 0x39, 0x9b, 0x1b, 0x90, 0x98, 0x45, 0x92,
-	0xf0, 0xa0, 0xeb, 0x2c, 0x32, 0xbd, 0xf2, 0x23, 0xd3, 0x8f, 0x7c, 0x96, 0x46, 0x2c, 0x4d, 0x83,
-	0xb1, 0x49, 0x99, 0x15, 0x79, 0x30, 0x32, 0xfb, 0x31, 0xfc, 0xce, 0xf4, 0xd2, 0xe2, 0xeb, 0x4c,
-	0x7f, 0x3a, 0xf7, 0xa2, 0xc9, 0x2b, 0xa3, 0x2c, 0x6b, 0xd8, 0xca, 0x26, 0x2c, 0xfd, 0xf6, 0x44,
-	0x70, 0x17, 0x04, 0x0a, 0x20, 0xd5, 0xbb, 0x82, 0xc0, 0x7f, 0x41, 0xa0, 0x14, 0xc4, 0xd9, 0x82,
-	0x7c, 0xc6, 0x0d, 0x60, 0xe0, 0x4d, 0x76, 0xa6, 0x71, 0x5f, 0x42, 0xbc, 0xbf, 0x0d, 0x62, 0xbf,
-	0xf2, 0x3a, 0xd3, 0xd5, 0x9c, 0x60, 0x2f, 0x65, 0xd8, 0x87, 0x32, 0x36, 0x28, 0xe9, 0x5d, 0x1c,
-	0xc0, 0x83, 0x3b, 0xf5, 0x86, 0x7f, 0xf7, 0x86, 0xfd, 0xde, 0x05, 0xdf, 0x03, 0x7c, 0x48, 0x66,
-	0x09, 0xe5, 0x1e, 0x50, 0x16, 0xbb, 0x62, 0xc1, 0xd4, 0x9a, 0xbc, 0x4a, 0x2d, 0x33, 0xdf, 0x3e,
-	0x73, 0xb3, 0x7d, 0xa6, 0xb3, 0xd9, 0xbe, 0xee, 0xa3, 0x45, 0xa6, 0xa3, 0xcb, 0x9f, 0x3a, 0xb2,
-	0x9f, 0x6c, 0x8b, 0x45, 0x5a, 0xf9, 0x8a, 0x70, 0x33, 0xe1, 0xd4, 0x27, 0x7f, 0x5f, 0xfd, 0x87,
-	0xd2, 0x4e, 0xba, 0xb6, 0x73, 0x16, 0x52, 0x18, 0x4d, 0x87, 0xa6, 0xcf, 0x22, 0x6b, 0x7d, 0x63,
-	0x4f, 0x18, 0x0f, 0x37, 0x67, 0xeb, 0xe2, 0xcc, 0x9a, 0x02, 0x9d, 0xa4, 0xb9, 0xd3, 0x73, 0x4e,
-	0xfc, 0x1e, 0xf1, 0xc5, 0xeb, 0x2e, 0xfb, 0xef, 0xed, 0xeb, 0x2e, 0xcb, 0x1a, 0x76, 0x43, 0x86,
-	0x8b, 0xbb, 0xd6, 0x7d, 0xb3, 0x58, 0x6a, 0xe8, 0x6a, 0xa9, 0xa1, 0x5f, 0x4b, 0x0d, 0x5d, 0xae,
-	0xb4, 0xca, 0xd5, 0x4a, 0xab, 0x7c, 0x5f, 0x69, 0x95, 0x8f, 0x27, 0xb7, 0x93, 0xcd, 0xf2, 0x6f,
-	0xca, 0x3c, 0x21, 0xe9, 0xb0, 0x26, 0xa7, 0x73, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x75, 0x97,
-	0x0b, 0x47, 0xf5, 0x04, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x04, 0x4a, 0xbb, 0x01, 0xaa, 0x5a, 0xa9, 0xe4, 0x16, 0xc9, 0x4e, 0x2d, 0x21,
+	0xe5, 0x52, 0x5b, 0xa2, 0x3d, 0x20, 0xc4, 0x29, 0x8a, 0x40, 0x11, 0x04, 0x2a, 0xcb, 0x27, 0x2e,
+	0x96, 0x63, 0x6f, 0x9d, 0x55, 0x6c, 0xaf, 0xb5, 0x9e, 0x54, 0x09, 0x0f, 0xc0, 0xb9, 0x0f, 0xc1,
+	0x9d, 0xd7, 0xc8, 0xb1, 0x47, 0xc4, 0xc1, 0xa0, 0xe4, 0x06, 0xb7, 0x3e, 0x01, 0x5a, 0x3b, 0x69,
+	0x6c, 0x62, 0xa8, 0x7a, 0xca, 0xfa, 0x9f, 0x7f, 0x32, 0xdf, 0x8c, 0x77, 0x8c, 0x9f, 0x45, 0x64,
+	0x0c, 0x9c, 0x45, 0x86, 0x47, 0x26, 0x46, 0x40, 0x43, 0x0a, 0x36, 0xe3, 0x1e, 0xe1, 0x36, 0x70,
+	0x27, 0x72, 0x87, 0x44, 0x8f, 0x39, 0x03, 0x26, 0x35, 0x96, 0x36, 0xdd, 0x23, 0x93, 0xc3, 0xa6,
+	0xcf, 0x7c, 0x96, 0xe9, 0x86, 0x38, 0xe5, 0x96, 0x43, 0xd5, 0x67, 0xcc, 0x0f, 0x88, 0x91, 0x3d,
+	0x0d, 0xc6, 0xe7, 0x06, 0xd0, 0x90, 0x24, 0xe0, 0x84, 0xf1, 0xd2, 0x70, 0x50, 0x2c, 0x15, 0x3b,
+	0x94, 0xdb, 0xd4, 0x5b, 0xe5, 0x16, 0x43, 0xc0, 0x1d, 0x8f, 0xd8, 0x25, 0x83, 0xf6, 0x15, 0xe1,
+	0xe6, 0x3b, 0x41, 0xf7, 0x41, 0xc0, 0x59, 0x39, 0xdb, 0x5b, 0x32, 0x95, 0x5e, 0xe1, 0xc7, 0x25,
+	0xbf, 0x8c, 0x5a, 0xa8, 0xdd, 0x78, 0x2e, 0xeb, 0x05, 0x60, 0xdd, 0x12, 0x8e, 0x33, 0x87, 0xf2,
+	0x5e, 0xd7, 0x6c, 0xc0, 0xcd, 0x83, 0x27, 0xbd, 0xc0, 0x07, 0x40, 0xdd, 0x91, 0x4d, 0x23, 0x8f,
+	0x4c, 0x6c, 0x70, 0x46, 0xa2, 0x71, 0x66, 0x87, 0xe2, 0x20, 0xdf, 0x6b, 0xa1, 0x76, 0xdd, 0xdc,
+	0x17, 0x86, 0x9e, 0x88, 0x5b, 0x42, 0xb5, 0x58, 0x5f, 0xfc, 0x48, 0x2a, 0x6e, 0x2c, 0x27, 0x64,
+	0x8f, 0xc8, 0x54, 0xae, 0xb7, 0x50, 0x7b, 0xc7, 0xc4, 0x70, 0x03, 0xa6, 0xfd, 0xde, 0xc2, 0x7b,
+	0x1b, 0xc4, 0xd2, 0x09, 0xae, 0x0b, 0x7b, 0x0e, 0x79, 0x54, 0x82, 0xac, 0x6a, 0xcf, 0x14, 0x6e,
+	0xe9, 0x33, 0xc2, 0x4d, 0x4e, 0x12, 0xc2, 0x2f, 0x48, 0x92, 0xb3, 0xd9, 0x1e, 0x89, 0x58, 0x98,
+	0x11, 0xee, 0x74, 0xac, 0x59, 0xaa, 0xd6, 0xbe, 0xa7, 0xea, 0xbe, 0xcb, 0x92, 0x90, 0x25, 0x89,
+	0x37, 0xd2, 0x29, 0x33, 0x42, 0x07, 0x86, 0x7a, 0x2f, 0x82, 0x5f, 0xa9, 0x5a, 0x99, 0x7c, 0x9d,
+	0xaa, 0x4f, 0xa7, 0x4e, 0x18, 0xbc, 0xd4, 0xaa, 0xa2, 0x9a, 0x29, 0xad, 0xe4, 0xac, 0xdf, 0xae,
+	0x10, 0xcb, 0x20, 0x50, 0x00, 0xa9, 0xdf, 0x15, 0x04, 0xfe, 0x0b, 0x02, 0x95, 0x20, 0xd6, 0x1a,
+	0xe4, 0x13, 0xde, 0x03, 0x06, 0x4e, 0x50, 0x9a, 0xc6, 0xfd, 0x0c, 0xe2, 0xfd, 0x6d, 0x10, 0x9b,
+	0x99, 0xd7, 0xa9, 0x2a, 0xe7, 0x04, 0x1b, 0x21, 0xcd, 0xdc, 0xcd, 0xb4, 0x7e, 0x45, 0xed, 0xe2,
+	0x00, 0x1e, 0xdc, 0xa9, 0x36, 0xfc, 0xbb, 0x36, 0x6c, 0xd6, 0x2e, 0xf4, 0xdd, 0xc7, 0xbb, 0x64,
+	0x12, 0x53, 0xee, 0x00, 0x65, 0x91, 0x2d, 0x16, 0x4c, 0xde, 0xca, 0xae, 0xd2, 0xa1, 0x9e, 0x6f,
+	0x9f, 0xbe, 0xda, 0x3e, 0xdd, 0x5a, 0x6d, 0x5f, 0x67, 0x7b, 0x96, 0xaa, 0xe8, 0xf2, 0x87, 0x8a,
+	0xcc, 0x27, 0xeb, 0x64, 0x11, 0x96, 0xbe, 0x20, 0xdc, 0x8c, 0x39, 0x75, 0xc9, 0xdf, 0x57, 0xff,
+	0x61, 0xd6, 0x4e, 0xb2, 0x6c, 0xe7, 0xd4, 0xa7, 0x30, 0x1c, 0x0f, 0x74, 0x97, 0x85, 0xc6, 0xf2,
+	0xc6, 0x1e, 0x33, 0xee, 0xaf, 0xce, 0xc6, 0xc5, 0xa9, 0x31, 0x06, 0x1a, 0x24, 0x79, 0xa7, 0x67,
+	0x9c, 0xb8, 0x5d, 0xe2, 0x8a, 0xd7, 0x5d, 0xf5, 0xdf, 0xeb, 0xd7, 0x5d, 0x15, 0xd5, 0xcc, 0xbd,
+	0x4c, 0x2e, 0xed, 0xda, 0x11, 0x7e, 0xc4, 0x89, 0xcb, 0xb8, 0x67, 0x9f, 0xd3, 0x20, 0x48, 0xe4,
+	0xed, 0x16, 0x6a, 0x6f, 0x9b, 0x8d, 0x5c, 0x7b, 0x2d, 0xa4, 0xce, 0x9b, 0xd9, 0x5c, 0x41, 0x57,
+	0x73, 0x05, 0xfd, 0x9c, 0x2b, 0xe8, 0x72, 0xa1, 0xd4, 0xae, 0x16, 0x4a, 0xed, 0xdb, 0x42, 0xa9,
+	0x7d, 0x3c, 0xbe, 0x1d, 0x7e, 0x92, 0x7f, 0x76, 0xa6, 0x31, 0x49, 0x06, 0x5b, 0xd9, 0x00, 0x4f,
+	0xfe, 0x0c, 0x00, 0x53, 0xeb, 0xe9, 0x9f, 0x18, 0x05, 0x00, 0x00,
 }
 
 func (m *LimitOrderTrancheKey) Marshal() (dAtA []byte, err error) {
@@ -267,6 +277,16 @@ func (m *LimitOrderTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecordFills {
+		i--
+		if m.RecordFills {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.PriceTakerToMaker.Size()
 		i -= size
@@ -397,6 +417,9 @@ func (m *LimitOrderTranche) Size() (n int) {
 	}
 	l = m.PriceTakerToMaker.Size()
 	n += 1 + l + sovLimitOrderTranche(uint64(l))
+	if m.RecordFills {
+		n += 2
+	}
 	return n
 }
 
@@ -814,6 +837,26 @@ func (m *LimitOrderTranche) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordFills", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderTranche
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordFills = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLimitOrderTranche(dAtA[iNdEx:])
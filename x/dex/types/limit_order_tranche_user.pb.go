@@ -34,6 +34,8 @@ type LimitOrderTrancheUser struct {
 	SharesWithdrawn       cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=shares_withdrawn,json=sharesWithdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"shares_withdrawn" yaml:"shares_withdrawn"`
 	SharesCancelled       cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=shares_cancelled,json=sharesCancelled,proto3,customtype=cosmossdk.io/math.Int" json:"shares_cancelled" yaml:"shares_cancelled"`
 	OrderType             LimitOrderType        `protobuf:"varint,8,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	// If true, fills of the user's order are written to the FillRecord store
+	RecordFills bool `protobuf:"varint,9,opt,name=record_fills,json=recordFills,proto3" json:"record_fills,omitempty"`
 }
 
 func (m *LimitOrderTrancheUser) Reset()         { *m = LimitOrderTrancheUser{} }
@@ -104,6 +106,13 @@ func (m *LimitOrderTrancheUser) GetOrderType() LimitOrderType {
 	return LimitOrderType_GOOD_TIL_CANCELLED
 }
 
+func (m *LimitOrderTrancheUser) GetRecordFills() bool {
+	if m != nil {
+		return m.RecordFills
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LimitOrderTrancheUser)(nil), "neutron.dex.LimitOrderTrancheUser")
 }
@@ -113,37 +122,38 @@ func init() {
 }
 
 var fileDescriptor_67e5ffbd487ea05f = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x6b, 0xdb, 0x4e,
-	0x10, 0xc6, 0xad, 0x7f, 0xf2, 0x4f, 0x9a, 0x55, 0xdf, 0x50, 0x63, 0xba, 0x4d, 0x41, 0x32, 0x3e,
-	0x99, 0x42, 0x24, 0x48, 0x7b, 0x28, 0xa1, 0xa7, 0x34, 0x50, 0x4c, 0x53, 0x52, 0x84, 0x4b, 0xa1,
-	0x17, 0xb1, 0xd1, 0x0e, 0xd2, 0x62, 0x4b, 0x2b, 0x76, 0xd7, 0xb5, 0xf4, 0x2d, 0xfa, 0xb1, 0x72,
-	0xcc, 0xb1, 0xf4, 0x20, 0x5a, 0xfb, 0xd6, 0x63, 0x3e, 0x41, 0x59, 0xeb, 0x05, 0x89, 0x1c, 0x4a,
-	0x4f, 0x9a, 0x79, 0x9e, 0x67, 0xe6, 0x27, 0x89, 0x41, 0x2f, 0x52, 0x58, 0x2a, 0xc1, 0x53, 0x8f,
-	0x42, 0xee, 0x2d, 0x58, 0xc2, 0x54, 0xc0, 0x05, 0x05, 0x11, 0x28, 0x41, 0xd2, 0x30, 0x86, 0x60,
-	0x29, 0x41, 0xb8, 0x99, 0xe0, 0x8a, 0x5b, 0x66, 0x9d, 0x75, 0x29, 0xe4, 0x47, 0x87, 0x11, 0x8f,
-	0xf8, 0x56, 0xf7, 0x74, 0x55, 0x45, 0x8e, 0x9c, 0xee, 0x3a, 0x25, 0x08, 0x85, 0x20, 0x23, 0x4c,
-	0x04, 0x8c, 0xd6, 0x81, 0xc3, 0x5e, 0x20, 0xaf, 0xd4, 0xf1, 0xaf, 0x5d, 0x34, 0xbc, 0xd0, 0xf0,
-	0x4b, 0xcd, 0x9e, 0x55, 0xe8, 0x4f, 0x12, 0x84, 0xf5, 0x06, 0x3d, 0xe8, 0xad, 0xc1, 0xc6, 0xc8,
-	0x98, 0x98, 0x27, 0xd8, 0xed, 0xbc, 0x8b, 0x3b, 0xd3, 0x89, 0x8f, 0x84, 0x89, 0xe9, 0xb9, 0x6f,
-	0xaa, 0xb6, 0xa1, 0xd6, 0x6b, 0xf4, 0x4c, 0xb1, 0x70, 0x1e, 0xb0, 0x94, 0x42, 0x1e, 0x28, 0x32,
-	0xd7, 0x1f, 0xc6, 0x83, 0x44, 0x17, 0xf8, 0xbf, 0x91, 0x31, 0xd9, 0xf1, 0x87, 0x3a, 0x30, 0xd5,
-	0xfe, 0x4c, 0xab, 0x33, 0xfe, 0x41, 0x3f, 0x2c, 0x07, 0x99, 0xcd, 0x1f, 0x98, 0x43, 0x81, 0x77,
-	0x46, 0xc6, 0xe4, 0xc0, 0x47, 0xb5, 0xf4, 0x1e, 0x0a, 0x0b, 0xa3, 0x7d, 0x42, 0xa9, 0x00, 0x29,
-	0xf1, 0xee, 0xd6, 0x6c, 0x5a, 0x2b, 0x42, 0xf7, 0x65, 0x4c, 0x04, 0xc8, 0x80, 0xaf, 0x52, 0xa0,
-	0xf8, 0x7f, 0x6d, 0x9f, 0x9d, 0x5f, 0x97, 0xce, 0xe0, 0x47, 0xe9, 0x0c, 0x43, 0x2e, 0x13, 0x2e,
-	0x25, 0x9d, 0xbb, 0x8c, 0x7b, 0x09, 0x51, 0xb1, 0x3b, 0x4d, 0xd5, 0xef, 0xd2, 0xe9, 0x0d, 0xdd,
-	0x96, 0xce, 0x93, 0x82, 0x24, 0x8b, 0xd3, 0x71, 0x57, 0x1d, 0xfb, 0x66, 0xd5, 0x5e, 0xea, 0xce,
-	0x5a, 0xa1, 0xc7, 0xb5, 0xbb, 0x62, 0x2a, 0xa6, 0x82, 0xac, 0x52, 0xbc, 0xb7, 0x85, 0x5d, 0xfc,
-	0x0d, 0x76, 0x67, 0xf0, 0xb6, 0x74, 0x9e, 0xf6, 0x80, 0xad, 0x33, 0xf6, 0x1f, 0x55, 0xd2, 0xe7,
-	0x46, 0xe9, 0x80, 0x43, 0x92, 0x86, 0xb0, 0x58, 0x00, 0xc5, 0xfb, 0xff, 0x06, 0x6e, 0x07, 0xef,
-	0x80, 0x5b, 0xa7, 0x05, 0xbf, 0x6d, 0x14, 0xeb, 0x14, 0xa1, 0xfa, 0x3a, 0x8b, 0x0c, 0xf0, 0xbd,
-	0x91, 0x31, 0x79, 0x78, 0xf2, 0xbc, 0x77, 0x0a, 0x9d, 0x2b, 0x2a, 0x32, 0xf0, 0x0f, 0x78, 0x53,
-	0x9e, 0xbd, 0xbb, 0x5e, 0xdb, 0xc6, 0xcd, 0xda, 0x36, 0x7e, 0xae, 0x6d, 0xe3, 0xdb, 0xc6, 0x1e,
-	0xdc, 0x6c, 0xec, 0xc1, 0xf7, 0x8d, 0x3d, 0xf8, 0x72, 0x1c, 0x31, 0x15, 0x2f, 0xaf, 0xdc, 0x90,
-	0x27, 0x5e, 0xbd, 0xeb, 0x98, 0x8b, 0xa8, 0xa9, 0xbd, 0xaf, 0xaf, 0xbc, 0xbc, 0xba, 0xd7, 0x22,
-	0x03, 0x79, 0xb5, 0xb7, 0xbd, 0xd9, 0x97, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x41, 0xf7,
-	0x93, 0x3b, 0x03, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc6, 0x1b, 0xd7, 0x7d, 0xe9, 0x64, 0x7d, 0x21, 0x6e, 0x71, 0x5c, 0x21, 0xa9, 0x3d, 0x15,
+	0x61, 0x13, 0x58, 0x3d, 0xc8, 0xe2, 0x69, 0x5d, 0x94, 0xe2, 0xca, 0x4a, 0xa8, 0x08, 0x5e, 0x86,
+	0xd9, 0xcc, 0xdf, 0x76, 0x68, 0x92, 0x29, 0x33, 0x53, 0xdb, 0xdc, 0xfd, 0x00, 0x7e, 0xac, 0x3d,
+	0xee, 0x51, 0x3c, 0x14, 0x69, 0x6f, 0x1e, 0xf7, 0x13, 0xc8, 0x34, 0x2f, 0x24, 0xec, 0x41, 0x3c,
+	0xe5, 0xff, 0x7f, 0x9e, 0x67, 0xe6, 0x97, 0x84, 0x07, 0x3d, 0x4f, 0x61, 0xa6, 0xa5, 0x48, 0x03,
+	0x06, 0x8b, 0x20, 0xe6, 0x09, 0xd7, 0x44, 0x48, 0x06, 0x92, 0x68, 0x49, 0xd3, 0x68, 0x0c, 0x64,
+	0xa6, 0x40, 0xfa, 0x53, 0x29, 0xb4, 0x70, 0xec, 0x22, 0xeb, 0x33, 0x58, 0x1c, 0x1e, 0x8c, 0xc4,
+	0x48, 0x6c, 0xf4, 0xc0, 0x4c, 0x79, 0xe4, 0xd0, 0xab, 0x5f, 0xa7, 0x25, 0x65, 0x40, 0xa6, 0x94,
+	0x4b, 0xc2, 0x59, 0x11, 0x38, 0x68, 0x04, 0x16, 0xb9, 0xda, 0xfb, 0xbe, 0x8d, 0x3a, 0xe7, 0x06,
+	0x7e, 0x61, 0xd8, 0xc3, 0x1c, 0xfd, 0x49, 0x81, 0x74, 0x5e, 0xa3, 0x7b, 0x8d, 0x6b, 0xb0, 0xd5,
+	0xb5, 0xfa, 0xf6, 0x31, 0xf6, 0x6b, 0xef, 0xe2, 0x0f, 0x4d, 0xe2, 0x23, 0xe5, 0x72, 0x70, 0x16,
+	0xda, 0xba, 0x5a, 0x98, 0xf3, 0x0a, 0x3d, 0xd1, 0x3c, 0x9a, 0x10, 0x9e, 0x32, 0x58, 0x10, 0x4d,
+	0x27, 0xe6, 0xc3, 0x04, 0x49, 0xcc, 0x80, 0xef, 0x74, 0xad, 0xfe, 0x56, 0xd8, 0x31, 0x81, 0x81,
+	0xf1, 0x87, 0x46, 0x1d, 0x8a, 0x0f, 0xe6, 0xe1, 0x78, 0xc8, 0x2e, 0xff, 0xc0, 0x04, 0x32, 0xbc,
+	0xd5, 0xb5, 0xfa, 0xed, 0x10, 0x15, 0xd2, 0x7b, 0xc8, 0x1c, 0x8c, 0x76, 0x29, 0x63, 0x12, 0x94,
+	0xc2, 0x77, 0x37, 0x66, 0xb9, 0x3a, 0x23, 0xb4, 0xaf, 0xc6, 0x54, 0x82, 0x22, 0x62, 0x9e, 0x02,
+	0xc3, 0xdb, 0xc6, 0x3e, 0x3d, 0xbb, 0x5a, 0x7a, 0xad, 0x5f, 0x4b, 0xaf, 0x13, 0x09, 0x95, 0x08,
+	0xa5, 0xd8, 0xc4, 0xe7, 0x22, 0x48, 0xa8, 0x1e, 0xfb, 0x83, 0x54, 0xff, 0x59, 0x7a, 0x8d, 0x43,
+	0x37, 0x4b, 0xef, 0x51, 0x46, 0x93, 0xf8, 0xa4, 0x57, 0x57, 0x7b, 0xa1, 0x9d, 0xaf, 0x17, 0x66,
+	0x73, 0xe6, 0xe8, 0x61, 0xe1, 0xce, 0xb9, 0x1e, 0x33, 0x49, 0xe7, 0x29, 0xde, 0xd9, 0xc0, 0xce,
+	0xff, 0x05, 0xbb, 0x75, 0xf0, 0x66, 0xe9, 0x3d, 0x6e, 0x00, 0x2b, 0xa7, 0x17, 0x3e, 0xc8, 0xa5,
+	0xcf, 0xa5, 0x52, 0x03, 0x47, 0x34, 0x8d, 0x20, 0x8e, 0x81, 0xe1, 0xdd, 0xff, 0x03, 0x57, 0x07,
+	0x6f, 0x81, 0x2b, 0xa7, 0x02, 0xbf, 0x29, 0x15, 0xe7, 0x04, 0xa1, 0xa2, 0x9d, 0xd9, 0x14, 0xf0,
+	0x5e, 0xd7, 0xea, 0xdf, 0x3f, 0x7e, 0xda, 0xa8, 0x42, 0xad, 0x45, 0xd9, 0x14, 0xc2, 0xb6, 0x28,
+	0x47, 0xe7, 0x19, 0xda, 0x97, 0x10, 0x09, 0xc9, 0xc8, 0x57, 0x1e, 0xc7, 0x0a, 0xb7, 0xbb, 0x56,
+	0x7f, 0x2f, 0xb4, 0x73, 0xed, 0xad, 0x91, 0x4e, 0xdf, 0x5d, 0xad, 0x5c, 0xeb, 0x7a, 0xe5, 0x5a,
+	0xbf, 0x57, 0xae, 0xf5, 0x63, 0xed, 0xb6, 0xae, 0xd7, 0x6e, 0xeb, 0xe7, 0xda, 0x6d, 0x7d, 0x39,
+	0x1a, 0x71, 0x3d, 0x9e, 0x5d, 0xfa, 0x91, 0x48, 0x82, 0x02, 0x77, 0x24, 0xe4, 0xa8, 0x9c, 0x83,
+	0x6f, 0x2f, 0x83, 0x45, 0x5e, 0xe9, 0x6c, 0x0a, 0xea, 0x72, 0x67, 0x53, 0xeb, 0x17, 0x7f, 0x07,
+	0x00, 0xfa, 0x64, 0x9a, 0x60, 0x5e, 0x03, 0x00, 0x00,
 }
 
 func (m *LimitOrderTrancheUser) Marshal() (dAtA []byte, err error) {
@@ -166,6 +176,16 @@ func (m *LimitOrderTrancheUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecordFills {
+		i--
+		if m.RecordFills {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.OrderType != 0 {
 		i = encodeVarintLimitOrderTrancheUser(dAtA, i, uint64(m.OrderType))
 		i--
@@ -276,6 +296,9 @@ func (m *LimitOrderTrancheUser) Size() (n int) {
 	if m.OrderType != 0 {
 		n += 1 + sovLimitOrderTrancheUser(uint64(m.OrderType))
 	}
+	if m.RecordFills {
+		n += 2
+	}
 	return n
 }
 
@@ -554,6 +577,26 @@ func (m *LimitOrderTrancheUser) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordFills", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderTrancheUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordFills = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLimitOrderTrancheUser(dAtA[iNdEx:])
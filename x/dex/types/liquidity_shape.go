@@ -0,0 +1,100 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	"github.com/neutron-org/neutron/v4/x/dex/utils"
+)
+
+// RangeTickIndexes returns the tick indexes of the pools a range deposit between lowerTick and upperTick is spread
+// across. Pools are spaced 2*fee ticks apart so that the reserves of neighbouring pools do not overlap. The spacing
+// is widened as needed to keep the number of pools at or below MaxRangeDepositPools.
+func RangeTickIndexes(lowerTick, upperTick int64, fee uint64) []int64 {
+	spacing := max(2*utils.MustSafeUint64ToInt64(fee), 1)
+	width := upperTick - lowerTick
+	if width/spacing+1 > MaxRangeDepositPools {
+		// ceil(width / (MaxRangeDepositPools - 1))
+		spacing = (width + MaxRangeDepositPools - 2) / (MaxRangeDepositPools - 1)
+	}
+
+	tickIndexes := make([]int64, 0, width/spacing+1)
+	for tickIndex := lowerTick; tickIndex <= upperTick; tickIndex += spacing {
+		tickIndexes = append(tickIndexes, tickIndex)
+	}
+
+	return tickIndexes
+}
+
+// RangeTickIndexesAToB returns the tick indexes (a to b) of the pools that a range deposit is spread across, given
+// the lower and upper price of token_a in terms of token_b.
+func RangeTickIndexesAToB(lowerPrice, upperPrice math_utils.PrecDec, fee uint64) ([]int64, error) {
+	// A higher price corresponds to a lower tick index
+	lowerTick, err := CalcTickIndexFromPrice(upperPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	upperTick, err := CalcTickIndexFromPrice(lowerPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return RangeTickIndexes(lowerTick, upperTick, fee), nil
+}
+
+// Weights returns the relative amount of liquidity placed in each of n pools ordered by tick index.
+// peak is the index of the pool closest to the current price and is only used by the CURVE shape.
+func (s LiquidityShape) Weights(n, peak int) []math.Int {
+	weights := make([]math.Int, n)
+	for i := range weights {
+		switch s {
+		case LiquidityShape_NORMAL:
+			// A binomial distribution is a deterministic approximation of a normal distribution
+			weights[i] = binomialCoefficient(n-1, i)
+		case LiquidityShape_CURVE:
+			weights[i] = math.NewIntFromUint64(uint64(n) - utils.Abs(int64(i-peak)))
+		default:
+			weights[i] = math.OneInt()
+		}
+	}
+
+	return weights
+}
+
+func binomialCoefficient(n, k int) math.Int {
+	result := math.OneInt()
+	for i := 1; i <= k; i++ {
+		result = result.MulRaw(int64(n - k + i)).QuoRaw(int64(i))
+	}
+
+	return result
+}
+
+// DistributeAmount splits amount across positions in proportion to weights. Any remainder left over from
+// truncation is added to the position with the largest weight. If all weights are zero nothing is distributed.
+func DistributeAmount(amount math.Int, weights []math.Int) []math.Int {
+	amounts := make([]math.Int, len(weights))
+	totalWeight := math.ZeroInt()
+	largest := 0
+	for i, weight := range weights {
+		amounts[i] = math.ZeroInt()
+		totalWeight = totalWeight.Add(weight)
+		if weight.GT(weights[largest]) {
+			largest = i
+		}
+	}
+
+	if !totalWeight.IsPositive() {
+		return amounts
+	}
+
+	remaining := amount
+	for i, weight := range weights {
+		amounts[i] = amount.Mul(weight).Quo(totalWeight)
+		remaining = remaining.Sub(amounts[i])
+	}
+	amounts[largest] = amounts[largest].Add(remaining)
+
+	return amounts
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func TestRangeTickIndexes(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		lowerTick int64
+		upperTick int64
+		fee       uint64
+		expected  []int64
+	}{
+		{
+			desc:      "single pool",
+			lowerTick: 5,
+			upperTick: 5,
+			fee:       1,
+			expected:  []int64{5},
+		},
+		{
+			desc:      "spaced by twice the fee",
+			lowerTick: -10,
+			upperTick: 10,
+			fee:       5,
+			expected:  []int64{-10, 0, 10},
+		},
+		{
+			desc:      "upper tick not on spacing",
+			lowerTick: 0,
+			upperTick: 9,
+			fee:       2,
+			expected:  []int64{0, 4, 8},
+		},
+		{
+			desc:      "zero fee",
+			lowerTick: 0,
+			upperTick: 2,
+			fee:       0,
+			expected:  []int64{0, 1, 2},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.RangeTickIndexes(tc.lowerTick, tc.upperTick, tc.fee))
+		})
+	}
+}
+
+func TestRangeTickIndexesMaxPools(t *testing.T) {
+	tickIndexes := types.RangeTickIndexes(-10_000, 10_000, 1)
+	require.LessOrEqual(t, len(tickIndexes), types.MaxRangeDepositPools)
+	require.Equal(t, int64(-10_000), tickIndexes[0])
+	require.LessOrEqual(t, tickIndexes[len(tickIndexes)-1], int64(10_000))
+}
+
+func TestLiquidityShapeWeights(t *testing.T) {
+	ints := func(vals ...int64) []math.Int {
+		result := make([]math.Int, len(vals))
+		for i, v := range vals {
+			result[i] = math.NewInt(v)
+		}
+		return result
+	}
+
+	require.Equal(t, ints(1, 1, 1, 1), types.LiquidityShape_UNIFORM.Weights(4, 0))
+	require.Equal(t, ints(1, 4, 6, 4, 1), types.LiquidityShape_NORMAL.Weights(5, 0))
+	require.Equal(t, ints(3, 4, 5, 4, 3), types.LiquidityShape_CURVE.Weights(5, 2))
+	require.Equal(t, ints(5, 4, 3, 2, 1), types.LiquidityShape_CURVE.Weights(5, 0))
+}
+
+func TestDistributeAmount(t *testing.T) {
+	amounts := types.DistributeAmount(math.NewInt(10), []math.Int{math.NewInt(1), math.NewInt(2), math.NewInt(1)})
+	require.Equal(t, []math.Int{math.NewInt(2), math.NewInt(6), math.NewInt(2)}, amounts)
+
+	amounts = types.DistributeAmount(math.NewInt(10), []math.Int{math.ZeroInt(), math.ZeroInt()})
+	require.Equal(t, []math.Int{math.ZeroInt(), math.ZeroInt()}, amounts)
+
+	amounts = types.DistributeAmount(math.NewInt(9), []math.Int{math.ZeroInt(), math.NewInt(1), math.NewInt(1)})
+	require.Equal(t, []math.Int{math.ZeroInt(), math.NewInt(5), math.NewInt(4)}, amounts)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgBatchCancelLimitOrders = "batch_cancel_limit_orders"
+
+var _ sdk.Msg = &MsgBatchCancelLimitOrders{}
+
+func NewMsgBatchCancelLimitOrders(creator string, trancheKeys []string) *MsgBatchCancelLimitOrders {
+	return &MsgBatchCancelLimitOrders{
+		Creator:     creator,
+		TrancheKeys: trancheKeys,
+	}
+}
+
+func (msg *MsgBatchCancelLimitOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchCancelLimitOrders) Type() string {
+	return TypeMsgBatchCancelLimitOrders
+}
+
+func (msg *MsgBatchCancelLimitOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchCancelLimitOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgBatchCancelLimitOrders) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return validateTrancheKeyBatch(msg.TrancheKeys)
+}
+
+func validateTrancheKeyBatch(trancheKeys []string) error {
+	if len(trancheKeys) == 0 || len(trancheKeys) > MaxLimitOrderBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidBatchSize, "max %d, got %d", MaxLimitOrderBatchSize, len(trancheKeys))
+	}
+
+	seen := make(map[string]bool, len(trancheKeys))
+	for _, trancheKey := range trancheKeys {
+		if seen[trancheKey] {
+			return sdkerrors.Wrapf(ErrDuplicateTrancheKey, "%s", trancheKey)
+		}
+		seen[trancheKey] = true
+	}
+
+	return nil
+}
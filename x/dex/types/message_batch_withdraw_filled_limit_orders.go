@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgBatchWithdrawFilledLimitOrders = "batch_withdraw_filled_limit_orders"
+
+var _ sdk.Msg = &MsgBatchWithdrawFilledLimitOrders{}
+
+func NewMsgBatchWithdrawFilledLimitOrders(creator string, trancheKeys []string) *MsgBatchWithdrawFilledLimitOrders {
+	return &MsgBatchWithdrawFilledLimitOrders{
+		Creator:     creator,
+		TrancheKeys: trancheKeys,
+	}
+}
+
+func (msg *MsgBatchWithdrawFilledLimitOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchWithdrawFilledLimitOrders) Type() string {
+	return TypeMsgBatchWithdrawFilledLimitOrders
+}
+
+func (msg *MsgBatchWithdrawFilledLimitOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchWithdrawFilledLimitOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgBatchWithdrawFilledLimitOrders) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return validateTrancheKeyBatch(msg.TrancheKeys)
+}
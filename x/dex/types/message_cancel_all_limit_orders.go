@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCancelAllLimitOrders = "cancel_all_limit_orders"
+
+var _ sdk.Msg = &MsgCancelAllLimitOrders{}
+
+func NewMsgCancelAllLimitOrders(creator, tokenA, tokenB string) *MsgCancelAllLimitOrders {
+	return &MsgCancelAllLimitOrders{
+		Creator: creator,
+		TokenA:  tokenA,
+		TokenB:  tokenB,
+	}
+}
+
+func (msg *MsgCancelAllLimitOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAllLimitOrders) Type() string {
+	return TypeMsgCancelAllLimitOrders
+}
+
+func (msg *MsgCancelAllLimitOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelAllLimitOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgCancelAllLimitOrders) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Verify tokenA and tokenB are valid denoms
+	err = sdk.ValidateDenom(msg.TokenA)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenA denom (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenB)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenB denom (%s)", err)
+	}
+
+	if msg.TokenA == msg.TokenB {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenA cannot equal tokenB")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCancelConditionalOrder = "cancel_conditional_order"
+
+var _ sdk.Msg = &MsgCancelConditionalOrder{}
+
+func NewMsgCancelConditionalOrder(creator string, id uint64) *MsgCancelConditionalOrder {
+	return &MsgCancelConditionalOrder{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelConditionalOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelConditionalOrder) Type() string {
+	return TypeMsgCancelConditionalOrder
+}
+
+func (msg *MsgCancelConditionalOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelConditionalOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgCancelConditionalOrder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
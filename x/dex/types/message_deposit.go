@@ -95,6 +95,14 @@ func (msg *MsgDeposit) Validate() error {
 	if numDeposits == 0 {
 		return ErrZeroDeposit
 	}
+	if len(msg.MinSharesOut) != 0 && len(msg.MinSharesOut) != numDeposits {
+		return ErrUnbalancedTxArray
+	}
+	for _, minShares := range msg.MinSharesOut {
+		if minShares.IsNegative() {
+			return ErrNegativeMinOut
+		}
+	}
 
 	poolsDeposited := make(map[string]bool)
 	for i := 0; i < numDeposits; i++ {
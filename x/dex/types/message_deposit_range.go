@@ -0,0 +1,130 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+const TypeMsgDepositRange = "deposit_range"
+
+var _ sdk.Msg = &MsgDepositRange{}
+
+func NewMsgDepositRange(
+	creator,
+	receiver,
+	tokenA,
+	tokenB string,
+	amountA,
+	amountB math.Int,
+	lowerPrice,
+	upperPrice math_utils.PrecDec,
+	fee uint64,
+	shape LiquidityShape,
+	depositOptions *DepositOptions,
+) *MsgDepositRange {
+	return &MsgDepositRange{
+		Creator:    creator,
+		Receiver:   receiver,
+		TokenA:     tokenA,
+		TokenB:     tokenB,
+		AmountA:    amountA,
+		AmountB:    amountB,
+		LowerPrice: lowerPrice,
+		UpperPrice: upperPrice,
+		Fee:        fee,
+		Shape:      shape,
+		Options:    depositOptions,
+	}
+}
+
+func (msg *MsgDepositRange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositRange) Type() string {
+	return TypeMsgDepositRange
+}
+
+func (msg *MsgDepositRange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDepositRange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgDepositRange) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	// Verify tokenA and tokenB are valid denoms
+	err = sdk.ValidateDenom(msg.TokenA)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenA denom (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenB)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenB denom (%s)", err)
+	}
+
+	if msg.TokenA == msg.TokenB {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenA cannot equal tokenB")
+	}
+
+	if msg.AmountA.IsNil() || msg.AmountB.IsNil() || msg.AmountA.IsNegative() || msg.AmountB.IsNegative() {
+		return ErrZeroDeposit
+	}
+	if msg.AmountA.IsZero() && msg.AmountB.IsZero() {
+		return ErrZeroDeposit
+	}
+
+	if _, ok := LiquidityShape_name[int32(msg.Shape)]; !ok {
+		return ErrInvalidLiquidityShape
+	}
+
+	return ValidatePriceRange(msg.LowerPrice, msg.UpperPrice, msg.Fee)
+}
+
+// ValidatePriceRange checks that a range deposit or withdrawal between lowerPrice and upperPrice only uses valid ticks.
+func ValidatePriceRange(lowerPrice, upperPrice math_utils.PrecDec, fee uint64) error {
+	if lowerPrice.IsNil() || IsPriceOutOfRange(lowerPrice) {
+		return sdkerrors.Wrap(ErrPriceOutsideRange, "invalid LowerPrice")
+	}
+
+	if upperPrice.IsNil() || IsPriceOutOfRange(upperPrice) {
+		return sdkerrors.Wrap(ErrPriceOutsideRange, "invalid UpperPrice")
+	}
+
+	if lowerPrice.GT(upperPrice) {
+		return ErrInvalidPriceRange
+	}
+
+	tickIndexes, err := RangeTickIndexesAToB(lowerPrice, upperPrice, fee)
+	if err != nil {
+		return err
+	}
+
+	// Tick indexes are ordered so only the outermost pools need to be checked
+	if err := ValidateTickFee(tickIndexes[0], fee); err != nil {
+		return err
+	}
+
+	return ValidateTickFee(tickIndexes[len(tickIndexes)-1], fee)
+}
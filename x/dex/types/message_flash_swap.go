@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+const TypeMsgFlashSwap = "flash_swap"
+
+var _ sdk.Msg = &MsgFlashSwap{}
+
+func NewMsgFlashSwap(
+	creator string,
+	tokenIn string,
+	tokenOut string,
+	amountIn math.Int,
+	maxAmountOut *math.Int,
+	limitSellPrice *math_utils.PrecDec,
+	callbackMsg []byte,
+) *MsgFlashSwap {
+	return &MsgFlashSwap{
+		Creator:        creator,
+		TokenIn:        tokenIn,
+		TokenOut:       tokenOut,
+		AmountIn:       amountIn,
+		MaxAmountOut:   maxAmountOut,
+		LimitSellPrice: limitSellPrice,
+		CallbackMsg:    callbackMsg,
+	}
+}
+
+func (msg *MsgFlashSwap) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFlashSwap) Type() string {
+	return TypeMsgFlashSwap
+}
+
+func (msg *MsgFlashSwap) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFlashSwap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgFlashSwap) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(msg.TokenIn); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid token in denom (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.TokenOut); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid token out denom (%s)", err)
+	}
+	if msg.TokenIn == msg.TokenOut {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenIn cannot equal tokenOut")
+	}
+	if err := validateAmountIn(msg.AmountIn); err != nil {
+		return err
+	}
+	if err := validateAmountOut(msg.MaxAmountOut); err != nil {
+		return err
+	}
+	if msg.LimitSellPrice != nil && IsPriceOutOfRange(*msg.LimitSellPrice) {
+		return ErrPriceOutsideRange
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgModifyLimitOrder = "modify_limit_order"
+
+var _ sdk.Msg = &MsgModifyLimitOrder{}
+
+func NewMsgModifyLimitOrder(
+	creator string,
+	trancheKey string,
+	reduceAmountIn *math.Int,
+	expirationTime *time.Time,
+) *MsgModifyLimitOrder {
+	return &MsgModifyLimitOrder{
+		Creator:        creator,
+		TrancheKey:     trancheKey,
+		ReduceAmountIn: reduceAmountIn,
+		ExpirationTime: expirationTime,
+	}
+}
+
+func (msg *MsgModifyLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgModifyLimitOrder) Type() string {
+	return TypeMsgModifyLimitOrder
+}
+
+func (msg *MsgModifyLimitOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgModifyLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgModifyLimitOrder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.ReduceAmountIn == nil && msg.ExpirationTime == nil {
+		return sdkerrors.Wrap(ErrInvalidLimitOrderModification, "reduce_amount_in or expiration_time must be set")
+	}
+
+	if msg.ReduceAmountIn != nil && !msg.ReduceAmountIn.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidLimitOrderModification, "reduce_amount_in must be positive")
+	}
+
+	return nil
+}
+
+func (msg *MsgModifyLimitOrder) ValidateGoodTilExpiration(blockTime time.Time) error {
+	if msg.ExpirationTime != nil && !msg.ExpirationTime.After(blockTime) {
+		return sdkerrors.Wrapf(ErrExpirationTimeInPast,
+			"Current BlockTime: %s; Provided ExpirationTime: %s",
+			blockTime.String(),
+			msg.ExpirationTime.String(),
+		)
+	}
+
+	return nil
+}
@@ -73,9 +73,20 @@ func (msg *MsgMultiHopSwap) Validate() error {
 	if err := validateExitLimitPrice(msg.ExitLimitPrice); err != nil {
 		return err
 	}
+	if err := validateAmountOut(msg.AmountOut); err != nil {
+		return err
+	}
+	if !msg.SelfTradePrevention.IsValid() {
+		return ErrInvalidSelfTradePrevention
+	}
 	return nil
 }
 
+// IsExactAmountOut returns true if the swap should deliver exactly AmountOut, treating AmountIn as the maximum input
+func (msg *MsgMultiHopSwap) IsExactAmountOut() bool {
+	return msg.AmountOut != nil
+}
+
 func validateAddress(address, field string) error {
 	_, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
@@ -142,6 +153,13 @@ func validateAmountIn(amount math.Int) error {
 	return nil
 }
 
+func validateAmountOut(amount *math.Int) error {
+	if amount != nil && amount.LTE(math.ZeroInt()) {
+		return ErrZeroSwapAmountOut
+	}
+	return nil
+}
+
 func validateExitLimitPrice(price math_utils.PrecDec) error {
 	if !price.IsPositive() {
 		return ErrZeroExitPrice
@@ -0,0 +1,113 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+const TypeMsgPlaceConditionalOrder = "place_conditional_order"
+
+var _ sdk.Msg = &MsgPlaceConditionalOrder{}
+
+func NewMsgPlaceConditionalOrder(
+	creator,
+	receiver,
+	tokenIn,
+	tokenOut string,
+	amountIn math.Int,
+	orderType LimitOrderType,
+	maxAmountOut *math.Int,
+	limitSellPrice math_utils.PrecDec,
+	trigger ConditionalOrderTrigger,
+	triggerPrice math_utils.PrecDec,
+) *MsgPlaceConditionalOrder {
+	return &MsgPlaceConditionalOrder{
+		Creator:        creator,
+		Receiver:       receiver,
+		TokenIn:        tokenIn,
+		TokenOut:       tokenOut,
+		AmountIn:       amountIn,
+		OrderType:      orderType,
+		MaxAmountOut:   maxAmountOut,
+		LimitSellPrice: limitSellPrice,
+		Trigger:        trigger,
+		TriggerPrice:   triggerPrice,
+	}
+}
+
+func (msg *MsgPlaceConditionalOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceConditionalOrder) Type() string {
+	return TypeMsgPlaceConditionalOrder
+}
+
+func (msg *MsgPlaceConditionalOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceConditionalOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgPlaceConditionalOrder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenIn)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "Error TokenIn denom (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenOut)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "Error TokenOut denom (%s)", err)
+	}
+
+	if msg.TokenIn == msg.TokenOut {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenIn cannot equal tokenOut")
+	}
+
+	if msg.AmountIn.IsNil() || msg.AmountIn.LTE(math.ZeroInt()) {
+		return ErrZeroLimitOrder
+	}
+
+	if !msg.OrderType.IsTakerOnly() {
+		return ErrInvalidConditionalOrderType
+	}
+
+	if _, ok := ConditionalOrderTrigger_name[int32(msg.Trigger)]; !ok {
+		return ErrInvalidConditionalOrderTrigger
+	}
+
+	if msg.MaxAmountOut != nil && !msg.MaxAmountOut.IsPositive() {
+		return ErrZeroMaxAmountOut
+	}
+
+	if msg.LimitSellPrice.IsNil() || IsPriceOutOfRange(msg.LimitSellPrice) {
+		return sdkerrors.Wrap(ErrPriceOutsideRange, "invalid LimitSellPrice")
+	}
+
+	if msg.TriggerPrice.IsNil() || IsPriceOutOfRange(msg.TriggerPrice) {
+		return sdkerrors.Wrap(ErrPriceOutsideRange, "invalid TriggerPrice")
+	}
+
+	return nil
+}
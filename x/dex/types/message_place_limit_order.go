@@ -121,6 +121,22 @@ func (msg *MsgPlaceLimitOrder) Validate() error {
 		return ErrInvalidPriceAndTick
 	}
 
+	if !msg.SelfTradePrevention.IsValid() {
+		return ErrInvalidSelfTradePrevention
+	}
+
+	if msg.OraclePeg != nil {
+		if err := msg.OraclePeg.Validate(); err != nil {
+			return err
+		}
+		if msg.LimitSellPrice != nil || msg.TickIndexInToOut != 0 {
+			return sdkerrors.Wrapf(ErrInvalidOraclePeg, "cannot set a price or tick index for an oracle pegged order")
+		}
+		if !msg.OrderType.IsGTC() {
+			return sdkerrors.Wrapf(ErrInvalidOraclePeg, "only GOOD_TIL_CANCELLED orders can be oracle pegged")
+		}
+	}
+
 	return nil
 }
 
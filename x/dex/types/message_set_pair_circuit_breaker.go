@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetPairCircuitBreaker = "set_pair_circuit_breaker"
+
+var _ sdk.Msg = &MsgSetPairCircuitBreaker{}
+
+func NewMsgSetPairCircuitBreaker(
+	authority,
+	tokenA,
+	tokenB string,
+	paused bool,
+	maxTickDeviation uint64,
+) *MsgSetPairCircuitBreaker {
+	return &MsgSetPairCircuitBreaker{
+		Authority:        authority,
+		TokenA:           tokenA,
+		TokenB:           tokenB,
+		Paused:           paused,
+		MaxTickDeviation: maxTickDeviation,
+	}
+}
+
+func (msg *MsgSetPairCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPairCircuitBreaker) Type() string {
+	return TypeMsgSetPairCircuitBreaker
+}
+
+func (msg *MsgSetPairCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetPairCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetPairCircuitBreaker) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	// Verify tokenA and tokenB are valid denoms
+	err = sdk.ValidateDenom(msg.TokenA)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenA denom (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenB)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenB denom (%s)", err)
+	}
+
+	if msg.TokenA == msg.TokenB {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenA cannot equal tokenB")
+	}
+
+	if msg.MaxTickDeviation > 2*MaxTickExp {
+		return sdkerrors.Wrapf(ErrTickOutsideRange, "max tick deviation cannot exceed %d", 2*MaxTickExp)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+const TypeMsgSwapExactIn = "swap_exact_in"
+
+var _ sdk.Msg = &MsgSwapExactIn{}
+
+func NewMsgSwapExactIn(
+	creator string,
+	receiver string,
+	tokenIn string,
+	tokenOut string,
+	amountIn math.Int,
+	exitLimitPrice math_utils.PrecDec,
+	maxHops uint64,
+) *MsgSwapExactIn {
+	return &MsgSwapExactIn{
+		Creator:        creator,
+		Receiver:       receiver,
+		TokenIn:        tokenIn,
+		TokenOut:       tokenOut,
+		AmountIn:       amountIn,
+		ExitLimitPrice: exitLimitPrice,
+		MaxHops:        maxHops,
+	}
+}
+
+func (msg *MsgSwapExactIn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSwapExactIn) Type() string {
+	return TypeMsgSwapExactIn
+}
+
+func (msg *MsgSwapExactIn) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSwapExactIn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSwapExactIn) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.Receiver, "receiver"); err != nil {
+		return err
+	}
+	if err := validateRouteEndpoints(msg.TokenIn, msg.TokenOut, msg.MaxHops); err != nil {
+		return err
+	}
+	if err := validateAmountIn(msg.AmountIn); err != nil {
+		return err
+	}
+	if err := validateExitLimitPrice(msg.ExitLimitPrice); err != nil {
+		return err
+	}
+	if !msg.SelfTradePrevention.IsValid() {
+		return ErrInvalidSelfTradePrevention
+	}
+	return nil
+}
+
+func (req *QueryEstimateBestRouteRequest) Validate() error {
+	if err := validateRouteEndpoints(req.TokenIn, req.TokenOut, req.MaxHops); err != nil {
+		return err
+	}
+	return validateAmountIn(req.AmountIn)
+}
+
+func validateRouteEndpoints(tokenIn, tokenOut string, maxHops uint64) error {
+	if err := sdk.ValidateDenom(tokenIn); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid token in denom (%s)", err)
+	}
+	if err := sdk.ValidateDenom(tokenOut); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid token out denom (%s)", err)
+	}
+	if tokenIn == tokenOut {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenIn cannot equal tokenOut")
+	}
+	if maxHops > MaxRouteHops {
+		return sdkerrors.Wrapf(ErrInvalidMaxHops, "max %d, got %d", MaxRouteHops, maxHops)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+const TypeMsgWithdrawPosition = "withdraw_position"
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func NewMsgWithdrawPosition(
+	creator,
+	receiver,
+	tokenA,
+	tokenB string,
+	percentage math_utils.PrecDec,
+) *MsgWithdrawPosition {
+	return &MsgWithdrawPosition{
+		Creator:    creator,
+		Receiver:   receiver,
+		TokenA:     tokenA,
+		TokenB:     tokenB,
+		Percentage: percentage,
+	}
+}
+
+func (msg *MsgWithdrawPosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawPosition) Type() string {
+	return TypeMsgWithdrawPosition
+}
+
+func (msg *MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawPosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgWithdrawPosition) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	// Verify tokenA and tokenB are valid denoms
+	err = sdk.ValidateDenom(msg.TokenA)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenA denom (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenB)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenB denom (%s)", err)
+	}
+
+	if msg.TokenA == msg.TokenB {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenA cannot equal tokenB")
+	}
+
+	if msg.Percentage.IsNil() || !msg.Percentage.IsPositive() || msg.Percentage.GT(math_utils.NewPrecDec(100)) {
+		return ErrInvalidWithdrawPercentage
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+const TypeMsgWithdrawRange = "withdraw_range"
+
+var _ sdk.Msg = &MsgWithdrawRange{}
+
+func NewMsgWithdrawRange(
+	creator,
+	receiver,
+	tokenA,
+	tokenB string,
+	lowerPrice,
+	upperPrice math_utils.PrecDec,
+	fee uint64,
+) *MsgWithdrawRange {
+	return &MsgWithdrawRange{
+		Creator:    creator,
+		Receiver:   receiver,
+		TokenA:     tokenA,
+		TokenB:     tokenB,
+		LowerPrice: lowerPrice,
+		UpperPrice: upperPrice,
+		Fee:        fee,
+	}
+}
+
+func (msg *MsgWithdrawRange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawRange) Type() string {
+	return TypeMsgWithdrawRange
+}
+
+func (msg *MsgWithdrawRange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawRange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgWithdrawRange) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	// Verify tokenA and tokenB are valid denoms
+	err = sdk.ValidateDenom(msg.TokenA)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenA denom (%s)", err)
+	}
+
+	err = sdk.ValidateDenom(msg.TokenB)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenB denom (%s)", err)
+	}
+
+	if msg.TokenA == msg.TokenB {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenA cannot equal tokenB")
+	}
+
+	return ValidatePriceRange(msg.LowerPrice, msg.UpperPrice, msg.Fee)
+}
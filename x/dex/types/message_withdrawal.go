@@ -96,5 +96,10 @@ func (msg *MsgWithdrawal) Validate() error {
 		}
 	}
 
+	if (msg.MinAmount0Out != nil && msg.MinAmount0Out.IsNegative()) ||
+		(msg.MinAmount1Out != nil && msg.MinAmount1Out.IsNegative()) {
+		return ErrNegativeMinOut
+	}
+
 	return nil
 }
@@ -3,21 +3,46 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyFeeTiers                         = []byte("FeeTiers")
-	DefaultFeeTiers                     = []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200}
-	KeyPaused                           = []byte("Paused")
-	DefaultPaused                       = false
-	KeyMaxJITsPerBlock                  = []byte("MaxJITs")
-	DefaultMaxJITsPerBlock       uint64 = 25
-	KeyGoodTilPurgeAllowance            = []byte("PurgeAllowance")
-	DefaultGoodTilPurgeAllowance uint64 = 540_000
+	KeyFeeTiers                                = []byte("FeeTiers")
+	DefaultFeeTiers                            = []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200}
+	KeyPaused                                  = []byte("Paused")
+	DefaultPaused                              = false
+	KeyMaxJITsPerBlock                         = []byte("MaxJITs")
+	DefaultMaxJITsPerBlock           uint64    = 25
+	KeyGoodTilPurgeAllowance                   = []byte("PurgeAllowance")
+	DefaultGoodTilPurgeAllowance     uint64    = 540_000
+	KeyConditionalOrderAllowance               = []byte("ConditionalOrderAllowance")
+	DefaultConditionalOrderAllowance uint64    = 1_000_000
+	KeyProtocolFeeShare                        = []byte("ProtocolFeeShare")
+	DefaultProtocolFeeShare                    = math_utils.ZeroPrecDec()
+	KeyProtocolFeeCollector                    = []byte("ProtocolFeeCollector")
+	DefaultProtocolFeeCollector                = ""
+	KeyCircuitBreakerAddress                   = []byte("CircuitBreakerAddress")
+	DefaultCircuitBreakerAddress               = ""
+	KeyMaxOracleRepegsPerBlock                 = []byte("MaxOracleRepegsPerBlock")
+	DefaultMaxOracleRepegsPerBlock   uint64    = 100
+	KeyMinMakerOrderSizes                      = []byte("MinMakerOrderSizes")
+	DefaultMinMakerOrderSizes        sdk.Coins = nil
+	KeyMinDepositSizes                         = []byte("MinDepositSizes")
+	DefaultMinDepositSizes           sdk.Coins = nil
+	KeyFeeRecommendationWindow                 = []byte("FeeRecommendationWindow")
+	DefaultFeeRecommendationWindow   uint64    = 0
+	KeyOracleRepegAllowance                    = []byte("OracleRepegAllowance")
+	DefaultOracleRepegAllowance      uint64    = 1_000_000
+	KeyMaxFillRecordSubscribers                = []byte("MaxFillRecordSubscribers")
+	DefaultMaxFillRecordSubscribers  uint64    = 100
+	KeyFillRecordPruneAllowance                = []byte("FillRecordPruneAllowance")
+	DefaultFillRecordPruneAllowance  uint64    = 1_000_000
 )
 
 // ParamKeyTable the param key table for launch module
@@ -26,18 +51,61 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(feeTiers []uint64, paused bool, maxJITsPerBlock, goodTilPurgeAllowance uint64) Params {
+func NewParams(
+	feeTiers []uint64,
+	paused bool,
+	maxJITsPerBlock,
+	goodTilPurgeAllowance,
+	conditionalOrderAllowance uint64,
+	protocolFeeShare math_utils.PrecDec,
+	protocolFeeCollector string,
+	circuitBreakerAddress string,
+	maxOracleRepegsPerBlock uint64,
+	minMakerOrderSizes sdk.Coins,
+	minDepositSizes sdk.Coins,
+	feeRecommendationWindow uint64,
+	oracleRepegAllowance uint64,
+	maxFillRecordSubscribers uint64,
+	fillRecordPruneAllowance uint64,
+) Params {
 	return Params{
-		FeeTiers:              feeTiers,
-		Paused:                paused,
-		MaxJitsPerBlock:       maxJITsPerBlock,
-		GoodTilPurgeAllowance: goodTilPurgeAllowance,
+		FeeTiers:                  feeTiers,
+		Paused:                    paused,
+		MaxJitsPerBlock:           maxJITsPerBlock,
+		GoodTilPurgeAllowance:     goodTilPurgeAllowance,
+		ConditionalOrderAllowance: conditionalOrderAllowance,
+		ProtocolFeeShare:          protocolFeeShare,
+		ProtocolFeeCollector:      protocolFeeCollector,
+		CircuitBreakerAddress:     circuitBreakerAddress,
+		MaxOracleRepegsPerBlock:   maxOracleRepegsPerBlock,
+		MinMakerOrderSizes:        minMakerOrderSizes,
+		MinDepositSizes:           minDepositSizes,
+		FeeRecommendationWindow:   feeRecommendationWindow,
+		OracleRepegAllowance:      oracleRepegAllowance,
+		MaxFillRecordSubscribers:  maxFillRecordSubscribers,
+		FillRecordPruneAllowance:  fillRecordPruneAllowance,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultFeeTiers, DefaultPaused, DefaultMaxJITsPerBlock, DefaultGoodTilPurgeAllowance)
+	return NewParams(
+		DefaultFeeTiers,
+		DefaultPaused,
+		DefaultMaxJITsPerBlock,
+		DefaultGoodTilPurgeAllowance,
+		DefaultConditionalOrderAllowance,
+		DefaultProtocolFeeShare,
+		DefaultProtocolFeeCollector,
+		DefaultCircuitBreakerAddress,
+		DefaultMaxOracleRepegsPerBlock,
+		DefaultMinMakerOrderSizes,
+		DefaultMinDepositSizes,
+		DefaultFeeRecommendationWindow,
+		DefaultOracleRepegAllowance,
+		DefaultMaxFillRecordSubscribers,
+		DefaultFillRecordPruneAllowance,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -47,6 +115,17 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPaused, &p.Paused, validatePaused),
 		paramtypes.NewParamSetPair(KeyMaxJITsPerBlock, &p.MaxJitsPerBlock, validateMaxJITsPerBlock),
 		paramtypes.NewParamSetPair(KeyGoodTilPurgeAllowance, &p.GoodTilPurgeAllowance, validatePurgeAllowance),
+		paramtypes.NewParamSetPair(KeyConditionalOrderAllowance, &p.ConditionalOrderAllowance, validateConditionalOrderAllowance),
+		paramtypes.NewParamSetPair(KeyProtocolFeeShare, &p.ProtocolFeeShare, validateProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyProtocolFeeCollector, &p.ProtocolFeeCollector, validateProtocolFeeCollector),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerAddress, &p.CircuitBreakerAddress, validateCircuitBreakerAddress),
+		paramtypes.NewParamSetPair(KeyMaxOracleRepegsPerBlock, &p.MaxOracleRepegsPerBlock, validateMaxOracleRepegsPerBlock),
+		paramtypes.NewParamSetPair(KeyMinMakerOrderSizes, &p.MinMakerOrderSizes, validateMinSizes),
+		paramtypes.NewParamSetPair(KeyMinDepositSizes, &p.MinDepositSizes, validateMinSizes),
+		paramtypes.NewParamSetPair(KeyFeeRecommendationWindow, &p.FeeRecommendationWindow, validateFeeRecommendationWindow),
+		paramtypes.NewParamSetPair(KeyOracleRepegAllowance, &p.OracleRepegAllowance, validateOracleRepegAllowance),
+		paramtypes.NewParamSetPair(KeyMaxFillRecordSubscribers, &p.MaxFillRecordSubscribers, validateMaxFillRecordSubscribers),
+		paramtypes.NewParamSetPair(KeyFillRecordPruneAllowance, &p.FillRecordPruneAllowance, validateFillRecordPruneAllowance),
 	}
 }
 
@@ -73,6 +152,39 @@ func (p Params) Validate() error {
 	if err := validatePurgeAllowance(p.GoodTilPurgeAllowance); err != nil {
 		return err
 	}
+	if err := validateConditionalOrderAllowance(p.ConditionalOrderAllowance); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeShare(p.ProtocolFeeShare); err != nil {
+		return fmt.Errorf("invalid protocol fee share: %w", err)
+	}
+	if err := validateProtocolFeeCollector(p.ProtocolFeeCollector); err != nil {
+		return fmt.Errorf("invalid protocol fee collector: %w", err)
+	}
+	if err := validateCircuitBreakerAddress(p.CircuitBreakerAddress); err != nil {
+		return fmt.Errorf("invalid circuit breaker address: %w", err)
+	}
+	if err := validateMaxOracleRepegsPerBlock(p.MaxOracleRepegsPerBlock); err != nil {
+		return err
+	}
+	if err := validateMinSizes(p.MinMakerOrderSizes); err != nil {
+		return fmt.Errorf("invalid min maker order sizes: %w", err)
+	}
+	if err := validateMinSizes(p.MinDepositSizes); err != nil {
+		return fmt.Errorf("invalid min deposit sizes: %w", err)
+	}
+	if err := validateFeeRecommendationWindow(p.FeeRecommendationWindow); err != nil {
+		return fmt.Errorf("invalid fee recommendation window: %w", err)
+	}
+	if err := validateOracleRepegAllowance(p.OracleRepegAllowance); err != nil {
+		return err
+	}
+	if err := validateMaxFillRecordSubscribers(p.MaxFillRecordSubscribers); err != nil {
+		return err
+	}
+	if err := validateFillRecordPruneAllowance(p.FillRecordPruneAllowance); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -118,3 +230,116 @@ func validatePurgeAllowance(v interface{}) error {
 
 	return nil
 }
+
+func validateConditionalOrderAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeShare(v interface{}) error {
+	share, ok := v.(math_utils.PrecDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// an unset share is treated as zero
+	if share.IsNil() {
+		return nil
+	}
+
+	if share.IsNegative() || share.GTE(math_utils.OnePrecDec()) {
+		return fmt.Errorf("protocol fee share must be >= 0 and < 1, got %s", share)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeCollector(v interface{}) error {
+	collector, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if collector == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(collector)
+	return err
+}
+
+func validateCircuitBreakerAddress(v interface{}) error {
+	address, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if address == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(address)
+	return err
+}
+
+func validateMaxOracleRepegsPerBlock(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateMinSizes(v interface{}) error {
+	minSizes, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return minSizes.Validate()
+}
+
+func validateFeeRecommendationWindow(v interface{}) error {
+	window, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if window > uint64(TwapRecordHistoryKeepPeriod.Seconds()) {
+		return fmt.Errorf("fee recommendation window must not exceed %s, got %ds", TwapRecordHistoryKeepPeriod, window)
+	}
+
+	return nil
+}
+
+func validateOracleRepegAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateMaxFillRecordSubscribers(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateFillRecordPruneAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -9,8 +9,12 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+
+	github_com_neutron_org_neutron_v4_utils_math "github.com/neutron-org/neutron/v4/utils/math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -30,6 +34,37 @@ type Params struct {
 	Paused                bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused"`
 	MaxJitsPerBlock       uint64   `protobuf:"varint,4,opt,name=max_jits_per_block,json=maxJitsPerBlock,proto3" json:"max_jits_per_block,omitempty"`
 	GoodTilPurgeAllowance uint64   `protobuf:"varint,5,opt,name=good_til_purge_allowance,json=goodTilPurgeAllowance,proto3" json:"good_til_purge_allowance,omitempty"`
+	// Gas budget per block for executing triggered conditional orders
+	ConditionalOrderAllowance uint64 `protobuf:"varint,6,opt,name=conditional_order_allowance,json=conditionalOrderAllowance,proto3" json:"conditional_order_allowance,omitempty"`
+	// Fraction of the LP fee earned on pool swaps that is taken as a protocol fee
+	ProtocolFeeShare github_com_neutron_org_neutron_v4_utils_math.PrecDec `protobuf:"bytes,7,opt,name=protocol_fee_share,json=protocolFeeShare,proto3,customtype=github.com/neutron-org/neutron/v4/utils/math.PrecDec" json:"protocol_fee_share" yaml:"protocol_fee_share"`
+	// Address that accrued protocol fees are sent to at the end of each block. If empty, protocol fees
+	// are held by the dex module until a collector is set.
+	ProtocolFeeCollector string `protobuf:"bytes,8,opt,name=protocol_fee_collector,json=protocolFeeCollector,proto3" json:"protocol_fee_collector,omitempty"`
+	// Address that, in addition to the module authority, may pause pairs and set their price bands.
+	// If empty, only the module authority can.
+	CircuitBreakerAddress string `protobuf:"bytes,9,opt,name=circuit_breaker_address,json=circuitBreakerAddress,proto3" json:"circuit_breaker_address,omitempty"`
+	// Maximum number of oracle-pegged limit orders re-priced at the start of each block
+	MaxOracleRepegsPerBlock uint64 `protobuf:"varint,10,opt,name=max_oracle_repegs_per_block,json=maxOracleRepegsPerBlock,proto3" json:"max_oracle_repegs_per_block,omitempty"`
+	// Minimum amount of each denom that can be placed as the maker portion of a limit order.
+	// Denoms without an entry have no minimum.
+	// If a limit order partially fills and its remainder is below the minimum, the taker portion is kept and the
+	// remainder is not placed.
+	MinMakerOrderSizes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=min_maker_order_sizes,json=minMakerOrderSizes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_maker_order_sizes"`
+	// Minimum amount of each denom that can be deposited into a pool. Applies to each side of every deposit that
+	// deposits a non-zero amount of the denom. Denoms without an entry have no minimum.
+	MinDepositSizes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=min_deposit_sizes,json=minDepositSizes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit_sizes"`
+	// Length in seconds of the rolling window over which the realized volatility of a pair's price is measured to
+	// recommend a fee tier. Must not exceed the TWAP record history of 48 hours. If 0, no fees are recommended.
+	FeeRecommendationWindow uint64 `protobuf:"varint,13,opt,name=fee_recommendation_window,json=feeRecommendationWindow,proto3" json:"fee_recommendation_window,omitempty"`
+	// Gas budget per block for scanning oracle-pegged limit orders for re-pricing. Scanning resumes where it stopped in
+	// the following block.
+	OracleRepegAllowance uint64 `protobuf:"varint,14,opt,name=oracle_repeg_allowance,json=oracleRepegAllowance,proto3" json:"oracle_repeg_allowance,omitempty"`
+	// Maximum number of users recording fills in a single limit order tranche. Every fill of a tranche updates the
+	// record of each of its users, so this bounds the work a swap does per tranche.
+	MaxFillRecordSubscribers uint64 `protobuf:"varint,15,opt,name=max_fill_record_subscribers,json=maxFillRecordSubscribers,proto3" json:"max_fill_record_subscribers,omitempty"`
+	// Gas budget per block for pruning expired fill records. Pruning resumes where it stopped in the following block.
+	FillRecordPruneAllowance uint64 `protobuf:"varint,16,opt,name=fill_record_prune_allowance,json=fillRecordPruneAllowance,proto3" json:"fill_record_prune_allowance,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -92,6 +127,76 @@ func (m *Params) GetGoodTilPurgeAllowance() uint64 {
 	return 0
 }
 
+func (m *Params) GetConditionalOrderAllowance() uint64 {
+	if m != nil {
+		return m.ConditionalOrderAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetProtocolFeeCollector() string {
+	if m != nil {
+		return m.ProtocolFeeCollector
+	}
+	return ""
+}
+
+func (m *Params) GetCircuitBreakerAddress() string {
+	if m != nil {
+		return m.CircuitBreakerAddress
+	}
+	return ""
+}
+
+func (m *Params) GetMaxOracleRepegsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxOracleRepegsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMinMakerOrderSizes() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinMakerOrderSizes
+	}
+	return nil
+}
+
+func (m *Params) GetMinDepositSizes() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinDepositSizes
+	}
+	return nil
+}
+
+func (m *Params) GetFeeRecommendationWindow() uint64 {
+	if m != nil {
+		return m.FeeRecommendationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetOracleRepegAllowance() uint64 {
+	if m != nil {
+		return m.OracleRepegAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFillRecordSubscribers() uint64 {
+	if m != nil {
+		return m.MaxFillRecordSubscribers
+	}
+	return 0
+}
+
+func (m *Params) GetFillRecordPruneAllowance() uint64 {
+	if m != nil {
+		return m.FillRecordPruneAllowance
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.dex.Params")
 }
@@ -99,26 +204,51 @@ func init() {
 func init() { proto.RegisterFile("neutron/dex/params.proto", fileDescriptor_84a6bffcfc21009c) }
 
 var fileDescriptor_84a6bffcfc21009c = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0xf4, 0x2c, 0x35, 0x0e, 0x42, 0x50, 0x08, 0x0a, 0x69, 0xe9, 0x54, 0x90, 0x36,
-	0x83, 0x82, 0xe0, 0x66, 0x17, 0xc1, 0xa9, 0x94, 0x4e, 0x2e, 0x21, 0xed, 0xbd, 0xc6, 0xe8, 0x5d,
-	0x13, 0x92, 0x9c, 0x9e, 0xdf, 0xc2, 0xd1, 0xd1, 0x2f, 0xe1, 0x77, 0x70, 0xec, 0xe8, 0x24, 0x72,
-	0xb7, 0xf9, 0x29, 0xe4, 0xce, 0xeb, 0xf4, 0xfe, 0xef, 0xff, 0x7b, 0xff, 0xe1, 0xfd, 0x31, 0xdd,
-	0x40, 0x1e, 0x9c, 0xd9, 0xf0, 0x04, 0x0a, 0x6e, 0xa5, 0x93, 0x99, 0x9f, 0x58, 0x67, 0x82, 0x21,
-	0x07, 0x2d, 0x99, 0x24, 0x50, 0x9c, 0x1c, 0x29, 0xa3, 0x4c, 0xe3, 0xf3, 0x5a, 0xfd, 0x9f, 0x0c,
-	0x3f, 0x10, 0xee, 0xce, 0x9a, 0x0c, 0x39, 0xc5, 0xfb, 0x6b, 0x00, 0x11, 0x34, 0x38, 0x4f, 0xd1,
-	0xa0, 0x33, 0x8a, 0xe7, 0xbd, 0x35, 0xc0, 0xa2, 0xde, 0xc9, 0x10, 0x77, 0xad, 0xcc, 0x3d, 0x24,
-	0xb4, 0x33, 0x40, 0xa3, 0xde, 0x14, 0xff, 0x7e, 0xf7, 0x5b, 0x67, 0xde, 0x4e, 0x72, 0x86, 0x49,
-	0x26, 0x0b, 0xf1, 0xa0, 0x83, 0x17, 0x16, 0x9c, 0x58, 0xa6, 0x66, 0xf5, 0x48, 0xe3, 0x01, 0x1a,
-	0xc5, 0xf3, 0xc3, 0x4c, 0x16, 0xb7, 0x3a, 0xf8, 0x19, 0xb8, 0x69, 0x6d, 0x93, 0x4b, 0x4c, 0x95,
-	0x31, 0x89, 0x08, 0x3a, 0x15, 0x36, 0x77, 0x0a, 0x84, 0x4c, 0x53, 0xf3, 0x2c, 0x37, 0x2b, 0xa0,
-	0x7b, 0x4d, 0xe4, 0xb8, 0xe6, 0x0b, 0x9d, 0xce, 0x6a, 0x7a, 0xbd, 0x83, 0x57, 0xf1, 0xdb, 0x7b,
-	0x3f, 0x9a, 0xde, 0x7c, 0x96, 0x0c, 0x6d, 0x4b, 0x86, 0x7e, 0x4a, 0x86, 0x5e, 0x2b, 0x16, 0x6d,
-	0x2b, 0x16, 0x7d, 0x55, 0x2c, 0xba, 0x1b, 0x2b, 0x1d, 0xee, 0xf3, 0xe5, 0x64, 0x65, 0x32, 0xde,
-	0xfe, 0x3f, 0x36, 0x4e, 0xed, 0x34, 0x7f, 0xba, 0xe0, 0x45, 0x53, 0x55, 0x78, 0xb1, 0xe0, 0x97,
-	0xdd, 0xa6, 0x87, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x59, 0xc8, 0x6a, 0x46, 0x01,
-	0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6b, 0xdb, 0x48,
+	0x18, 0xb5, 0x88, 0xd7, 0xeb, 0x4c, 0x76, 0x37, 0x59, 0x91, 0x6c, 0xe4, 0x04, 0x6c, 0xe3, 0x93,
+	0x61, 0x89, 0xd4, 0xb4, 0xa1, 0x85, 0xd0, 0x16, 0xe2, 0x84, 0x14, 0x0a, 0x25, 0x46, 0x09, 0x14,
+	0x7a, 0x11, 0xa3, 0xd1, 0x67, 0x67, 0x9a, 0x91, 0x46, 0xcc, 0x8c, 0x62, 0xa7, 0x87, 0xfe, 0x86,
+	0x1e, 0x7b, 0x29, 0xf4, 0x9c, 0x5f, 0x92, 0x63, 0x8e, 0xa5, 0x07, 0xb7, 0x24, 0xb7, 0x1c, 0xfb,
+	0x0b, 0xca, 0x8c, 0xe4, 0x58, 0xa1, 0x85, 0x5e, 0x7a, 0x9a, 0xf1, 0xf7, 0xbe, 0x37, 0xcf, 0xf3,
+	0xbe, 0x37, 0x42, 0x4e, 0x02, 0x99, 0x12, 0x3c, 0xf1, 0x22, 0x18, 0x7b, 0x29, 0x16, 0x38, 0x96,
+	0x6e, 0x2a, 0xb8, 0xe2, 0xf6, 0x42, 0x81, 0xb8, 0x11, 0x8c, 0xd7, 0x9a, 0x84, 0xcb, 0x98, 0x4b,
+	0x2f, 0xc4, 0x12, 0xbc, 0xd3, 0xcd, 0x10, 0x14, 0xde, 0xf4, 0x08, 0xa7, 0x49, 0xde, 0xbc, 0xb6,
+	0x3c, 0xe4, 0x43, 0x6e, 0xb6, 0x9e, 0xde, 0xe5, 0xd5, 0xce, 0x79, 0x1d, 0xd5, 0xfa, 0xe6, 0x4c,
+	0x7b, 0x1d, 0xcd, 0x0f, 0x00, 0x02, 0x45, 0x41, 0x48, 0xc7, 0x6a, 0xcf, 0x75, 0xab, 0x7e, 0x7d,
+	0x00, 0x70, 0xa4, 0x7f, 0xdb, 0x1d, 0x54, 0x4b, 0x71, 0x26, 0x21, 0x72, 0xe6, 0xda, 0x56, 0xb7,
+	0xde, 0x43, 0x37, 0x93, 0x56, 0x51, 0xf1, 0x8b, 0xd5, 0xfe, 0x1f, 0xd9, 0x31, 0x1e, 0x07, 0xaf,
+	0xa9, 0x92, 0x41, 0x0a, 0x22, 0x08, 0x19, 0x27, 0x27, 0x4e, 0xb5, 0x6d, 0x75, 0xab, 0xfe, 0x62,
+	0x8c, 0xc7, 0xcf, 0xa9, 0x92, 0x7d, 0x10, 0x3d, 0x5d, 0xb6, 0x1f, 0x21, 0x67, 0xc8, 0x79, 0x14,
+	0x28, 0xca, 0x82, 0x34, 0x13, 0x43, 0x08, 0x30, 0x63, 0x7c, 0x84, 0x13, 0x02, 0xce, 0x1f, 0x86,
+	0xb2, 0xa2, 0xf1, 0x23, 0xca, 0xfa, 0x1a, 0xdd, 0x99, 0x82, 0xf6, 0x53, 0xb4, 0x4e, 0x78, 0x12,
+	0x51, 0x45, 0x79, 0x82, 0x59, 0xc0, 0x45, 0x04, 0xa2, 0xc4, 0xad, 0x19, 0x6e, 0xa3, 0xd4, 0x72,
+	0xa0, 0x3b, 0x66, 0xfc, 0x0f, 0x16, 0xb2, 0xcd, 0xdd, 0x09, 0x67, 0x81, 0xbe, 0xb0, 0x3c, 0xc6,
+	0x02, 0x9c, 0x3f, 0xdb, 0x56, 0x77, 0xbe, 0xc7, 0x2f, 0x26, 0xad, 0xca, 0xe7, 0x49, 0x6b, 0x6b,
+	0x48, 0xd5, 0x71, 0x16, 0xba, 0x84, 0xc7, 0x5e, 0x61, 0xf2, 0x06, 0x17, 0xc3, 0xe9, 0xde, 0x3b,
+	0xdd, 0xf2, 0x32, 0x45, 0x99, 0xf4, 0x62, 0xac, 0x8e, 0xdd, 0xbe, 0x00, 0xb2, 0x07, 0xe4, 0x66,
+	0xd2, 0xfa, 0xc9, 0xc9, 0xdf, 0x26, 0xad, 0xc6, 0x19, 0x8e, 0xd9, 0x76, 0xe7, 0x47, 0xac, 0xe3,
+	0x2f, 0x4d, 0x8b, 0xfb, 0x00, 0x87, 0xba, 0x64, 0x6f, 0xa1, 0xff, 0xee, 0x34, 0x12, 0xce, 0x18,
+	0x10, 0xc5, 0x85, 0x53, 0xd7, 0x7f, 0xd1, 0x5f, 0x2e, 0x31, 0x76, 0xa7, 0x98, 0xfd, 0x10, 0xad,
+	0x12, 0x2a, 0x48, 0x46, 0x55, 0x10, 0x0a, 0xc0, 0x27, 0xda, 0x93, 0x28, 0x12, 0x20, 0xa5, 0x33,
+	0x6f, 0x68, 0x2b, 0x05, 0xdc, 0xcb, 0xd1, 0x9d, 0x1c, 0xb4, 0x1f, 0xa3, 0x75, 0x3d, 0x33, 0x2e,
+	0x30, 0x61, 0x10, 0x08, 0x48, 0x61, 0x58, 0x1e, 0x1e, 0x32, 0x6e, 0xae, 0xc6, 0x78, 0x7c, 0x60,
+	0x3a, 0x7c, 0xd3, 0x70, 0x3b, 0xc4, 0xb7, 0x68, 0x25, 0xa6, 0x49, 0x10, 0x1b, 0xbd, 0x7c, 0x12,
+	0x92, 0xbe, 0x01, 0xe9, 0x2c, 0xb4, 0xe7, 0xba, 0x0b, 0xf7, 0x1b, 0x6e, 0x9e, 0x49, 0x57, 0x67,
+	0xd2, 0x2d, 0x32, 0xe9, 0xee, 0x72, 0x9a, 0xf4, 0xee, 0x69, 0xa3, 0xcf, 0xbf, 0xb4, 0xba, 0x25,
+	0xa3, 0x8b, 0x00, 0xe7, 0xcb, 0x86, 0x8c, 0x4e, 0x3c, 0x75, 0x96, 0x82, 0x34, 0x04, 0xe9, 0xdb,
+	0x31, 0x4d, 0x5e, 0x68, 0x21, 0x33, 0xcf, 0x43, 0x2d, 0x63, 0x8f, 0xd0, 0xbf, 0x5a, 0x3f, 0x82,
+	0x94, 0x4b, 0xaa, 0x0a, 0xed, 0xbf, 0x7e, 0xbf, 0xf6, 0x62, 0x4c, 0x93, 0xbd, 0x5c, 0x24, 0x17,
+	0xde, 0x46, 0x0d, 0x3d, 0x1b, 0x01, 0x84, 0xc7, 0x31, 0x24, 0x11, 0xd6, 0x51, 0x0b, 0x46, 0x34,
+	0x89, 0xf8, 0xc8, 0xf9, 0x3b, 0x37, 0x6d, 0x00, 0xe0, 0xdf, 0xc1, 0x5f, 0x1a, 0x58, 0x0f, 0xb8,
+	0x6c, 0x77, 0x29, 0xbb, 0xff, 0x18, 0xe2, 0x32, 0x9f, 0x59, 0x3d, 0x8b, 0xed, 0x93, 0x7c, 0x50,
+	0x03, 0xca, 0x98, 0x91, 0x15, 0x51, 0x20, 0xb3, 0x50, 0x12, 0x41, 0x43, 0xfd, 0x5e, 0x17, 0x0d,
+	0xd5, 0x89, 0xf1, 0x78, 0x9f, 0x32, 0xe6, 0x9b, 0x86, 0xc3, 0x19, 0xae, 0xe9, 0x65, 0x6a, 0x2a,
+	0xb2, 0xa4, 0xfc, 0xe2, 0x96, 0x72, 0xfa, 0xe0, 0x96, 0xdb, 0xd7, 0x0d, 0xb7, 0xea, 0xdb, 0xd5,
+	0xf7, 0x1f, 0x5b, 0x95, 0xde, 0xb3, 0x8b, 0xab, 0xa6, 0x75, 0x79, 0xd5, 0xb4, 0xbe, 0x5e, 0x35,
+	0xad, 0x77, 0xd7, 0xcd, 0xca, 0xe5, 0x75, 0xb3, 0xf2, 0xe9, 0xba, 0x59, 0x79, 0xb5, 0xf1, 0xeb,
+	0xf7, 0x32, 0x36, 0xdf, 0x2f, 0xe3, 0x6a, 0x58, 0x33, 0x19, 0x7e, 0xf0, 0x7d, 0x00, 0x8a, 0xa0,
+	0x09, 0x62, 0xdb, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +271,90 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FillRecordPruneAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FillRecordPruneAllowance))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxFillRecordSubscribers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFillRecordSubscribers))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OracleRepegAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OracleRepegAllowance))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FeeRecommendationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeRecommendationWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MinDepositSizes) > 0 {
+		for iNdEx := len(m.MinDepositSizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDepositSizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MinMakerOrderSizes) > 0 {
+		for iNdEx := len(m.MinMakerOrderSizes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinMakerOrderSizes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxOracleRepegsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOracleRepegsPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.CircuitBreakerAddress) > 0 {
+		i -= len(m.CircuitBreakerAddress)
+		copy(dAtA[i:], m.CircuitBreakerAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CircuitBreakerAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProtocolFeeCollector) > 0 {
+		i -= len(m.ProtocolFeeCollector)
+		copy(dAtA[i:], m.ProtocolFeeCollector)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ProtocolFeeCollector)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.ProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.ProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ConditionalOrderAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConditionalOrderAllowance))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.GoodTilPurgeAllowance != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GoodTilPurgeAllowance))
 		i--
@@ -215,6 +429,46 @@ func (m *Params) Size() (n int) {
 	if m.GoodTilPurgeAllowance != 0 {
 		n += 1 + sovParams(uint64(m.GoodTilPurgeAllowance))
 	}
+	if m.ConditionalOrderAllowance != 0 {
+		n += 1 + sovParams(uint64(m.ConditionalOrderAllowance))
+	}
+	l = m.ProtocolFeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.ProtocolFeeCollector)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.CircuitBreakerAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxOracleRepegsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxOracleRepegsPerBlock))
+	}
+	if len(m.MinMakerOrderSizes) > 0 {
+		for _, e := range m.MinMakerOrderSizes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MinDepositSizes) > 0 {
+		for _, e := range m.MinDepositSizes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FeeRecommendationWindow != 0 {
+		n += 1 + sovParams(uint64(m.FeeRecommendationWindow))
+	}
+	if m.OracleRepegAllowance != 0 {
+		n += 1 + sovParams(uint64(m.OracleRepegAllowance))
+	}
+	if m.MaxFillRecordSubscribers != 0 {
+		n += 1 + sovParams(uint64(m.MaxFillRecordSubscribers))
+	}
+	if m.FillRecordPruneAllowance != 0 {
+		n += 2 + sovParams(uint64(m.FillRecordPruneAllowance))
+	}
 	return n
 }
 
@@ -387,6 +641,286 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderAllowance", wireType)
+			}
+			m.ConditionalOrderAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionalOrderAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleRepegsPerBlock", wireType)
+			}
+			m.MaxOracleRepegsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOracleRepegsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMakerOrderSizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinMakerOrderSizes = append(m.MinMakerOrderSizes, types.Coin{})
+			if err := m.MinMakerOrderSizes[len(m.MinMakerOrderSizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositSizes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDepositSizes = append(m.MinDepositSizes, types.Coin{})
+			if err := m.MinDepositSizes[len(m.MinDepositSizes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecommendationWindow", wireType)
+			}
+			m.FeeRecommendationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRecommendationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRepegAllowance", wireType)
+			}
+			m.OracleRepegAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleRepegAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFillRecordSubscribers", wireType)
+			}
+			m.MaxFillRecordSubscribers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFillRecordSubscribers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillRecordPruneAllowance", wireType)
+			}
+			m.FillRecordPruneAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillRecordPruneAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+func (p OraclePeg) Validate() error {
+	if _, err := slinkytypes.CurrencyPairFromString(p.CurrencyPair); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidOraclePeg, "invalid currency pair (%s)", err)
+	}
+
+	if IsTickOutOfRange(p.OffsetBps) {
+		return sdkerrors.Wrapf(ErrInvalidOraclePeg, "offset_bps cannot exceed %d", MaxTickExp)
+	}
+
+	return nil
+}
+
+// TickIndexInToOut returns the TickIndexInToOut of a limit order pegged to an oracle price. Since a higher sell
+// price is a lower tick, a positive offset lowers the tick.
+func (p OraclePeg) TickIndexInToOut(oraclePrice math_utils.PrecDec) (int64, error) {
+	tickIndex, err := CalcTickIndexFromPrice(oraclePrice)
+	if err != nil {
+		return 0, err
+	}
+
+	tickIndex -= p.OffsetBps
+	if IsTickOutOfRange(tickIndex) {
+		return 0, ErrTickOutsideRange
+	}
+
+	return tickIndex, nil
+}
@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/pegged_limit_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PeggedLimitOrder tracks the tranche currently holding a limit order that is re-priced with an oracle.
+type PeggedLimitOrder struct {
+	// Owner of the order's LimitOrderTrancheUser
+	Address    string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TrancheKey *LimitOrderTrancheKey `protobuf:"bytes,2,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	OraclePeg  *OraclePeg            `protobuf:"bytes,3,opt,name=oracle_peg,json=oraclePeg,proto3" json:"oracle_peg,omitempty"`
+}
+
+func (m *PeggedLimitOrder) Reset()         { *m = PeggedLimitOrder{} }
+func (m *PeggedLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*PeggedLimitOrder) ProtoMessage()    {}
+func (*PeggedLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13c46b65e862b363, []int{0}
+}
+func (m *PeggedLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeggedLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeggedLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeggedLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeggedLimitOrder.Merge(m, src)
+}
+func (m *PeggedLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeggedLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeggedLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeggedLimitOrder proto.InternalMessageInfo
+
+func (m *PeggedLimitOrder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeggedLimitOrder) GetTrancheKey() *LimitOrderTrancheKey {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return nil
+}
+
+func (m *PeggedLimitOrder) GetOraclePeg() *OraclePeg {
+	if m != nil {
+		return m.OraclePeg
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PeggedLimitOrder)(nil), "neutron.dex.PeggedLimitOrder")
+}
+
+func init() {
+	proto.RegisterFile("neutron/dex/pegged_limit_order.proto", fileDescriptor_13c46b65e862b363)
+}
+
+var fileDescriptor_13c46b65e862b363 = []byte{
+	// 257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4b, 0x2d, 0x2d,
+	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x49, 0xad, 0xd0, 0x2f, 0x48, 0x4d, 0x4f, 0x4f, 0x4d, 0x89, 0xcf,
+	0xc9, 0xcc, 0xcd, 0x2c, 0x89, 0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0xe2, 0x86, 0xaa, 0xd2, 0x4b, 0x49, 0xad, 0x90, 0x52, 0x45, 0xd6, 0x82, 0xa4, 0x36, 0xbe,
+	0xa4, 0x28, 0x31, 0x2f, 0x39, 0x23, 0x15, 0xa2, 0x47, 0x4a, 0x04, 0x59, 0x59, 0x49, 0x05, 0x44,
+	0x54, 0x69, 0x39, 0x23, 0x97, 0x40, 0x00, 0xd8, 0x1a, 0x1f, 0x90, 0x4e, 0x7f, 0x90, 0x46, 0x21,
+	0x09, 0x2e, 0xf6, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce,
+	0x20, 0x18, 0x57, 0xc8, 0x89, 0x8b, 0x1b, 0x6a, 0x6a, 0x7c, 0x76, 0x6a, 0xa5, 0x04, 0x93, 0x02,
+	0xa3, 0x06, 0xb7, 0x91, 0xa2, 0x1e, 0x92, 0x73, 0xf4, 0x10, 0xe6, 0x84, 0x40, 0x54, 0x7a, 0xa7,
+	0x56, 0x06, 0x71, 0x95, 0xc0, 0xd9, 0x42, 0xa6, 0x5c, 0x5c, 0xf9, 0x45, 0x89, 0xc9, 0x39, 0xa9,
+	0xf1, 0x05, 0xa9, 0xe9, 0x12, 0xcc, 0x60, 0x23, 0xc4, 0x50, 0x8c, 0xf0, 0x07, 0x4b, 0x07, 0xa4,
+	0xa6, 0x07, 0x71, 0xe6, 0xc3, 0x98, 0x4e, 0xee, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
+	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
+	0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x35,
+	0x46, 0x37, 0xbf, 0x28, 0x1d, 0xc6, 0xd6, 0x2f, 0x33, 0xd1, 0xaf, 0x80, 0xf8, 0xba, 0xb2, 0x20,
+	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x73, 0x63, 0xc0, 0x00, 0x48, 0xea, 0x07, 0xc9, 0x6b, 0x01, 0x00,
+	0x00,
+}
+
+func (m *PeggedLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeggedLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeggedLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OraclePeg != nil {
+		{
+			size, err := m.OraclePeg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPeggedLimitOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TrancheKey != nil {
+		{
+			size, err := m.TrancheKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPeggedLimitOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPeggedLimitOrder(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeggedLimitOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeggedLimitOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PeggedLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPeggedLimitOrder(uint64(l))
+	}
+	if m.TrancheKey != nil {
+		l = m.TrancheKey.Size()
+		n += 1 + l + sovPeggedLimitOrder(uint64(l))
+	}
+	if m.OraclePeg != nil {
+		l = m.OraclePeg.Size()
+		n += 1 + l + sovPeggedLimitOrder(uint64(l))
+	}
+	return n
+}
+
+func sovPeggedLimitOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeggedLimitOrder(x uint64) (n int) {
+	return sovPeggedLimitOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PeggedLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeggedLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeggedLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeggedLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeggedLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeggedLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrancheKey == nil {
+				m.TrancheKey = &LimitOrderTrancheKey{}
+			}
+			if err := m.TrancheKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePeg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeggedLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OraclePeg == nil {
+				m.OraclePeg = &OraclePeg{}
+			}
+			if err := m.OraclePeg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeggedLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeggedLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeggedLimitOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeggedLimitOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeggedLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeggedLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeggedLimitOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeggedLimitOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeggedLimitOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeggedLimitOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeggedLimitOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeggedLimitOrder = fmt.Errorf("proto: unexpected end of group")
+)
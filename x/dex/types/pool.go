@@ -107,6 +107,35 @@ func (p *Pool) Swap(
 	return amountTakerIn, amountMakerOut
 }
 
+// TakeProtocolFee removes protocolFeeShare of the LP fee earned by a swap of amountTakerIn from the pool's taker
+// reserves and returns the amount removed. The LP fee is the difference between amountTakerIn and the amount that
+// would have been paid at the pool's center tick.
+func (p *Pool) TakeProtocolFee(
+	tradePairID *TradePairID,
+	amountTakerIn math.Int,
+	protocolFeeShare math_utils.PrecDec,
+) math.Int {
+	if protocolFeeShare.IsNil() || !protocolFeeShare.IsPositive() || !amountTakerIn.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	var takerReserves *PoolReserves
+	if tradePairID.IsMakerDenomToken0() {
+		takerReserves = p.UpperTick1
+	} else {
+		takerReserves = p.LowerTick0
+	}
+
+	feeInt64 := utils.MustSafeUint64ToInt64(p.Fee())
+	lpFeeRate := math_utils.OnePrecDec().Sub(MustCalcPrice(feeInt64))
+	protocolFee := lpFeeRate.Mul(protocolFeeShare).MulInt(amountTakerIn).TruncateInt()
+	protocolFee = math.MinInt(protocolFee, takerReserves.ReservesMakerDenom)
+
+	takerReserves.ReservesMakerDenom = takerReserves.ReservesMakerDenom.Sub(protocolFee)
+
+	return protocolFee
+}
+
 // Mutates the Pool object and returns relevant change variables. Deposit is not committed until
 // pool.save() is called or the underlying ticks are saved; this method does not use any keeper methods.
 func (p *Pool) Deposit(
@@ -114,7 +143,7 @@ func (p *Pool) Deposit(
 	maxAmount1,
 	existingShares math.Int,
 	autoswap bool,
-) (inAmount0, inAmount1 math.Int, outShares sdk.Coin) {
+) (inAmount0, inAmount1, autoswapped0, autoswapped1 math.Int, outShares sdk.Coin) {
 	lowerReserve0 := &p.LowerTick0.ReservesMakerDenom
 	upperReserve1 := &p.UpperTick1.ReservesMakerDenom
 
@@ -126,10 +155,11 @@ func (p *Pool) Deposit(
 	)
 
 	if inAmount0.Equal(math.ZeroInt()) && inAmount1.Equal(math.ZeroInt()) {
-		return math.ZeroInt(), math.ZeroInt(), sdk.Coin{Denom: p.GetPoolDenom()}
+		return math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), sdk.Coin{Denom: p.GetPoolDenom()}
 	}
 
 	outShares = p.CalcSharesMinted(inAmount0, inAmount1, existingShares)
+	autoswapped0, autoswapped1 = math.ZeroInt(), math.ZeroInt()
 
 	if autoswap {
 		residualAmount0 := maxAmount0.Sub(inAmount0)
@@ -144,12 +174,14 @@ func (p *Pool) Deposit(
 
 		inAmount0 = maxAmount0
 		inAmount1 = maxAmount1
+		autoswapped0 = residualAmount0
+		autoswapped1 = residualAmount1
 	}
 
 	*lowerReserve0 = lowerReserve0.Add(inAmount0)
 	*upperReserve1 = upperReserve1.Add(inAmount1)
 
-	return inAmount0, inAmount1, outShares
+	return inAmount0, inAmount1, autoswapped0, autoswapped1, outShares
 }
 
 func (p *Pool) GetPoolDenom() string {
@@ -199,12 +231,11 @@ func (p *Pool) CalcResidualSharesMinted(
 	residualAmount0 math.Int,
 	residualAmount1 math.Int,
 ) (sharesMinted sdk.Coin, err error) {
-	fee := CalcFee(p.UpperTick1.Key.TickIndexTakerToMaker, p.LowerTick0.Key.TickIndexTakerToMaker)
 	valueMintedToken0, err := CalcResidualValue(
 		residualAmount0,
 		residualAmount1,
 		p.LowerTick0.PriceTakerToMaker,
-		fee,
+		utils.MustSafeUint64ToInt64(p.Fee()),
 	)
 	if err != nil {
 		return sdk.Coin{Denom: p.GetPoolDenom()}, err
@@ -273,7 +304,7 @@ func CalcResidualValue(
 	fee int64,
 ) (math_utils.PrecDec, error) {
 	// ResidualValue = Amount0 * (Price1to0Center / Price1to0Upper) + Amount1 * Price1to0Lower
-	amount0Discount, err := CalcPrice(-fee)
+	amount0Discount, err := CalcPrice(fee)
 	if err != nil {
 		return math_utils.ZeroPrecDec(), err
 	}
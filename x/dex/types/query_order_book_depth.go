@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (req *QueryOrderBookDepthRequest) Validate() error {
+	if err := sdk.ValidateDenom(req.TokenIn); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid token in denom (%s)", err)
+	}
+	if err := sdk.ValidateDenom(req.TokenOut); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid token out denom (%s)", err)
+	}
+	if req.TokenIn == req.TokenOut {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenIn cannot equal tokenOut")
+	}
+	if req.BucketWidth > MaxOrderBookDepthBucketWidth {
+		return sdkerrors.Wrapf(ErrInvalidBucketWidth, "max %d, got %d", MaxOrderBookDepthBucketWidth, req.BucketWidth)
+	}
+	if req.MaxBuckets > MaxOrderBookDepthBuckets {
+		return sdkerrors.Wrapf(ErrInvalidMaxBuckets, "max %d, got %d", MaxOrderBookDepthBuckets, req.MaxBuckets)
+	}
+	return nil
+}
+
+// DepthBucketBounds returns the inclusive range of ticks of the bucket of width bucketWidth that contains tickIndex.
+// Buckets are aligned to multiples of bucketWidth and clamped to the valid tick range.
+func DepthBucketBounds(tickIndex, bucketWidth int64) (start, end int64) {
+	start = tickIndex / bucketWidth * bucketWidth
+	if tickIndex < 0 && tickIndex%bucketWidth != 0 {
+		start -= bucketWidth
+	}
+	end = start + bucketWidth - 1
+
+	start = max(start, -int64(MaxTickExp))
+	end = min(end, int64(MaxTickExp))
+
+	return start, end
+}
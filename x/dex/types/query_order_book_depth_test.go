@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func TestDepthBucketBounds(t *testing.T) {
+	for _, tc := range []struct {
+		desc          string
+		tick          int64
+		width         int64
+		expectedStart int64
+		expectedEnd   int64
+	}{
+		{
+			desc:          "single tick",
+			tick:          -7,
+			width:         1,
+			expectedStart: -7,
+			expectedEnd:   -7,
+		},
+		{
+			desc:          "positive tick",
+			tick:          15,
+			width:         10,
+			expectedStart: 10,
+			expectedEnd:   19,
+		},
+		{
+			desc:          "negative tick",
+			tick:          -15,
+			width:         10,
+			expectedStart: -20,
+			expectedEnd:   -11,
+		},
+		{
+			desc:          "negative tick on boundary",
+			tick:          -20,
+			width:         10,
+			expectedStart: -20,
+			expectedEnd:   -11,
+		},
+		{
+			desc:          "zero",
+			tick:          0,
+			width:         10,
+			expectedStart: 0,
+			expectedEnd:   9,
+		},
+		{
+			desc:          "clamped to max tick",
+			tick:          int64(types.MaxTickExp),
+			width:         1000,
+			expectedStart: 559_000,
+			expectedEnd:   int64(types.MaxTickExp),
+		},
+		{
+			desc:          "clamped to min tick",
+			tick:          -int64(types.MaxTickExp),
+			width:         1000,
+			expectedStart: -int64(types.MaxTickExp),
+			expectedEnd:   -559_001,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			start, end := types.DepthBucketBounds(tc.tick, tc.width)
+			require.Equal(t, tc.expectedStart, start)
+			require.Equal(t, tc.expectedEnd, end)
+		})
+	}
+}
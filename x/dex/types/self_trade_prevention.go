@@ -0,0 +1,10 @@
+package types
+
+func (s SelfTradePrevention) AllowsSelfTrade() bool {
+	return s == SelfTradePrevention_ALLOW_SELF_TRADE
+}
+
+func (s SelfTradePrevention) IsValid() bool {
+	_, ok := SelfTradePrevention_name[int32(s)]
+	return ok
+}
@@ -42,3 +42,15 @@ func (t TickLiquidity) HasToken() bool {
 		panic("Tick does not contain valid liqudityType")
 	}
 }
+
+func (t TickLiquidity) TradePairID() *TradePairID {
+	switch liquidity := t.Liquidity.(type) {
+	case *TickLiquidity_LimitOrderTranche:
+		return liquidity.LimitOrderTranche.Key.TradePairId
+
+	case *TickLiquidity_PoolReserves:
+		return liquidity.PoolReserves.Key.TradePairId
+	default:
+		panic("Tick does not contain valid liqudityType")
+	}
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+func NewTwapRecord(
+	tradePairID *TradePairID,
+	recordTime time.Time,
+	height int64,
+	price math_utils.PrecDec,
+	tickIndexTakerToMaker int64,
+) TwapRecord {
+	return TwapRecord{
+		TradePairId:                  tradePairID,
+		Time:                         recordTime,
+		Height:                       height,
+		Price:                        price,
+		TickIndexTakerToMaker:        tickIndexTakerToMaker,
+		ArithmeticAccumulator:        math_utils.ZeroPrecDec(),
+		TickAccumulator:              math.ZeroInt(),
+		SquaredTickChangeAccumulator: math.ZeroInt(),
+	}
+}
+
+// AccumulatorsAt returns the value of the record's accumulators at time t, assuming the spot price
+// did not change between the record's time and t.
+func (r TwapRecord) AccumulatorsAt(t time.Time) (arithmetic math_utils.PrecDec, tick math.Int) {
+	elapsedMs := t.Sub(r.Time).Milliseconds()
+	arithmetic = r.ArithmeticAccumulator.Add(r.Price.MulInt64(elapsedMs))
+	tick = r.TickAccumulator.Add(math.NewInt(r.TickIndexTakerToMaker).MulRaw(elapsedMs))
+
+	return arithmetic, tick
+}
+
+// squaredTickChangeAccumulator returns the record's squared tick change accumulator, which is unset for records
+// written before it was introduced.
+func (r TwapRecord) squaredTickChangeAccumulator() math.Int {
+	if r.SquaredTickChangeAccumulator.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return r.SquaredTickChangeAccumulator
+}
+
+// Advance returns a new record at time t with the accumulators updated and the given spot price.
+func (r TwapRecord) Advance(
+	t time.Time,
+	height int64,
+	price math_utils.PrecDec,
+	tickIndexTakerToMaker int64,
+) TwapRecord {
+	arithmetic, tick := r.AccumulatorsAt(t)
+	tickChange := math.NewInt(tickIndexTakerToMaker - r.TickIndexTakerToMaker)
+
+	return TwapRecord{
+		TradePairId:                  r.TradePairId,
+		Time:                         t,
+		Height:                       height,
+		Price:                        price,
+		TickIndexTakerToMaker:        tickIndexTakerToMaker,
+		ArithmeticAccumulator:        arithmetic,
+		TickAccumulator:              tick,
+		SquaredTickChangeAccumulator: r.squaredTickChangeAccumulator().Add(tickChange.Mul(tickChange)),
+	}
+}
+
+// ComputeArithmeticTwap returns the arithmetic mean price between startTime and endTime. startRecord and endRecord
+// must be the latest records at or before startTime and endTime respectively.
+func ComputeArithmeticTwap(
+	startRecord, endRecord TwapRecord,
+	startTime, endTime time.Time,
+) math_utils.PrecDec {
+	durationMs := endTime.Sub(startTime).Milliseconds()
+	if durationMs <= 0 {
+		return startRecord.Price
+	}
+
+	startAcc, _ := startRecord.AccumulatorsAt(startTime)
+	endAcc, _ := endRecord.AccumulatorsAt(endTime)
+
+	return endAcc.Sub(startAcc).QuoInt64(durationMs)
+}
+
+// ComputeGeometricTwap returns the geometric mean price between startTime and endTime. Since prices are
+// exponential in the tick index, this is the price at the time weighted mean tick, rounded to the nearest tick.
+// startRecord and endRecord must be the latest records at or before startTime and endTime respectively.
+func ComputeGeometricTwap(
+	startRecord, endRecord TwapRecord,
+	startTime, endTime time.Time,
+) (math_utils.PrecDec, error) {
+	durationMs := endTime.Sub(startTime).Milliseconds()
+	if durationMs <= 0 {
+		return CalcPrice(startRecord.TickIndexTakerToMaker)
+	}
+
+	_, startAcc := startRecord.AccumulatorsAt(startTime)
+	_, endAcc := endRecord.AccumulatorsAt(endTime)
+	meanTick := math_utils.NewPrecDecFromInt(endAcc.Sub(startAcc)).QuoInt64(durationMs).RoundInt64()
+
+	return CalcPrice(meanTick)
+}
+
+// ComputeRealizedVolatility returns the realized volatility of the price in ticks between the times of startRecord
+// and endRecord, which is the square root of the sum of the squared tick changes between them.
+func ComputeRealizedVolatility(startRecord, endRecord TwapRecord) (math_utils.PrecDec, error) {
+	sumSquares := endRecord.squaredTickChangeAccumulator().Sub(startRecord.squaredTickChangeAccumulator())
+
+	return math_utils.NewPrecDecFromInt(sumSquares).ApproxSqrt()
+}
+
+// RecommendFeeTier returns the smallest of feeTiers that is at least volatility, or the largest of feeTiers if
+// volatility exceeds all of them. A pool with such a fee is not arbitraged by a typical price move. feeTiers must
+// not be empty.
+func RecommendFeeTier(feeTiers []uint64, volatility math_utils.PrecDec) uint64 {
+	sortedTiers := slices.Clone(feeTiers)
+	slices.Sort(sortedTiers)
+
+	for _, fee := range sortedTiers {
+		if math_utils.NewPrecDecFromInt(math.NewIntFromUint64(fee)).GTE(volatility) {
+			return fee
+		}
+	}
+
+	return sortedTiers[len(sortedTiers)-1]
+}
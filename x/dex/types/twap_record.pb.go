@@ -0,0 +1,671 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/twap_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	cosmossdk_io_math "cosmossdk.io/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+
+	github_com_neutron_org_neutron_v4_utils_math "github.com/neutron-org/neutron/v4/utils/math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TwapRecord is a snapshot of the cumulative price of a TradePairID. A new record is written
+// whenever a swap moves the price of the TradePairID.
+type TwapRecord struct {
+	TradePairId *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	Time        time.Time    `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	Height      int64        `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Spot price (maker denom per taker denom) from time onwards
+	Price github_com_neutron_org_neutron_v4_utils_math.PrecDec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/neutron-org/neutron/v4/utils/math.PrecDec" json:"price" yaml:"price"`
+	// TakerToMaker tick index of the spot price from time onwards
+	TickIndexTakerToMaker int64 `protobuf:"varint,5,opt,name=tick_index_taker_to_maker,json=tickIndexTakerToMaker,proto3" json:"tick_index_taker_to_maker,omitempty"`
+	// Sum of price * milliseconds elapsed up to time
+	ArithmeticAccumulator github_com_neutron_org_neutron_v4_utils_math.PrecDec `protobuf:"bytes,6,opt,name=arithmetic_accumulator,json=arithmeticAccumulator,proto3,customtype=github.com/neutron-org/neutron/v4/utils/math.PrecDec" json:"arithmetic_accumulator" yaml:"arithmetic_accumulator"`
+	// Sum of tick_index_taker_to_maker * milliseconds elapsed up to time
+	TickAccumulator cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=tick_accumulator,json=tickAccumulator,proto3,customtype=cosmossdk.io/math.Int" json:"tick_accumulator" yaml:"tick_accumulator"`
+	// Sum of the squared changes of tick_index_taker_to_maker between blocks up to time
+	SquaredTickChangeAccumulator cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=squared_tick_change_accumulator,json=squaredTickChangeAccumulator,proto3,customtype=cosmossdk.io/math.Int" json:"squared_tick_change_accumulator" yaml:"squared_tick_change_accumulator"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d67b3f7ce22ab0d1, []int{0}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+func (m *TwapRecord) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *TwapRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TwapRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TwapRecord) GetTickIndexTakerToMaker() int64 {
+	if m != nil {
+		return m.TickIndexTakerToMaker
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TwapRecord)(nil), "neutron.dex.TwapRecord")
+}
+
+func init() { proto.RegisterFile("neutron/dex/twap_record.proto", fileDescriptor_d67b3f7ce22ab0d1) }
+
+var fileDescriptor_d67b3f7ce22ab0d1 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xb6, 0x95, 0xe1, 0x82, 0x40, 0x11, 0x1d, 0xa1, 0x62, 0x71, 0x95, 0x03, 0xea,
+	0x65, 0x8e, 0x04, 0x3b, 0x4c, 0x88, 0x0b, 0x65, 0x12, 0xaa, 0x04, 0xd2, 0x14, 0x85, 0x0b, 0x97,
+	0xc8, 0x75, 0x4c, 0x62, 0xda, 0xc4, 0xc1, 0x71, 0x68, 0xf7, 0x2d, 0xf6, 0x15, 0xf8, 0x02, 0x48,
+	0x7c, 0x8b, 0x1d, 0x77, 0x44, 0x1c, 0x02, 0x6a, 0x6f, 0x3b, 0xf6, 0x13, 0x20, 0x3b, 0x29, 0x6b,
+	0xf9, 0xa3, 0x1e, 0x76, 0x8a, 0xdf, 0xe7, 0x79, 0xde, 0xf7, 0xfd, 0x59, 0x91, 0xc1, 0x7e, 0x4a,
+	0x0b, 0x29, 0x78, 0xea, 0x86, 0x74, 0xea, 0xca, 0x09, 0xce, 0x02, 0x41, 0x09, 0x17, 0x21, 0xca,
+	0x04, 0x97, 0xdc, 0x6c, 0xd5, 0x36, 0x0a, 0xe9, 0xb4, 0x73, 0x3f, 0xe2, 0x11, 0xd7, 0xba, 0xab,
+	0x4e, 0x55, 0xa4, 0x03, 0x23, 0xce, 0xa3, 0x31, 0x75, 0x75, 0x35, 0x2c, 0xde, 0xbb, 0x92, 0x25,
+	0x34, 0x97, 0x38, 0xc9, 0x96, 0x81, 0xb5, 0x15, 0x02, 0x87, 0x34, 0xc8, 0x30, 0x13, 0x01, 0xab,
+	0x97, 0x38, 0x9f, 0x9b, 0x00, 0xf8, 0x13, 0x9c, 0x79, 0x7a, 0xb3, 0xf9, 0x1c, 0xdc, 0x59, 0x4b,
+	0x59, 0x46, 0xd7, 0xe8, 0xb5, 0x9e, 0x58, 0x68, 0x85, 0x05, 0xf9, 0x2a, 0x71, 0x82, 0x99, 0x18,
+	0x1c, 0x7b, 0x2d, 0xf9, 0xbb, 0x08, 0xcd, 0x23, 0xb0, 0xad, 0x00, 0xac, 0x1b, 0xba, 0xa9, 0x83,
+	0x2a, 0x3a, 0xb4, 0xa4, 0x43, 0xfe, 0x92, 0xae, 0xbf, 0x7b, 0x5e, 0xc2, 0xc6, 0xd9, 0x0f, 0x68,
+	0x78, 0xba, 0xc3, 0xdc, 0x03, 0xcd, 0x98, 0xb2, 0x28, 0x96, 0xd6, 0x56, 0xd7, 0xe8, 0x6d, 0x79,
+	0x75, 0x65, 0x8e, 0xc0, 0x4e, 0x26, 0x18, 0xa1, 0xd6, 0x76, 0xd7, 0xe8, 0xdd, 0xea, 0xbf, 0x55,
+	0x6d, 0xdf, 0x4b, 0x78, 0x18, 0x31, 0x19, 0x17, 0x43, 0x44, 0x78, 0xe2, 0xd6, 0x64, 0x07, 0x5c,
+	0x44, 0xcb, 0xb3, 0xfb, 0xe9, 0xd0, 0x2d, 0x24, 0x1b, 0xe7, 0x6e, 0x82, 0x65, 0x8c, 0x4e, 0x04,
+	0x25, 0xc7, 0x94, 0x5c, 0x96, 0xb0, 0x1a, 0xb6, 0x28, 0xe1, 0xed, 0x53, 0x9c, 0x8c, 0x9f, 0x39,
+	0xba, 0x74, 0xbc, 0x4a, 0x36, 0x8f, 0xc0, 0x43, 0xc9, 0xc8, 0x28, 0x60, 0x69, 0x48, 0xa7, 0x81,
+	0xc4, 0x23, 0x2a, 0x02, 0xc9, 0x83, 0x44, 0x1d, 0xac, 0x1d, 0xcd, 0xd5, 0x56, 0x81, 0x81, 0xf2,
+	0x7d, 0xa5, 0xfa, 0xfc, 0x8d, 0xfa, 0x98, 0x5f, 0x0c, 0xb0, 0x87, 0x05, 0x93, 0x71, 0x42, 0x25,
+	0x23, 0x01, 0x26, 0xa4, 0x48, 0x8a, 0x31, 0x96, 0x5c, 0x58, 0x4d, 0x0d, 0x3e, 0xb9, 0x26, 0xf8,
+	0x7f, 0xa6, 0x2f, 0x4a, 0xb8, 0x5f, 0xdd, 0xe4, 0xdf, 0xbe, 0xe3, 0xb5, 0xaf, 0x8c, 0x17, 0x57,
+	0xba, 0x39, 0x01, 0xf7, 0xf4, 0x55, 0x57, 0x49, 0x6f, 0x6a, 0xd2, 0xd7, 0x35, 0x69, 0x9b, 0xf0,
+	0x3c, 0xe1, 0x79, 0x1e, 0x8e, 0x10, 0xe3, 0x15, 0xce, 0x20, 0x95, 0x97, 0x25, 0xfc, 0xab, 0x71,
+	0x51, 0xc2, 0x07, 0x15, 0xc4, 0x9f, 0x8e, 0xe3, 0xdd, 0x55, 0xd2, 0xea, 0xe2, 0xaf, 0x06, 0x80,
+	0xf9, 0xc7, 0x02, 0x0b, 0x1a, 0x06, 0x3a, 0x4e, 0x62, 0x9c, 0x46, 0x74, 0x0d, 0x64, 0x57, 0x83,
+	0x7c, 0xd8, 0x04, 0xb2, 0x69, 0xce, 0xa2, 0x84, 0x8f, 0x2b, 0xae, 0x0d, 0x41, 0xc7, 0x7b, 0x54,
+	0x27, 0x7c, 0x46, 0x46, 0x2f, 0xb5, 0xbf, 0xc2, 0xdc, 0x7f, 0x75, 0x3e, 0xb3, 0x8d, 0x8b, 0x99,
+	0x6d, 0xfc, 0x9c, 0xd9, 0xc6, 0xd9, 0xdc, 0x6e, 0x5c, 0xcc, 0xed, 0xc6, 0xb7, 0xb9, 0xdd, 0x78,
+	0x77, 0xb0, 0xf9, 0x77, 0x4e, 0xab, 0xa7, 0x77, 0x9a, 0xd1, 0x7c, 0xd8, 0xd4, 0x2f, 0xe1, 0xe9,
+	0xaf, 0x01, 0x00, 0x14, 0x91, 0xa6, 0xc2, 0xf9, 0x03, 0x00, 0x00,
+}
+
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SquaredTickChangeAccumulator.Size()
+		i -= size
+		if _, err := m.SquaredTickChangeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TickAccumulator.Size()
+		i -= size
+		if _, err := m.TickAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ArithmeticAccumulator.Size()
+		i -= size
+		if _, err := m.ArithmeticAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TickIndexTakerToMaker != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.TickIndexTakerToMaker))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwapRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTwapRecord(uint64(m.Height))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.TickIndexTakerToMaker != 0 {
+		n += 1 + sovTwapRecord(uint64(m.TickIndexTakerToMaker))
+	}
+	l = m.ArithmeticAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.TickAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.SquaredTickChangeAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func sovTwapRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwapRecord(x uint64) (n int) {
+	return sovTwapRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexTakerToMaker", wireType)
+			}
+			m.TickIndexTakerToMaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexTakerToMaker |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquaredTickChangeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquaredTickChangeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwapRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwapRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwapRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwapRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwapRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwapRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwapRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -203,3 +203,43 @@ func (t *QueryAllLimitOrderTrancheUserByAddressResponse) MarshalBinding() ([]byt
 	}
 	return json.Marshal(&allLimitOrders)
 }
+
+type ConditionalOrderBinding struct {
+	ConditionalOrder
+	OrderType string `json:"order_type"`
+	Trigger   string `json:"trigger"`
+}
+
+func (t ConditionalOrder) ToBinding() ConditionalOrderBinding {
+	return ConditionalOrderBinding{
+		ConditionalOrder: t,
+		OrderType:        t.OrderType.String(),
+		Trigger:          t.Trigger.String(),
+	}
+}
+
+func (t *QueryGetConditionalOrderResponse) MarshalBinding() ([]byte, error) {
+	type QueryGetConditionalOrderResponseBinding struct {
+		ConditionalOrder ConditionalOrderBinding `json:"conditional_order"`
+	}
+
+	q := QueryGetConditionalOrderResponseBinding{ConditionalOrder: t.ConditionalOrder.ToBinding()}
+	return json.Marshal(&q)
+}
+
+func (t *QueryAllConditionalOrderResponse) MarshalBinding() ([]byte, error) {
+	type QueryAllConditionalOrderResponseBinding struct {
+		ConditionalOrder []ConditionalOrderBinding `json:"conditional_order"`
+		Pagination       *query.PageResponse       `json:"pagination,omitempty"`
+	}
+
+	q := QueryAllConditionalOrderResponseBinding{
+		ConditionalOrder: make([]ConditionalOrderBinding, 0, len(t.ConditionalOrder)),
+		Pagination:       t.Pagination,
+	}
+	for _, order := range t.ConditionalOrder {
+		q.ConditionalOrder = append(q.ConditionalOrder, order.ToBinding())
+	}
+
+	return json.Marshal(&q)
+}
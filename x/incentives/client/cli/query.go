@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(_ string) *cobra.Command {
+	// Group incentives queries under a subcommand
+	cmd := &cobra.Command{
+		Use: types.ModuleName,
+		Short: fmt.Sprintf(
+			"Querying commands for the %s module",
+			types.ModuleName,
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdListGauge())
+	cmd.AddCommand(CmdShowGauge())
+	cmd.AddCommand(CmdShowStake())
+	cmd.AddCommand(CmdListStakesByOwner())
+	cmd.AddCommand(CmdShowAccountRewards())
+	// this line is used by starport scaffolding # 1
+
+	return cmd
+}
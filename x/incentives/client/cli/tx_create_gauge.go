@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+func CmdCreateGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll
+		Use:     "create-gauge [token-a] [token-b] [start-tick] [end-tick] [coins] [num-epochs] ?[start-height] ?[weight-by-distance]",
+		Short:   "Broadcast message CreateGauge which pays coins over num-epochs to stakers of pool shares between start-tick and end-tick",
+		Example: "create-gauge tokenA tokenB -10 10 1000untrn 30 0 true --from alice",
+		Args:    cobra.RangeArgs(6, 8),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			pairID, err := dextypes.NewPairIDFromUnsorted(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			startTick, err := strconv.ParseInt(args[2], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			endTick, err := strconv.ParseInt(args[3], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			numEpochs, err := strconv.ParseUint(args[5], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			var startHeight int64
+			if len(args) > 6 {
+				startHeight, err = strconv.ParseInt(args[6], 10, 0)
+				if err != nil {
+					return err
+				}
+			}
+
+			var weightByDistance bool
+			if len(args) > 7 {
+				weightByDistance, err = strconv.ParseBool(args[7])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGauge(
+				clientCtx.GetFromAddress().String(),
+				types.QueryCondition{
+					PairId:    pairID,
+					StartTick: startTick,
+					EndTick:   endTick,
+				},
+				coins,
+				startHeight,
+				numEpochs,
+				weightByDistance,
+			)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package incentives
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/keeper"
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the gauges
+	for _, elem := range genState.Gauges {
+		k.SetGauge(ctx, elem)
+	}
+	k.SetNextGaugeID(ctx, genState.NextGaugeId)
+	// Set all the stakes
+	for _, elem := range genState.Stakes {
+		k.SetStake(ctx, elem)
+	}
+	k.SetNextStakeID(ctx, genState.NextStakeId)
+	// Set the distribution in progress
+	if genState.DistributionState != nil {
+		k.SetDistributionState(ctx, *genState.DistributionState)
+	}
+	for _, elem := range genState.StakeWeights {
+		k.SetStakeWeight(ctx, elem)
+	}
+	// Set all the accountRewards
+	for _, elem := range genState.AccountRewards {
+		k.SetAccountRewards(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	err := k.SetParams(ctx, genState.Params)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.Gauges = k.GetAllGauge(ctx)
+	genesis.NextGaugeId = k.GetNextGaugeID(ctx)
+	genesis.Stakes = k.GetAllStake(ctx)
+	genesis.NextStakeId = k.GetNextStakeID(ctx)
+	if state, found := k.GetDistributionState(ctx); found {
+		genesis.DistributionState = &state
+	}
+	genesis.StakeWeights = k.GetAllStakeWeight(ctx)
+	genesis.AccountRewards = k.GetAllAccountRewards(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,93 @@
+package incentives_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v4/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v4/testutil/incentives/keeper"
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	"github.com/neutron-org/neutron/v4/x/incentives"
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+func TestGenesis(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice")).String()
+	bob := sdk.AccAddress([]byte("bob")).String()
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		Gauges: []types.Gauge{
+			{
+				Id:      0,
+				Creator: alice,
+				DistributeTo: types.QueryCondition{
+					PairId:    &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"},
+					StartTick: -10,
+					EndTick:   10,
+				},
+				Coins:             sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(100))),
+				StartHeight:       1,
+				NumEpochsPaidOver: 10,
+				FilledEpochs:      2,
+				DistributedCoins:  sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(20))),
+			},
+			{
+				Id:      1,
+				Creator: bob,
+				DistributeTo: types.QueryCondition{
+					PairId:    &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"},
+					StartTick: 0,
+					EndTick:   0,
+				},
+				Coins:             sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(50))),
+				StartHeight:       5,
+				NumEpochsPaidOver: 5,
+				DistributedCoins:  sdk.Coins{},
+				WeightByDistance:  true,
+			},
+		},
+		NextGaugeId: 2,
+		Stakes: []types.Stake{
+			{
+				Id:          0,
+				Owner:       alice,
+				Coins:       sdk.NewCoins(sdk.NewCoin(dextypes.NewPoolDenom(0), math.NewInt(10))),
+				StartHeight: 1,
+			},
+			{
+				Id:          1,
+				Owner:       bob,
+				Coins:       sdk.NewCoins(sdk.NewCoin(dextypes.NewPoolDenom(1), math.NewInt(20))),
+				StartHeight: 2,
+			},
+		},
+		NextStakeId: 2,
+		AccountRewards: []types.AccountRewards{
+			{
+				Address: alice,
+				Coins:   sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(20))),
+			},
+		},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.IncentivesKeeper(t)
+	incentives.InitGenesis(ctx, *k, genesisState)
+	got := incentives.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.Gauges, got.Gauges)
+	require.Equal(t, genesisState.NextGaugeId, got.NextGaugeId)
+	require.ElementsMatch(t, genesisState.Stakes, got.Stakes)
+	require.Equal(t, genesisState.NextStakeId, got.NextStakeId)
+	require.ElementsMatch(t, genesisState.AccountRewards, got.AccountRewards)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
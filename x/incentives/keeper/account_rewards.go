@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// SetAccountRewards set the unclaimed rewards of an address in the store
+func (k Keeper) SetAccountRewards(ctx sdk.Context, accountRewards types.AccountRewards) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccountRewardsKeyPrefix))
+	b := k.cdc.MustMarshal(&accountRewards)
+	store.Set(types.AccountRewardsKey(accountRewards.Address), b)
+}
+
+// GetAccountRewards returns the unclaimed rewards of an address
+func (k Keeper) GetAccountRewards(ctx sdk.Context, address string) (val types.AccountRewards, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccountRewardsKeyPrefix))
+	b := store.Get(types.AccountRewardsKey(address))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAccountRewards removes the unclaimed rewards of an address from the store
+func (k Keeper) RemoveAccountRewards(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccountRewardsKeyPrefix))
+	store.Delete(types.AccountRewardsKey(address))
+}
+
+// GetAllAccountRewards returns the unclaimed rewards of all addresses
+func (k Keeper) GetAllAccountRewards(ctx sdk.Context) (list []types.AccountRewards) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccountRewardsKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccountRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddAccountRewards adds coins to the unclaimed rewards of an address
+func (k Keeper) AddAccountRewards(ctx sdk.Context, address string, coins sdk.Coins) {
+	accountRewards, found := k.GetAccountRewards(ctx, address)
+	if !found {
+		accountRewards = types.AccountRewards{Address: address}
+	}
+	accountRewards.Coins = accountRewards.Coins.Add(coins...)
+	k.SetAccountRewards(ctx, accountRewards)
+}
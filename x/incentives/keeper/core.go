@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// CreateGaugeCore handles MsgCreateGauge, escrowing the reward coins in the module account and
+// storing a new gauge that will start distributing at startHeight (or the next epoch if startHeight
+// has already passed).
+func (k Keeper) CreateGaugeCore(
+	goCtx context.Context,
+	creatorAddr sdk.AccAddress,
+	distributeTo types.QueryCondition,
+	coins sdk.Coins,
+	startHeight int64,
+	numEpochsPaidOver uint64,
+	weightByDistance bool,
+) (types.Gauge, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	maxGauges := k.GetParams(ctx).MaxGauges
+	if maxGauges != 0 && uint64(len(k.GetActiveGauges(ctx))) >= maxGauges {
+		return types.Gauge{}, sdkerrors.Wrapf(types.ErrMaxGaugesReached, "max gauges: %d", maxGauges)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, coins); err != nil {
+		return types.Gauge{}, err
+	}
+
+	if startHeight < ctx.BlockHeight() {
+		startHeight = ctx.BlockHeight()
+	}
+
+	id := k.GetNextGaugeID(ctx)
+	gauge := types.Gauge{
+		Id:                id,
+		Creator:           creatorAddr.String(),
+		DistributeTo:      distributeTo,
+		Coins:             coins,
+		StartHeight:       startHeight,
+		NumEpochsPaidOver: numEpochsPaidOver,
+		DistributedCoins:  sdk.Coins{},
+		WeightByDistance:  weightByDistance,
+	}
+	k.SetGauge(ctx, gauge)
+	k.SetNextGaugeID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(types.CreateGaugeEvent(gauge))
+
+	return gauge, nil
+}
+
+// StakeCore handles MsgStake, moving the pool shares into the module account. Each coin is added to the owner's
+// existing stake of that denom, or to a new stake if there is none, so an owner has at most one stake per denom.
+func (k Keeper) StakeCore(goCtx context.Context, ownerAddr sdk.AccAddress, coins sdk.Coins) ([]types.Stake, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := types.ValidateStakeCoins(coins); err != nil {
+		return nil, err
+	}
+
+	minStakeAmount := k.GetParams(ctx).MinStakeAmount
+	for _, coin := range coins {
+		poolID, err := dextypes.ParsePoolIDFromDenom(coin.Denom)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStakeCoins, "%s is not a pool denom", coin.Denom)
+		}
+		if _, found := k.dexKeeper.GetPoolMetadata(ctx, poolID); !found {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStakeCoins, "pool %d does not exist", poolID)
+		}
+		if coin.Amount.LT(minStakeAmount) {
+			return nil, sdkerrors.Wrapf(types.ErrStakeAmountTooSmall, "%s is less than %s", coin, minStakeAmount)
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, ownerAddr, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	stakes := make([]types.Stake, 0, len(coins))
+	for _, coin := range coins {
+		stake, found := k.GetStakeByOwnerAndDenom(ctx, ownerAddr.String(), coin.Denom)
+		if found {
+			stake.Coins = stake.Coins.Add(coin)
+		} else {
+			id := k.GetNextStakeID(ctx)
+			stake = types.Stake{
+				Id:          id,
+				Owner:       ownerAddr.String(),
+				Coins:       sdk.NewCoins(coin),
+				StartHeight: ctx.BlockHeight(),
+			}
+			k.SetNextStakeID(ctx, id+1)
+		}
+		k.SetStake(ctx, stake)
+
+		ctx.EventManager().EmitEvent(types.StakeEvent(stake, sdk.NewCoins(coin)))
+		stakes = append(stakes, stake)
+	}
+
+	return stakes, nil
+}
+
+// UnstakeCore handles MsgUnstake, removing a stake and returning its pool shares to the owner.
+// Rewards already accrued by the owner remain claimable.
+func (k Keeper) UnstakeCore(goCtx context.Context, ownerAddr sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stake, found := k.GetStake(ctx, id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrStakeNotFound, "%d", id)
+	}
+
+	if stake.Owner != ownerAddr.String() {
+		return nil, sdkerrors.Wrapf(types.ErrNotStakeOwner, "%d", id)
+	}
+
+	k.RemoveStake(ctx, stake)
+	// A stake weighed by a distribution in progress is not paid out once it has been removed. Otherwise restaking the
+	// same shares under a new id would have them weighed and paid twice in the same epoch.
+	k.RemoveStakeWeight(ctx, stake.Id)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, stake.Coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(types.UnstakeEvent(stake))
+
+	return stake.Coins, nil
+}
+
+// ClaimRewardsCore handles MsgClaimRewards, paying out all rewards accrued by the owner
+func (k Keeper) ClaimRewardsCore(goCtx context.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accountRewards, found := k.GetAccountRewards(ctx, ownerAddr.String())
+	if !found || accountRewards.Coins.IsZero() {
+		return nil, types.ErrNoRewards
+	}
+
+	k.RemoveAccountRewards(ctx, accountRewards.Address)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, accountRewards.Coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(types.ClaimRewardsEvent(accountRewards.Address, accountRewards.Coins))
+
+	return accountRewards.Coins, nil
+}
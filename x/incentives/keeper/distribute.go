@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// poolValuation caches what is needed to value the shares of a single pool during a distribution
+type poolValuation struct {
+	metadata    dextypes.PoolMetadata
+	pool        *dextypes.Pool
+	totalShares sdk.Coin
+}
+
+// distributionCache avoids reloading pools and current ticks for every gauge and stake weighed in a block
+type distributionCache struct {
+	pools        map[uint64]*poolValuation
+	currentTicks map[string]*int64
+}
+
+func newDistributionCache() *distributionCache {
+	return &distributionCache{
+		pools:        make(map[uint64]*poolValuation),
+		currentTicks: make(map[string]*int64),
+	}
+}
+
+// IsEpochEnd returns true if rewards are distributed at the current block height
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	epochBlocks := k.GetParams(ctx).EpochBlocks
+	return epochBlocks != 0 && ctx.BlockHeight()%int64(epochBlocks) == 0
+}
+
+// DistributeRewards pays out one epoch of rewards for every active gauge. A distribution starts at the end of
+// an epoch and spends at most DistributionAllowance gas per block: all stakes are first weighed against the gauges,
+// then their weights are paid out. If the allowance runs out, the distribution continues from where it stopped in the
+// next block. An epoch that ends while a distribution is still in progress is skipped; the gauges then spread their
+// remaining coins over their later epochs.
+func (k Keeper) DistributeRewards(ctx sdk.Context) {
+	state, found := k.GetDistributionState(ctx)
+	if !found {
+		if !k.IsEpochEnd(ctx) {
+			return
+		}
+
+		state = k.newDistributionState(ctx)
+		if len(state.Gauges) == 0 {
+			return
+		}
+	}
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + k.GetParams(ctx).DistributionAllowance
+	if !state.WeighingDone {
+		if !k.weighStakes(ctx, &state, gasCutoff) {
+			k.SetDistributionState(ctx, state)
+			return
+		}
+		state.WeighingDone = true
+	}
+
+	if !k.payStakeWeights(ctx, &state, gasCutoff) {
+		k.SetDistributionState(ctx, state)
+		return
+	}
+
+	k.finishDistribution(ctx, state)
+	k.RemoveDistributionState(ctx)
+}
+
+func (k Keeper) newDistributionState(ctx sdk.Context) types.DistributionState {
+	state := types.DistributionState{}
+	for _, gauge := range k.GetActiveGauges(ctx) {
+		if !gauge.IsActive(ctx.BlockHeight()) {
+			continue
+		}
+
+		state.Gauges = append(state.Gauges, types.GaugeDistribution{
+			GaugeId:          gauge.Id,
+			EpochCoins:       gauge.EpochCoins(),
+			TotalWeight:      math_utils.ZeroPrecDec(),
+			DistributedCoins: sdk.Coins{},
+		})
+	}
+
+	return state
+}
+
+// weighStakes stores the weight of every stake from state.NextStakeId onwards for each gauge being distributed.
+// It returns false if it stopped at gasCutoff before weighing all stakes.
+func (k Keeper) weighStakes(ctx sdk.Context, state *types.DistributionState, gasCutoff uint64) bool {
+	gauges := make([]types.Gauge, len(state.Gauges))
+	for i, gaugeDistribution := range state.Gauges {
+		gauge, found := k.GetGauge(ctx, gaugeDistribution.GaugeId)
+		if !found {
+			panic("distribution references a missing gauge")
+		}
+		gauges[i] = gauge
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeKeyPrefix))
+	iterator := store.Iterator(types.StakeKey(state.NextStakeId), nil)
+	defer iterator.Close()
+
+	cache := newDistributionCache()
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.Stake
+		k.cdc.MustUnmarshal(iterator.Value(), &stake)
+
+		stakeWeight := types.StakeWeight{StakeId: stake.Id, Owner: stake.Owner}
+		for i, gauge := range gauges {
+			weight := k.stakeWeight(ctx, gauge, stake, cache)
+			if !weight.IsPositive() {
+				continue
+			}
+
+			stakeWeight.Weights = append(stakeWeight.Weights, types.GaugeWeight{GaugeId: gauge.Id, Weight: weight})
+			state.Gauges[i].TotalWeight = state.Gauges[i].TotalWeight.Add(weight)
+		}
+
+		if len(stakeWeight.Weights) > 0 {
+			k.SetStakeWeight(ctx, stakeWeight)
+		}
+
+		// At least one stake is weighed per block so that the distribution always makes progress
+		if ctx.GasMeter().GasConsumed() >= gasCutoff {
+			state.NextStakeId = stake.Id + 1
+			return false
+		}
+	}
+
+	return true
+}
+
+// payStakeWeights credits every stored stake weight with its share of the gauges' epoch coins. It returns false if it
+// stopped at gasCutoff before paying out all stake weights.
+func (k Keeper) payStakeWeights(ctx sdk.Context, state *types.DistributionState, gasCutoff uint64) bool {
+	gaugeIndexes := make(map[uint64]int, len(state.Gauges))
+	for i, gaugeDistribution := range state.Gauges {
+		gaugeIndexes[gaugeDistribution.GaugeId] = i
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeWeightKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stakeWeight types.StakeWeight
+		k.cdc.MustUnmarshal(iterator.Value(), &stakeWeight)
+
+		rewards := sdk.Coins{}
+		for _, gaugeWeight := range stakeWeight.Weights {
+			gaugeDistribution := &state.Gauges[gaugeIndexes[gaugeWeight.GaugeId]]
+			for _, coin := range gaugeDistribution.EpochCoins {
+				amount := gaugeWeight.Weight.MulInt(coin.Amount).Quo(gaugeDistribution.TotalWeight).TruncateInt()
+				if amount.IsPositive() {
+					reward := sdk.NewCoin(coin.Denom, amount)
+					rewards = rewards.Add(reward)
+					gaugeDistribution.DistributedCoins = gaugeDistribution.DistributedCoins.Add(reward)
+				}
+			}
+		}
+
+		if !rewards.IsZero() {
+			k.AddAccountRewards(ctx, stakeWeight.Owner, rewards)
+		}
+		store.Delete(iterator.Key())
+
+		if ctx.GasMeter().GasConsumed() >= gasCutoff {
+			return false
+		}
+	}
+
+	return true
+}
+
+// finishDistribution records the epoch as filled for every gauge in state. Anything left once a gauge has finished
+// could not be distributed and is returned to its creator.
+func (k Keeper) finishDistribution(ctx sdk.Context, state types.DistributionState) {
+	for _, gaugeDistribution := range state.Gauges {
+		gauge, found := k.GetGauge(ctx, gaugeDistribution.GaugeId)
+		if !found {
+			panic("distribution references a missing gauge")
+		}
+
+		gauge.DistributedCoins = gauge.DistributedCoins.Add(gaugeDistribution.DistributedCoins...)
+		gauge.FilledEpochs++
+		k.SetGauge(ctx, gauge)
+
+		ctx.EventManager().EmitEvent(types.DistributeGaugeEvent(gauge, gaugeDistribution.DistributedCoins))
+
+		if !gauge.IsFinished() {
+			continue
+		}
+
+		remaining := gauge.RemainingCoins()
+		if remaining.IsZero() {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		creatorAddr := sdk.MustAccAddressFromBech32(gauge.Creator)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, creatorAddr, remaining); err != nil {
+			k.Logger(ctx).Error("failed to refund gauge", "gauge", gauge.Id, "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// stakeWeight returns the share of a gauge's rewards that a stake is entitled to. Each eligible pool share
+// is valued in terms of token0 and, if the gauge weights by distance, divided by 1 + the distance in ticks
+// between the pool and the current price of the pair.
+func (k Keeper) stakeWeight(
+	ctx sdk.Context,
+	gauge types.Gauge,
+	stake types.Stake,
+	cache *distributionCache,
+) math_utils.PrecDec {
+	weight := math_utils.ZeroPrecDec()
+	for _, coin := range stake.Coins {
+		valuation := k.getPoolValuation(ctx, coin.Denom, cache)
+		if valuation == nil || !gauge.DistributeTo.Test(valuation.metadata) {
+			continue
+		}
+
+		amount0, amount1 := valuation.pool.RedeemValue(coin.Amount, valuation.totalShares.Amount)
+		value := dextypes.CalcAmountAsToken0(amount0, amount1, valuation.pool.MustCalcPrice1To0Center())
+
+		if gauge.WeightByDistance {
+			if currentTick := k.getCurrentTick(ctx, gauge.DistributeTo.PairId, cache); currentTick != nil {
+				distance := valuation.metadata.Tick - *currentTick
+				if distance < 0 {
+					distance = -distance
+				}
+				value = value.QuoInt64(1 + distance)
+			}
+		}
+
+		weight = weight.Add(value)
+	}
+
+	return weight
+}
+
+func (k Keeper) getPoolValuation(ctx sdk.Context, denom string, cache *distributionCache) *poolValuation {
+	poolID, err := dextypes.ParsePoolIDFromDenom(denom)
+	if err != nil {
+		return nil
+	}
+
+	if valuation, ok := cache.pools[poolID]; ok {
+		return valuation
+	}
+
+	var valuation *poolValuation
+	metadata, foundMetadata := k.dexKeeper.GetPoolMetadata(ctx, poolID)
+	pool, foundPool := k.dexKeeper.GetPoolByID(ctx, poolID)
+	totalShares := k.bankKeeper.GetSupply(ctx, denom)
+	if foundMetadata && foundPool && totalShares.IsPositive() {
+		valuation = &poolValuation{metadata: metadata, pool: pool, totalShares: totalShares}
+	}
+	cache.pools[poolID] = valuation
+
+	return valuation
+}
+
+func (k Keeper) getCurrentTick(ctx sdk.Context, pairID *dextypes.PairID, cache *distributionCache) *int64 {
+	key := pairID.CanonicalString()
+	if tick, ok := cache.currentTicks[key]; ok {
+		return tick
+	}
+
+	var currentTick *int64
+	if tick, found := k.GetCurrentTick(ctx, pairID); found {
+		currentTick = &tick
+	}
+	cache.currentTicks[key] = currentTick
+
+	return currentTick
+}
+
+// GetCurrentTick returns the normalized tick at the middle of the best token0 and token1 liquidity of a pair.
+// If only one side of the pair has liquidity its best tick is used.
+func (k Keeper) GetCurrentTick(ctx sdk.Context, pairID *dextypes.PairID) (int64, bool) {
+	tick0, found0 := k.dexKeeper.GetCurrTickIndexTakerToMakerNormalized(ctx, dextypes.NewTradePairIDFromMaker(pairID, pairID.Token0))
+	tick1, found1 := k.dexKeeper.GetCurrTickIndexTakerToMakerNormalized(ctx, dextypes.NewTradePairIDFromMaker(pairID, pairID.Token1))
+
+	switch {
+	case found0 && found1:
+		return (tick0 + tick1) / 2, true
+	case found0:
+		return tick0, true
+	case found1:
+		return tick1, true
+	default:
+		return 0, false
+	}
+}
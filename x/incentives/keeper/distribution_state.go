@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// SetDistributionState set the state of the distribution in progress
+func (k Keeper) SetDistributionState(ctx sdk.Context, state types.DistributionState) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&state)
+	store.Set(types.KeyPrefix(types.DistributionStateKey), b)
+}
+
+// GetDistributionState returns the state of the distribution in progress, if any
+func (k Keeper) GetDistributionState(ctx sdk.Context) (val types.DistributionState, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.DistributionStateKey))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDistributionState removes the state of the distribution in progress once it has finished
+func (k Keeper) RemoveDistributionState(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefix(types.DistributionStateKey))
+}
+
+// SetStakeWeight set the weights of a stake waiting to be paid out
+func (k Keeper) SetStakeWeight(ctx sdk.Context, stakeWeight types.StakeWeight) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeWeightKeyPrefix))
+	b := k.cdc.MustMarshal(&stakeWeight)
+	store.Set(types.StakeWeightKey(stakeWeight.StakeId), b)
+}
+
+// RemoveStakeWeight removes the weights of a stake once they have been paid out
+func (k Keeper) RemoveStakeWeight(ctx sdk.Context, stakeID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeWeightKeyPrefix))
+	store.Delete(types.StakeWeightKey(stakeID))
+}
+
+// GetAllStakeWeight returns the weights of all stakes waiting to be paid out
+func (k Keeper) GetAllStakeWeight(ctx sdk.Context) (list []types.StakeWeight) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeWeightKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StakeWeight
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
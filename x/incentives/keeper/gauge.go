@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// SetGauge set a specific gauge in the store; gauges that have not finished distributing are also
+// added to the active gauge index
+func (k Keeper) SetGauge(ctx sdk.Context, gauge types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+	b := k.cdc.MustMarshal(&gauge)
+	store.Set(types.GaugeKey(gauge.Id), b)
+
+	activeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActiveGaugeKeyPrefix))
+	if gauge.IsFinished() {
+		activeStore.Delete(types.GaugeKey(gauge.Id))
+	} else {
+		activeStore.Set(types.GaugeKey(gauge.Id), []byte{})
+	}
+}
+
+// GetGauge returns a gauge from its id
+func (k Keeper) GetGauge(ctx sdk.Context, id uint64) (val types.Gauge, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+	b := store.Get(types.GaugeKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllGauge returns all gauges
+func (k Keeper) GetAllGauge(ctx sdk.Context) (list []types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Gauge
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetActiveGauges returns all gauges that have not finished distributing, ordered by id
+func (k Keeper) GetActiveGauges(ctx sdk.Context) (list []types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActiveGaugeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		gauge, found := k.GetGauge(ctx, binary.BigEndian.Uint64(iterator.Key()))
+		if !found {
+			panic("active gauge index references a missing gauge")
+		}
+		list = append(list, gauge)
+	}
+
+	return
+}
+
+// GetNextGaugeID get the id that will be assigned to the next gauge
+func (k Keeper) GetNextGaugeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.GaugeCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextGaugeID set the id that will be assigned to the next gauge
+func (k Keeper) SetNextGaugeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.GaugeCountKey), types.GaugeKey(id))
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+func (k Keeper) AccountRewards(
+	goCtx context.Context,
+	req *types.QueryAccountRewardsRequest,
+) (*types.QueryAccountRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accountRewards, _ := k.GetAccountRewards(ctx, req.Address)
+
+	return &types.QueryAccountRewardsResponse{Coins: accountRewards.Coins}, nil
+}
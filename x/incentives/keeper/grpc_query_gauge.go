@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+func (k Keeper) Gauges(goCtx context.Context, req *types.QueryAllGaugeRequest) (*types.QueryAllGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var gauges []types.Gauge
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	gaugeStore := prefix.NewStore(store, types.KeyPrefix(types.GaugeKeyPrefix))
+
+	pageRes, err := query.Paginate(gaugeStore, req.Pagination, func(_, value []byte) error {
+		var gauge types.Gauge
+		if err := k.cdc.Unmarshal(value, &gauge); err != nil {
+			return err
+		}
+
+		gauges = append(gauges, gauge)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllGaugeResponse{Gauges: gauges, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Gauge(goCtx context.Context, req *types.QueryGetGaugeRequest) (*types.QueryGetGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gauge, found := k.GetGauge(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "Gauge not found")
+	}
+
+	return &types.QueryGetGaugeResponse{Gauge: gauge}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+func (k Keeper) Stake(goCtx context.Context, req *types.QueryGetStakeRequest) (*types.QueryGetStakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stake, found := k.GetStake(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "Stake not found")
+	}
+
+	return &types.QueryGetStakeResponse{Stake: stake}, nil
+}
+
+func (k Keeper) StakesByOwner(
+	goCtx context.Context,
+	req *types.QueryStakesByOwnerRequest,
+) (*types.QueryStakesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stakes := k.GetStakesByOwner(ctx, req.Owner)
+
+	return &types.QueryStakesByOwnerResponse{Stakes: stakes}, nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+func (s *IncentivesTestSuite) TestQueryGauges() {
+	// GIVEN two gauges
+	id0 := s.createGauge(s.alice, -10, 10, 1000, 5, false)
+	id1 := s.createGauge(s.bob, 0, 0, 500, 1, true)
+
+	// WHEN gauges are queried one page at a time
+	resp, err := s.App.IncentivesKeeper.Gauges(s.Ctx, &types.QueryAllGaugeRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+
+	// THEN the first page holds the first gauge
+	s.Require().Len(resp.Gauges, 1)
+	s.Equal(id0, resp.Gauges[0].Id)
+	s.Equal(uint64(2), resp.Pagination.Total)
+
+	// AND a single gauge can be queried by id
+	gaugeResp, err := s.App.IncentivesKeeper.Gauge(s.Ctx, &types.QueryGetGaugeRequest{Id: id1})
+	s.Require().NoError(err)
+	s.Equal(s.bob.String(), gaugeResp.Gauge.Creator)
+
+	_, err = s.App.IncentivesKeeper.Gauge(s.Ctx, &types.QueryGetGaugeRequest{Id: 5})
+	s.Error(err)
+}
+
+func (s *IncentivesTestSuite) TestQueryStakesAndRewards() {
+	// GIVEN alice has stakes of two pools and has accrued rewards
+	shares := s.depositTokenA(s.alice, 10, 0, 1)
+	stakeID := s.stake(s.alice, shares)
+	s.stake(s.alice, s.depositTokenA(s.alice, 10, 2, 1))
+	s.createGauge(s.carol, -10, 10, 3000, 1, false)
+	s.distributeAtHeight(epochBlocks)
+
+	// WHEN her stakes are queried
+	stakesResp, err := s.App.IncentivesKeeper.StakesByOwner(s.Ctx, &types.QueryStakesByOwnerRequest{Owner: s.alice.String()})
+	s.Require().NoError(err)
+
+	// THEN both are returned
+	s.Len(stakesResp.Stakes, 2)
+
+	stakeResp, err := s.App.IncentivesKeeper.Stake(s.Ctx, &types.QueryGetStakeRequest{Id: stakeID})
+	s.Require().NoError(err)
+	s.Equal(sdk.NewCoins(shares), stakeResp.Stake.Coins)
+
+	// AND her rewards are returned
+	rewardsResp, err := s.App.IncentivesKeeper.AccountRewards(s.Ctx, &types.QueryAccountRewardsRequest{Address: s.alice.String()})
+	s.Require().NoError(err)
+	s.Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 3000)), rewardsResp.Coins)
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+type MsgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &MsgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = MsgServer{}
+
+func (k MsgServer) CreateGauge(
+	goCtx context.Context,
+	msg *types.MsgCreateGauge,
+) (*types.MsgCreateGaugeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgCreateGauge")
+	}
+
+	creatorAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	gauge, err := k.CreateGaugeCore(
+		goCtx,
+		creatorAddr,
+		msg.DistributeTo,
+		msg.Coins,
+		msg.StartHeight,
+		msg.NumEpochsPaidOver,
+		msg.WeightByDistance,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGaugeResponse{Id: gauge.Id}, nil
+}
+
+func (k MsgServer) Stake(
+	goCtx context.Context,
+	msg *types.MsgStake,
+) (*types.MsgStakeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgStake")
+	}
+
+	ownerAddr := sdk.MustAccAddressFromBech32(msg.Owner)
+
+	stakes, err := k.StakeCore(goCtx, ownerAddr, msg.Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(stakes))
+	for i, stake := range stakes {
+		ids[i] = stake.Id
+	}
+
+	return &types.MsgStakeResponse{Ids: ids}, nil
+}
+
+func (k MsgServer) Unstake(
+	goCtx context.Context,
+	msg *types.MsgUnstake,
+) (*types.MsgUnstakeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUnstake")
+	}
+
+	ownerAddr := sdk.MustAccAddressFromBech32(msg.Owner)
+
+	coins, err := k.UnstakeCore(goCtx, ownerAddr, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnstakeResponse{Coins: coins}, nil
+}
+
+func (k MsgServer) ClaimRewards(
+	goCtx context.Context,
+	msg *types.MsgClaimRewards,
+) (*types.MsgClaimRewardsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgClaimRewards")
+	}
+
+	ownerAddr := sdk.MustAccAddressFromBech32(msg.Owner)
+
+	coins, err := k.ClaimRewardsCore(goCtx, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRewardsResponse{Coins: coins}, nil
+}
+
+func (k MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,546 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v4/testutil/apptesting"
+	dexkeeper "github.com/neutron-org/neutron/v4/x/dex/keeper"
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	incentiveskeeper "github.com/neutron-org/neutron/v4/x/incentives/keeper"
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+const (
+	rewardDenom = "untrn"
+	epochBlocks = 10
+)
+
+var (
+	defaultPairID = &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"}
+	denomMultiple = sdkmath.NewInt(1_000_000)
+)
+
+type IncentivesTestSuite struct {
+	apptesting.KeeperTestHelper
+	msgServer    types.MsgServer
+	dexMsgServer dextypes.MsgServer
+	alice        sdk.AccAddress
+	bob          sdk.AccAddress
+	carol        sdk.AccAddress
+}
+
+func TestIncentivesTestSuite(t *testing.T) {
+	suite.Run(t, new(IncentivesTestSuite))
+}
+
+func (s *IncentivesTestSuite) SetupTest() {
+	s.Setup()
+
+	s.alice = []byte("alice")
+	s.bob = []byte("bob")
+	s.carol = []byte("carol")
+
+	s.msgServer = incentiveskeeper.NewMsgServerImpl(s.App.IncentivesKeeper)
+	s.dexMsgServer = dexkeeper.NewMsgServerImpl(s.App.DexKeeper)
+
+	err := s.App.IncentivesKeeper.SetParams(s.Ctx, types.NewParams(
+		epochBlocks,
+		types.DefaultMaxGauges,
+		types.DefaultMinStakeAmount,
+		types.DefaultDistributionAllowance,
+	))
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+}
+
+// Helpers
+
+func (s *IncentivesTestSuite) depositTokenA(addr sdk.AccAddress, amount, tickIndex int64, fee uint64) sdk.Coin {
+	amountInt := sdkmath.NewInt(amount).Mul(denomMultiple)
+	s.FundAcc(addr, sdk.NewCoins(sdk.NewCoin("TokenA", amountInt)))
+
+	_, err := s.dexMsgServer.Deposit(s.Ctx, dextypes.NewMsgDeposit(
+		addr.String(),
+		addr.String(),
+		"TokenA",
+		"TokenB",
+		[]sdkmath.Int{amountInt},
+		[]sdkmath.Int{sdkmath.ZeroInt()},
+		[]int64{tickIndex},
+		[]uint64{fee},
+		[]*dextypes.DepositOptions{{}},
+	))
+	s.Require().NoError(err)
+
+	pool, found := s.App.DexKeeper.GetPool(s.Ctx, defaultPairID, tickIndex, fee)
+	s.Require().True(found)
+
+	return s.App.BankKeeper.GetBalance(s.Ctx, addr, pool.GetPoolDenom())
+}
+
+func (s *IncentivesTestSuite) createGauge(
+	creator sdk.AccAddress,
+	startTick, endTick int64,
+	amount int64,
+	numEpochs uint64,
+	weightByDistance bool,
+) uint64 {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, amount))
+	s.FundAcc(creator, coins)
+
+	resp, err := s.msgServer.CreateGauge(s.Ctx, types.NewMsgCreateGauge(
+		creator.String(),
+		types.QueryCondition{PairId: defaultPairID, StartTick: startTick, EndTick: endTick},
+		coins,
+		0,
+		numEpochs,
+		weightByDistance,
+	))
+	s.Require().NoError(err)
+
+	return resp.Id
+}
+
+func (s *IncentivesTestSuite) stake(owner sdk.AccAddress, coins ...sdk.Coin) uint64 {
+	resp, err := s.msgServer.Stake(s.Ctx, types.NewMsgStake(owner.String(), sdk.NewCoins(coins...)))
+	s.Require().NoError(err)
+	s.Require().Len(resp.Ids, 1)
+
+	return resp.Ids[0]
+}
+
+func (s *IncentivesTestSuite) setDistributionAllowance(allowance uint64) {
+	params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+	params.DistributionAllowance = allowance
+	err := s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(err)
+}
+
+func (s *IncentivesTestSuite) distributeAtHeight(height int64) {
+	s.Ctx = s.Ctx.WithBlockHeight(height)
+	s.App.IncentivesKeeper.DistributeRewards(s.Ctx)
+}
+
+func (s *IncentivesTestSuite) assertAccountRewards(addr sdk.AccAddress, amount int64) {
+	rewards, found := s.App.IncentivesKeeper.GetAccountRewards(s.Ctx, addr.String())
+	if amount == 0 {
+		s.False(found && !rewards.Coins.IsZero(), "expected no rewards, got %s", rewards.Coins)
+		return
+	}
+	s.Require().True(found)
+	s.Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, amount)), rewards.Coins)
+}
+
+func (s *IncentivesTestSuite) assertRewardBalance(addr sdk.AccAddress, amount int64) {
+	s.Equal(sdkmath.NewInt(amount), s.App.BankKeeper.GetBalance(s.Ctx, addr, rewardDenom).Amount)
+}
+
+// Tests
+
+func (s *IncentivesTestSuite) TestCreateGauge() {
+	// WHEN alice creates a gauge
+	id := s.createGauge(s.alice, -10, 10, 1000, 5, false)
+
+	// THEN the reward coins are escrowed and the gauge starts at the current height
+	s.assertRewardBalance(s.alice, 0)
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.assertRewardBalance(moduleAddr, 1000)
+
+	gauge, found := s.App.IncentivesKeeper.GetGauge(s.Ctx, id)
+	s.Require().True(found)
+	s.Equal(s.alice.String(), gauge.Creator)
+	s.Equal(int64(1), gauge.StartHeight)
+	s.Equal(uint64(5), gauge.NumEpochsPaidOver)
+	s.Len(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx), 1)
+}
+
+func (s *IncentivesTestSuite) TestCreateGaugeMaxGaugesReached() {
+	err := s.App.IncentivesKeeper.SetParams(s.Ctx, types.NewParams(
+		epochBlocks,
+		1,
+		types.DefaultMinStakeAmount,
+		types.DefaultDistributionAllowance,
+	))
+	s.Require().NoError(err)
+
+	// GIVEN one active gauge
+	s.createGauge(s.alice, -10, 10, 1000, 5, false)
+
+	// WHEN alice creates a second gauge
+	coins := sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 1000))
+	s.FundAcc(s.alice, coins)
+	_, err = s.msgServer.CreateGauge(s.Ctx, types.NewMsgCreateGauge(
+		s.alice.String(),
+		types.QueryCondition{PairId: defaultPairID, StartTick: -10, EndTick: 10},
+		coins,
+		0,
+		5,
+		false,
+	))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrMaxGaugesReached)
+}
+
+func (s *IncentivesTestSuite) TestStakeNonPoolDenomFails() {
+	s.FundAcc(s.alice, sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 10)))
+
+	// WHEN alice tries to stake a coin that is not a pool share
+	_, err := s.msgServer.Stake(s.Ctx, types.NewMsgStake(s.alice.String(), sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 10))))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidStakeCoins)
+}
+
+func (s *IncentivesTestSuite) TestStakeMissingPoolFails() {
+	missingPoolShares := sdk.NewCoin(dextypes.NewPoolDenom(99), types.DefaultMinStakeAmount)
+	s.FundAcc(s.alice, sdk.NewCoins(missingPoolShares))
+
+	// WHEN alice tries to stake shares of a pool that does not exist
+	_, err := s.msgServer.Stake(s.Ctx, types.NewMsgStake(s.alice.String(), sdk.NewCoins(missingPoolShares)))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidStakeCoins)
+	s.Empty(s.App.IncentivesKeeper.GetStakesByOwner(s.Ctx, s.alice.String()))
+}
+
+func (s *IncentivesTestSuite) TestStakeCoreRejectsNonPoolDenom() {
+	coins := sdk.NewCoins(sdk.NewCoin(rewardDenom, types.DefaultMinStakeAmount))
+	s.FundAcc(s.alice, coins)
+
+	// WHEN a coin that is not a pool share is staked without going through message validation
+	_, err := s.App.IncentivesKeeper.StakeCore(s.Ctx, s.alice, coins)
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrInvalidStakeCoins)
+}
+
+func (s *IncentivesTestSuite) TestStakeAggregatesByDenom() {
+	// GIVEN alice has shares of two pools
+	shares0 := s.depositTokenA(s.alice, 10, 0, 1)
+	shares1 := s.depositTokenA(s.alice, 10, 2, 1)
+	quarter0 := sdk.NewCoin(shares0.Denom, shares0.Amount.QuoRaw(4))
+
+	// WHEN alice stakes shares of the first pool twice
+	stakeID := s.stake(s.alice, quarter0)
+	s.Equal(stakeID, s.stake(s.alice, quarter0))
+
+	// THEN a single stake holds both amounts
+	stake, found := s.App.IncentivesKeeper.GetStake(s.Ctx, stakeID)
+	s.Require().True(found)
+	s.Equal(sdk.NewCoins(quarter0.Add(quarter0)), stake.Coins)
+
+	// WHEN alice stakes shares of both pools at once
+	resp, err := s.msgServer.Stake(s.Ctx, types.NewMsgStake(s.alice.String(), sdk.NewCoins(quarter0, shares1)))
+	s.Require().NoError(err)
+
+	// THEN the first pool's shares are added to the existing stake and the second pool gets a new stake
+	s.Require().Len(resp.Ids, 2)
+	s.Len(s.App.IncentivesKeeper.GetStakesByOwner(s.Ctx, s.alice.String()), 2)
+	stake, found = s.App.IncentivesKeeper.GetStakeByOwnerAndDenom(s.Ctx, s.alice.String(), shares0.Denom)
+	s.Require().True(found)
+	s.Equal(stakeID, stake.Id)
+	s.Equal(quarter0.Amount.MulRaw(3), stake.Coins.AmountOf(shares0.Denom))
+	stake, found = s.App.IncentivesKeeper.GetStakeByOwnerAndDenom(s.Ctx, s.alice.String(), shares1.Denom)
+	s.Require().True(found)
+	s.Equal(sdk.NewCoins(shares1), stake.Coins)
+}
+
+func (s *IncentivesTestSuite) TestStakeBelowMinimumFails() {
+	shares := s.depositTokenA(s.alice, 10, 0, 1)
+
+	// WHEN alice stakes less than the minimum stake amount
+	dust := sdk.NewCoin(shares.Denom, types.DefaultMinStakeAmount.SubRaw(1))
+	_, err := s.msgServer.Stake(s.Ctx, types.NewMsgStake(s.alice.String(), sdk.NewCoins(dust)))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrStakeAmountTooSmall)
+	s.Empty(s.App.IncentivesKeeper.GetStakesByOwner(s.Ctx, s.alice.String()))
+}
+
+func (s *IncentivesTestSuite) TestDistributeRewardsProRata() {
+	// GIVEN alice and bob deposit into the same pool
+	aliceShares := s.depositTokenA(s.alice, 10, 0, 1)
+	bobShares := s.depositTokenA(s.bob, 10, 0, 1)
+
+	// AND alice stakes all her shares and bob stakes half of his
+	s.stake(s.alice, aliceShares)
+	s.stake(s.bob, sdk.NewCoin(bobShares.Denom, bobShares.Amount.QuoRaw(2)))
+
+	// AND carol creates a gauge paying 3000 over one epoch
+	gaugeID := s.createGauge(s.carol, -10, 10, 3000, 1, false)
+
+	// WHEN the epoch ends
+	s.distributeAtHeight(epochBlocks)
+
+	// THEN rewards are split by the value of the staked shares
+	s.assertAccountRewards(s.alice, 2000)
+	s.assertAccountRewards(s.bob, 1000)
+
+	// AND the gauge is finished
+	gauge, found := s.App.IncentivesKeeper.GetGauge(s.Ctx, gaugeID)
+	s.Require().True(found)
+	s.True(gauge.IsFinished())
+	s.Equal(gauge.Coins, gauge.DistributedCoins)
+	s.Empty(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx))
+}
+
+func (s *IncentivesTestSuite) TestDistributeRewardsOverMultipleBlocks() {
+	// GIVEN alice, bob and carol stake shares of the same pool
+	s.stake(s.alice, s.depositTokenA(s.alice, 10, 0, 1))
+	s.stake(s.bob, s.depositTokenA(s.bob, 10, 0, 1))
+	s.stake(s.carol, s.depositTokenA(s.carol, 10, 0, 1))
+	gaugeID := s.createGauge(s.carol, -10, 10, 3000, 2, false)
+
+	// AND the distribution allowance only covers a single stake per block
+	s.setDistributionAllowance(1)
+
+	// WHEN the epoch ends
+	s.distributeAtHeight(epochBlocks)
+
+	// THEN only the first stake has been weighed
+	state, found := s.App.IncentivesKeeper.GetDistributionState(s.Ctx)
+	s.Require().True(found)
+	s.False(state.WeighingDone)
+	s.Equal(uint64(1), state.NextStakeId)
+	s.Len(s.App.IncentivesKeeper.GetAllStakeWeight(s.Ctx), 1)
+
+	// WHEN the next epoch ends while the distribution is in progress
+	s.distributeAtHeight(2 * epochBlocks)
+
+	// THEN the distribution continues from where it stopped
+	state, found = s.App.IncentivesKeeper.GetDistributionState(s.Ctx)
+	s.Require().True(found)
+	s.Equal(uint64(2), state.NextStakeId)
+
+	// WHEN the following blocks are processed
+	for height := int64(2*epochBlocks + 1); height <= 2*epochBlocks+5; height++ {
+		s.distributeAtHeight(height)
+	}
+
+	// THEN a single epoch has been paid out pro rata
+	_, found = s.App.IncentivesKeeper.GetDistributionState(s.Ctx)
+	s.False(found)
+	s.Empty(s.App.IncentivesKeeper.GetAllStakeWeight(s.Ctx))
+	s.assertAccountRewards(s.alice, 500)
+	s.assertAccountRewards(s.bob, 500)
+	s.assertAccountRewards(s.carol, 500)
+
+	gauge, found := s.App.IncentivesKeeper.GetGauge(s.Ctx, gaugeID)
+	s.Require().True(found)
+	s.Equal(uint64(1), gauge.FilledEpochs)
+	s.Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 1500)), gauge.DistributedCoins)
+
+	// WHEN the following epoch is distributed
+	for height := int64(3 * epochBlocks); height <= 3*epochBlocks+6; height++ {
+		s.distributeAtHeight(height)
+	}
+
+	// THEN the gauge is finished
+	s.assertAccountRewards(s.alice, 1000)
+	s.assertAccountRewards(s.bob, 1000)
+	s.assertAccountRewards(s.carol, 1000)
+	s.Empty(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx))
+}
+
+func (s *IncentivesTestSuite) TestRestakeDuringDistributionIsNotPaidTwice() {
+	// GIVEN alice, bob and carol stake shares of the same pool
+	aliceStakeID := s.stake(s.alice, s.depositTokenA(s.alice, 10, 0, 1))
+	s.stake(s.bob, s.depositTokenA(s.bob, 10, 0, 1))
+	s.stake(s.carol, s.depositTokenA(s.carol, 10, 0, 1))
+	s.createGauge(s.carol, -10, 10, 3000, 1, false)
+
+	// AND the distribution allowance only covers a single stake per block
+	s.setDistributionAllowance(1)
+
+	// WHEN the epoch ends and alice's stake is weighed
+	s.distributeAtHeight(epochBlocks)
+	s.Len(s.App.IncentivesKeeper.GetAllStakeWeight(s.Ctx), 1)
+
+	// AND alice unstakes and restakes her shares while the distribution is in progress
+	resp, err := s.msgServer.Unstake(s.Ctx, types.NewMsgUnstake(s.alice.String(), aliceStakeID))
+	s.Require().NoError(err)
+	s.Empty(s.App.IncentivesKeeper.GetAllStakeWeight(s.Ctx))
+	s.NotEqual(aliceStakeID, s.stake(s.alice, resp.Coins...))
+
+	// AND the distribution finishes
+	for height := int64(epochBlocks + 1); height <= epochBlocks+10; height++ {
+		s.distributeAtHeight(height)
+	}
+	_, found := s.App.IncentivesKeeper.GetDistributionState(s.Ctx)
+	s.Require().False(found)
+
+	// THEN alice's shares are only paid once
+	s.assertAccountRewards(s.alice, 750)
+	s.assertAccountRewards(s.bob, 750)
+	s.assertAccountRewards(s.carol, 750)
+}
+
+func (s *IncentivesTestSuite) TestDistributeRewardsOnlyAtEpochEnd() {
+	// GIVEN alice has staked shares and there is an active gauge
+	shares := s.depositTokenA(s.alice, 10, 0, 1)
+	s.stake(s.alice, shares)
+	s.createGauge(s.carol, -10, 10, 3000, 1, false)
+
+	// WHEN a block that does not end an epoch is processed
+	s.distributeAtHeight(epochBlocks + 1)
+
+	// THEN nothing is distributed
+	s.assertAccountRewards(s.alice, 0)
+	s.Len(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx), 1)
+}
+
+func (s *IncentivesTestSuite) TestDistributeRewardsOverMultipleEpochs() {
+	// GIVEN alice has staked shares
+	shares := s.depositTokenA(s.alice, 10, 0, 1)
+	s.stake(s.alice, shares)
+
+	// AND a gauge paying 100 over 3 epochs
+	s.createGauge(s.carol, -10, 10, 100, 3, false)
+
+	// WHEN three epochs end
+	// THEN the rewards are spread over the epochs with the rounding remainder paid in the last one
+	s.distributeAtHeight(epochBlocks)
+	s.assertAccountRewards(s.alice, 33)
+	s.distributeAtHeight(2 * epochBlocks)
+	s.assertAccountRewards(s.alice, 66)
+	s.distributeAtHeight(3 * epochBlocks)
+	s.assertAccountRewards(s.alice, 100)
+	s.Empty(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx))
+}
+
+func (s *IncentivesTestSuite) TestDistributeRewardsIgnoresPoolsOutOfRange() {
+	// GIVEN alice stakes shares of a pool inside the gauge range and bob outside of it
+	aliceShares := s.depositTokenA(s.alice, 10, 0, 1)
+	bobShares := s.depositTokenA(s.bob, 10, 20, 1)
+	s.stake(s.alice, aliceShares)
+	s.stake(s.bob, bobShares)
+
+	s.createGauge(s.carol, -10, 10, 3000, 1, false)
+
+	// WHEN the epoch ends
+	s.distributeAtHeight(epochBlocks)
+
+	// THEN only alice is rewarded
+	s.assertAccountRewards(s.alice, 3000)
+	s.assertAccountRewards(s.bob, 0)
+}
+
+func (s *IncentivesTestSuite) TestDistributeRewardsWeightByDistance() {
+	// GIVEN alice stakes shares of the pool at the current price and bob stakes shares of a pool further away
+	aliceShares := s.depositTokenA(s.alice, 10, 0, 1)
+	bobShares := s.depositTokenA(s.bob, 10, -4, 1)
+	s.stake(s.alice, aliceShares)
+	s.stake(s.bob, bobShares)
+
+	// The best token0 liquidity is alice's at tick -1
+	currentTick, found := s.App.IncentivesKeeper.GetCurrentTick(s.Ctx, defaultPairID)
+	s.Require().True(found)
+	s.Equal(int64(-1), currentTick)
+
+	// AND carol creates a gauge weighted by distance
+	s.createGauge(s.carol, -10, 10, 3000, 1, true)
+
+	// WHEN the epoch ends
+	s.distributeAtHeight(epochBlocks)
+
+	// THEN alice (distance 1) earns twice as much as bob (distance 3)
+	s.assertAccountRewards(s.alice, 2000)
+	s.assertAccountRewards(s.bob, 1000)
+}
+
+func (s *IncentivesTestSuite) TestDistributeRewardsRefundsCreatorWithoutStakers() {
+	// GIVEN a gauge with nobody staked
+	s.createGauge(s.carol, -10, 10, 3000, 2, false)
+
+	// WHEN the first epoch ends
+	s.distributeAtHeight(epochBlocks)
+
+	// THEN nothing is refunded yet
+	s.assertRewardBalance(s.carol, 0)
+
+	// WHEN the last epoch ends
+	s.distributeAtHeight(2 * epochBlocks)
+
+	// THEN the undistributed coins are returned to carol
+	s.assertRewardBalance(s.carol, 3000)
+	s.Empty(s.App.IncentivesKeeper.GetActiveGauges(s.Ctx))
+}
+
+func (s *IncentivesTestSuite) TestClaimRewards() {
+	// GIVEN alice has accrued rewards
+	shares := s.depositTokenA(s.alice, 10, 0, 1)
+	s.stake(s.alice, shares)
+	s.createGauge(s.carol, -10, 10, 3000, 1, false)
+	s.distributeAtHeight(epochBlocks)
+
+	// WHEN alice claims
+	resp, err := s.msgServer.ClaimRewards(s.Ctx, types.NewMsgClaimRewards(s.alice.String()))
+	s.Require().NoError(err)
+
+	// THEN she receives her rewards
+	s.Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 3000)), resp.Coins)
+	s.assertRewardBalance(s.alice, 3000)
+	s.assertAccountRewards(s.alice, 0)
+
+	// AND claiming again fails
+	_, err = s.msgServer.ClaimRewards(s.Ctx, types.NewMsgClaimRewards(s.alice.String()))
+	s.ErrorIs(err, types.ErrNoRewards)
+}
+
+func (s *IncentivesTestSuite) TestUnstake() {
+	// GIVEN alice has staked shares
+	shares := s.depositTokenA(s.alice, 10, 0, 1)
+	stakeID := s.stake(s.alice, shares)
+	s.True(s.App.BankKeeper.GetBalance(s.Ctx, s.alice, shares.Denom).IsZero())
+
+	// WHEN bob tries to unstake it
+	_, err := s.msgServer.Unstake(s.Ctx, types.NewMsgUnstake(s.bob.String(), stakeID))
+
+	// THEN it fails
+	s.ErrorIs(err, types.ErrNotStakeOwner)
+
+	// WHEN alice unstakes
+	resp, err := s.msgServer.Unstake(s.Ctx, types.NewMsgUnstake(s.alice.String(), stakeID))
+	s.Require().NoError(err)
+
+	// THEN her shares are returned and the stake is removed
+	s.Equal(sdk.NewCoins(shares), resp.Coins)
+	s.Equal(shares, s.App.BankKeeper.GetBalance(s.Ctx, s.alice, shares.Denom))
+	_, found := s.App.IncentivesKeeper.GetStake(s.Ctx, stakeID)
+	s.False(found)
+	s.Empty(s.App.IncentivesKeeper.GetStakesByOwner(s.Ctx, s.alice.String()))
+
+	// AND unstaking again fails
+	_, err = s.msgServer.Unstake(s.Ctx, types.NewMsgUnstake(s.alice.String(), stakeID))
+	s.ErrorIs(err, types.ErrStakeNotFound)
+}
+
+func (s *IncentivesTestSuite) TestUpdateParams() {
+	newParams := types.NewParams(100, 5, sdkmath.NewInt(10), 500_000)
+
+	// WHEN a non-authority updates params
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{
+		Authority: s.alice.String(),
+		Params:    newParams,
+	})
+
+	// THEN it fails
+	s.ErrorContains(err, "invalid authority")
+
+	// WHEN the authority updates params
+	_, err = s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+		Params:    newParams,
+	})
+	s.Require().NoError(err)
+
+	// THEN the params are updated
+	s.Equal(newParams, s.App.IncentivesKeeper.GetParams(s.Ctx))
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+// SetStake set a specific stake in the store and index it by its owner and denom
+func (k Keeper) SetStake(ctx sdk.Context, stake types.Stake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeKeyPrefix))
+	b := k.cdc.MustMarshal(&stake)
+	store.Set(types.StakeKey(stake.Id), b)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeOwnerPrefix(stake.Owner))
+	ownerStore.Set(types.StakeOwnerKey(stake.Denom()), types.StakeKey(stake.Id))
+}
+
+// GetStake returns a stake from its id
+func (k Keeper) GetStake(ctx sdk.Context, id uint64) (val types.Stake, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeKeyPrefix))
+	b := store.Get(types.StakeKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveStake removes a stake and its owner index from the store
+func (k Keeper) RemoveStake(ctx sdk.Context, stake types.Stake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeKeyPrefix))
+	store.Delete(types.StakeKey(stake.Id))
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeOwnerPrefix(stake.Owner))
+	ownerStore.Delete(types.StakeOwnerKey(stake.Denom()))
+}
+
+// GetStakeByOwnerAndDenom returns the stake of owner holding denom
+func (k Keeper) GetStakeByOwnerAndDenom(ctx sdk.Context, owner, denom string) (val types.Stake, found bool) {
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeOwnerPrefix(owner))
+	b := ownerStore.Get(types.StakeOwnerKey(denom))
+	if b == nil {
+		return val, false
+	}
+
+	return k.GetStake(ctx, binary.BigEndian.Uint64(b))
+}
+
+// GetAllStake returns all stakes
+func (k Keeper) GetAllStake(ctx sdk.Context) (list []types.Stake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Stake
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetStakesByOwner returns all stakes owned by owner, ordered by denom
+func (k Keeper) GetStakesByOwner(ctx sdk.Context, owner string) (list []types.Stake) {
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeOwnerPrefix(owner))
+	iterator := storetypes.KVStorePrefixIterator(ownerStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		stake, found := k.GetStake(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if !found {
+			panic("stake owner index references a missing stake")
+		}
+		list = append(list, stake)
+	}
+
+	return
+}
+
+// GetNextStakeID get the id that will be assigned to the next stake
+func (k Keeper) GetNextStakeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.StakeCountKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextStakeID set the id that will be assigned to the next stake
+func (k Keeper) SetNextStakeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.StakeCountKey), types.StakeKey(id))
+}
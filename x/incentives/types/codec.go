@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateGauge{}, "incentives/MsgCreateGauge", nil)
+	cdc.RegisterConcrete(&MsgStake{}, "incentives/MsgStake", nil)
+	cdc.RegisterConcrete(&MsgUnstake{}, "incentives/MsgUnstake", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "incentives/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "incentives/MsgUpdateParams", nil)
+	// this line is used by starport scaffolding # 2
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateGauge{},
+		&MsgStake{},
+		&MsgUnstake{},
+		&MsgClaimRewards{},
+		&MsgUpdateParams{},
+	)
+	// this line is used by starport scaffolding # 3
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
@@ -0,0 +1,57 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/incentives module sentinel errors
+
+var (
+	ErrInvalidAddress = sdkerrors.Register(
+		ModuleName,
+		1100,
+		"Invalid Address",
+	)
+	ErrInvalidStakeCoins = sdkerrors.Register(
+		ModuleName,
+		1101,
+		"Stake coins must be non-empty dex pool shares",
+	)
+	ErrInvalidGauge = sdkerrors.Register(
+		ModuleName,
+		1102,
+		"Invalid gauge",
+	)
+	ErrGaugeNotFound = sdkerrors.Register(
+		ModuleName,
+		1103,
+		"Gauge not found",
+	)
+	ErrStakeNotFound = sdkerrors.Register(
+		ModuleName,
+		1104,
+		"Stake not found",
+	)
+	ErrNotStakeOwner = sdkerrors.Register(
+		ModuleName,
+		1105,
+		"Stake is not owned by the caller",
+	)
+	ErrMaxGaugesReached = sdkerrors.Register(
+		ModuleName,
+		1106,
+		"Maximum number of active gauges reached",
+	)
+	ErrNoRewards = sdkerrors.Register(
+		ModuleName,
+		1107,
+		"No rewards to claim",
+	)
+	ErrStakeAmountTooSmall = sdkerrors.Register(
+		ModuleName,
+		1108,
+		"Stake amount is below the minimum stake amount",
+	)
+)
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func CreateGaugeEvent(gauge Gauge) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, CreateGaugeEventKey),
+		sdk.NewAttribute(EventAttributeGaugeID, strconv.FormatUint(gauge.Id, 10)),
+		sdk.NewAttribute(EventAttributeCreator, gauge.Creator),
+		sdk.NewAttribute(EventAttributeCoins, gauge.Coins.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func StakeEvent(stake Stake, coins sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, StakeEventKey),
+		sdk.NewAttribute(EventAttributeStakeID, strconv.FormatUint(stake.Id, 10)),
+		sdk.NewAttribute(EventAttributeOwner, stake.Owner),
+		sdk.NewAttribute(EventAttributeCoins, coins.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func UnstakeEvent(stake Stake) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, UnstakeEventKey),
+		sdk.NewAttribute(EventAttributeStakeID, strconv.FormatUint(stake.Id, 10)),
+		sdk.NewAttribute(EventAttributeOwner, stake.Owner),
+		sdk.NewAttribute(EventAttributeCoins, stake.Coins.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func ClaimRewardsEvent(owner string, coins sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, ClaimRewardsEventKey),
+		sdk.NewAttribute(EventAttributeOwner, owner),
+		sdk.NewAttribute(EventAttributeCoins, coins.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func DistributeGaugeEvent(gauge Gauge, distributed sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeyAction, DistributeEventKey),
+		sdk.NewAttribute(EventAttributeGaugeID, strconv.FormatUint(gauge.Id, 10)),
+		sdk.NewAttribute(EventAttributeEpoch, strconv.FormatUint(gauge.FilledEpochs, 10)),
+		sdk.NewAttribute(EventAttributeCoins, distributed.String()),
+		sdk.NewAttribute(EventAttributeFinished, strconv.FormatBool(gauge.IsFinished())),
+	}
+
+	return sdk.NewEvent(DistributeEventKey, attrs...)
+}
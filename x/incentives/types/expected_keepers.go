@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// BankKeeper defines the expected interface needed to move reward coins and staked shares.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// DexKeeper defines the expected interface needed to value staked pool shares.
+type DexKeeper interface {
+	GetPoolByID(ctx sdk.Context, poolID uint64) (pool *dextypes.Pool, found bool)
+	GetPoolMetadata(ctx sdk.Context, id uint64) (val dextypes.PoolMetadata, found bool)
+	GetCurrTickIndexTakerToMakerNormalized(ctx sdk.Context, tradePairID *dextypes.TradePairID) (int64, bool)
+}
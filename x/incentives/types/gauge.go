@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+func (qc QueryCondition) Validate() error {
+	if qc.PairId == nil {
+		return sdkerrors.Wrap(ErrInvalidGauge, "pair id must be set")
+	}
+
+	if err := sdk.ValidateDenom(qc.PairId.Token0); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "token0 denom (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(qc.PairId.Token1); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "token1 denom (%s)", err)
+	}
+
+	token0, token1 := dextypes.SortTokens(qc.PairId.Token0, qc.PairId.Token1)
+	if qc.PairId.Token0 == qc.PairId.Token1 || token0 != qc.PairId.Token0 || token1 != qc.PairId.Token1 {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "pair id %s is not a sorted pair of distinct denoms", qc.PairId.CanonicalString())
+	}
+
+	if qc.StartTick > qc.EndTick {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "start tick %d is greater than end tick %d", qc.StartTick, qc.EndTick)
+	}
+
+	return nil
+}
+
+// Test returns true if shares of the pool described by poolMetadata are eligible under the QueryCondition
+func (qc QueryCondition) Test(poolMetadata dextypes.PoolMetadata) bool {
+	if poolMetadata.PairId == nil || *poolMetadata.PairId != *qc.PairId {
+		return false
+	}
+
+	return qc.StartTick <= poolMetadata.Tick && poolMetadata.Tick <= qc.EndTick
+}
+
+func (g Gauge) IsFinished() bool {
+	return g.FilledEpochs >= g.NumEpochsPaidOver
+}
+
+func (g Gauge) IsActive(blockHeight int64) bool {
+	return !g.IsFinished() && g.StartHeight <= blockHeight
+}
+
+func (g Gauge) RemainingCoins() sdk.Coins {
+	return g.Coins.Sub(g.DistributedCoins...)
+}
+
+// EpochCoins returns the coins to distribute in the next epoch. Coins that are left undistributed
+// in an epoch roll over and are spread across the remaining epochs.
+func (g Gauge) EpochCoins() sdk.Coins {
+	if g.IsFinished() {
+		return sdk.Coins{}
+	}
+
+	remainingEpochs := g.NumEpochsPaidOver - g.FilledEpochs
+	epochCoins := sdk.Coins{}
+	for _, coin := range g.RemainingCoins() {
+		amount := coin.Amount.QuoRaw(int64(remainingEpochs))
+		if amount.IsPositive() {
+			epochCoins = epochCoins.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return epochCoins
+}
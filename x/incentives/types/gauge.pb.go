@@ -0,0 +1,1560 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/incentives/gauge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+
+	github_com_neutron_org_neutron_v4_utils_math "github.com/neutron-org/neutron/v4/utils/math"
+	types "github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryCondition selects the dex pools whose shares are eligible for a gauge's rewards
+type QueryCondition struct {
+	// Pair whose pools are eligible
+	PairId *types.PairID `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Lowest eligible pool center tick (inclusive)
+	StartTick int64 `protobuf:"varint,2,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	// Highest eligible pool center tick (inclusive)
+	EndTick int64 `protobuf:"varint,3,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+}
+
+func (m *QueryCondition) Reset()         { *m = QueryCondition{} }
+func (m *QueryCondition) String() string { return proto.CompactTextString(m) }
+func (*QueryCondition) ProtoMessage()    {}
+func (*QueryCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2467fab98b594cb6, []int{0}
+}
+func (m *QueryCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCondition.Merge(m, src)
+}
+func (m *QueryCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCondition proto.InternalMessageInfo
+
+func (m *QueryCondition) GetPairId() *types.PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *QueryCondition) GetStartTick() int64 {
+	if m != nil {
+		return m.StartTick
+	}
+	return 0
+}
+
+func (m *QueryCondition) GetEndTick() int64 {
+	if m != nil {
+		return m.EndTick
+	}
+	return 0
+}
+
+// Gauge pays out reward coins over a number of epochs to stakes holding eligible pool shares
+type Gauge struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address that funded the gauge; any undistributed coins are returned to it when the gauge finishes
+	Creator      string         `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	DistributeTo QueryCondition `protobuf:"bytes,3,opt,name=distribute_to,json=distributeTo,proto3" json:"distribute_to"`
+	// Total reward coins paid out over the life of the gauge
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// Block height after which distributions begin
+	StartHeight       int64                                    `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	NumEpochsPaidOver uint64                                   `protobuf:"varint,6,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+	FilledEpochs      uint64                                   `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
+	DistributedCoins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// If true, rewards are weighted by how close a pool's tick is to the current price of the pair
+	WeightByDistance bool `protobuf:"varint,9,opt,name=weight_by_distance,json=weightByDistance,proto3" json:"weight_by_distance,omitempty"`
+}
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2467fab98b594cb6, []int{1}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
+func (m *Gauge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Gauge) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Gauge) GetDistributeTo() QueryCondition {
+	if m != nil {
+		return m.DistributeTo
+	}
+	return QueryCondition{}
+}
+
+func (m *Gauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *Gauge) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Gauge) GetNumEpochsPaidOver() uint64 {
+	if m != nil {
+		return m.NumEpochsPaidOver
+	}
+	return 0
+}
+
+func (m *Gauge) GetFilledEpochs() uint64 {
+	if m != nil {
+		return m.FilledEpochs
+	}
+	return 0
+}
+
+func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+func (m *Gauge) GetWeightByDistance() bool {
+	if m != nil {
+		return m.WeightByDistance
+	}
+	return false
+}
+
+// GaugeDistribution is the payout of a single gauge in the distribution in progress
+type GaugeDistribution struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// Coins paid out to stakes pro rata to their weight
+	EpochCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=epoch_coins,json=epochCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_coins"`
+	// Sum of the weights of all stakes
+	TotalWeight github_com_neutron_org_neutron_v4_utils_math.PrecDec `protobuf:"bytes,3,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/neutron-org/neutron/v4/utils/math.PrecDec" json:"total_weight" yaml:"total_weight"`
+	// Coins paid out so far
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+}
+
+func (m *GaugeDistribution) Reset()         { *m = GaugeDistribution{} }
+func (m *GaugeDistribution) String() string { return proto.CompactTextString(m) }
+func (*GaugeDistribution) ProtoMessage()    {}
+func (*GaugeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2467fab98b594cb6, []int{2}
+}
+func (m *GaugeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeDistribution.Merge(m, src)
+}
+func (m *GaugeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeDistribution proto.InternalMessageInfo
+
+func (m *GaugeDistribution) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *GaugeDistribution) GetEpochCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochCoins
+	}
+	return nil
+}
+
+func (m *GaugeDistribution) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+// DistributionState tracks an epoch distribution that did not fit in the distribution allowance of a single block
+type DistributionState struct {
+	Gauges []GaugeDistribution `protobuf:"bytes,1,rep,name=gauges,proto3" json:"gauges"`
+	// Stakes with a lower id have already been weighed
+	NextStakeId uint64 `protobuf:"varint,2,opt,name=next_stake_id,json=nextStakeId,proto3" json:"next_stake_id,omitempty"`
+	// Set once every stake has been weighed; the StakeWeights are then paid out
+	WeighingDone bool `protobuf:"varint,3,opt,name=weighing_done,json=weighingDone,proto3" json:"weighing_done,omitempty"`
+}
+
+func (m *DistributionState) Reset()         { *m = DistributionState{} }
+func (m *DistributionState) String() string { return proto.CompactTextString(m) }
+func (*DistributionState) ProtoMessage()    {}
+func (*DistributionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2467fab98b594cb6, []int{3}
+}
+func (m *DistributionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionState.Merge(m, src)
+}
+func (m *DistributionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionState proto.InternalMessageInfo
+
+func (m *DistributionState) GetGauges() []GaugeDistribution {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *DistributionState) GetNextStakeId() uint64 {
+	if m != nil {
+		return m.NextStakeId
+	}
+	return 0
+}
+
+func (m *DistributionState) GetWeighingDone() bool {
+	if m != nil {
+		return m.WeighingDone
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryCondition)(nil), "neutron.incentives.QueryCondition")
+	proto.RegisterType((*Gauge)(nil), "neutron.incentives.Gauge")
+	proto.RegisterType((*GaugeDistribution)(nil), "neutron.incentives.GaugeDistribution")
+	proto.RegisterType((*DistributionState)(nil), "neutron.incentives.DistributionState")
+}
+
+func init() { proto.RegisterFile("neutron/incentives/gauge.proto", fileDescriptor_2467fab98b594cb6) }
+
+var fileDescriptor_2467fab98b594cb6 = []byte{
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x34, 0x3f, 0xce, 0x69, 0xd5, 0x5c, 0x3b, 0x38, 0x95, 0x70, 0x82, 0x11, 0x52,
+	0x86, 0xd6, 0xa6, 0xa5, 0x13, 0x63, 0x1a, 0x04, 0x19, 0x80, 0xe2, 0x56, 0x42, 0x62, 0xb1, 0x2e,
+	0xbe, 0xc3, 0x39, 0x25, 0xb9, 0x8b, 0xec, 0x73, 0x48, 0x76, 0xc4, 0xcc, 0x7f, 0x80, 0x58, 0xf9,
+	0x4b, 0x3a, 0x76, 0x44, 0x0c, 0x01, 0xb5, 0x1b, 0x23, 0x7f, 0x01, 0xf2, 0xb3, 0x43, 0x53, 0xb5,
+	0x03, 0x43, 0xc5, 0x64, 0xdf, 0xf7, 0xee, 0xbd, 0x7b, 0xdf, 0xf7, 0xbd, 0x3b, 0x64, 0x0a, 0x16,
+	0xab, 0x50, 0x0a, 0x87, 0x0b, 0x9f, 0x09, 0xc5, 0xa7, 0x2c, 0x72, 0x02, 0x12, 0x07, 0xcc, 0x9e,
+	0x84, 0x52, 0x49, 0x8c, 0xb3, 0xb8, 0x7d, 0x15, 0xdf, 0x31, 0x7d, 0x19, 0x8d, 0x65, 0xe4, 0xf4,
+	0x49, 0xc4, 0x9c, 0xe9, 0x7e, 0x9f, 0x29, 0xb2, 0xef, 0xf8, 0x92, 0x8b, 0x34, 0x67, 0x67, 0x3b,
+	0x90, 0x81, 0x84, 0x5f, 0x27, 0xf9, 0xcb, 0xd0, 0xc6, 0xf2, 0x24, 0xca, 0x66, 0xce, 0x84, 0xf0,
+	0xd0, 0xe3, 0x34, 0x0b, 0xdd, 0xd6, 0x44, 0xa4, 0xc8, 0x30, 0x6b, 0xc2, 0x9a, 0xa1, 0x8d, 0xd7,
+	0x31, 0x0b, 0xe7, 0x47, 0x52, 0x50, 0xae, 0xb8, 0x14, 0x78, 0x17, 0x95, 0xb3, 0x12, 0x86, 0xd6,
+	0xd2, 0xda, 0xfa, 0xc1, 0x96, 0xbd, 0x6c, 0x94, 0xb2, 0x99, 0x7d, 0x4c, 0x78, 0xd8, 0xeb, 0xba,
+	0xa5, 0x64, 0x4f, 0x8f, 0xe2, 0x7b, 0x08, 0x45, 0x8a, 0x84, 0xca, 0x53, 0xdc, 0x1f, 0x1a, 0xf9,
+	0x96, 0xd6, 0x2e, 0xb8, 0x55, 0x40, 0x4e, 0xb9, 0x3f, 0xc4, 0x0d, 0x54, 0x61, 0x82, 0xa6, 0xc1,
+	0x02, 0x04, 0xcb, 0x4c, 0xd0, 0x24, 0x64, 0x7d, 0x28, 0xa2, 0xb5, 0x67, 0x89, 0x1c, 0x78, 0x03,
+	0xe5, 0xb3, 0xc3, 0x8a, 0x6e, 0x9e, 0x53, 0x6c, 0xa0, 0xb2, 0x1f, 0x32, 0xa2, 0x64, 0x08, 0x05,
+	0xab, 0xee, 0x72, 0x89, 0x5f, 0xa0, 0x75, 0xca, 0x23, 0x15, 0xf2, 0x7e, 0xac, 0x98, 0xa7, 0x24,
+	0xd4, 0xd4, 0x0f, 0x2c, 0xfb, 0xa6, 0x94, 0xf6, 0x75, 0x5a, 0x9d, 0xe2, 0xd9, 0xa2, 0x99, 0x73,
+	0x6b, 0x57, 0xe9, 0xa7, 0x12, 0x13, 0xb4, 0x96, 0x68, 0x1b, 0x19, 0xc5, 0x56, 0xa1, 0xad, 0x1f,
+	0x34, 0xec, 0x54, 0x7d, 0x3b, 0x51, 0xdf, 0xce, 0xd4, 0xb7, 0x8f, 0x24, 0x17, 0x9d, 0x47, 0x49,
+	0xf6, 0xd7, 0x1f, 0xcd, 0x76, 0xc0, 0xd5, 0x20, 0xee, 0xdb, 0xbe, 0x1c, 0x3b, 0x99, 0x55, 0xe9,
+	0x67, 0x2f, 0xa2, 0x43, 0x47, 0xcd, 0x27, 0x2c, 0x82, 0x84, 0xc8, 0x4d, 0x2b, 0xe3, 0xfb, 0xa8,
+	0x96, 0xea, 0x33, 0x60, 0x3c, 0x18, 0x28, 0x63, 0x0d, 0x44, 0xd0, 0x01, 0x7b, 0x0e, 0x10, 0x76,
+	0xd0, 0xb6, 0x88, 0xc7, 0x1e, 0x9b, 0x48, 0x7f, 0x10, 0x79, 0x13, 0xc2, 0xa9, 0x27, 0xa7, 0x2c,
+	0x34, 0x4a, 0x20, 0x48, 0x5d, 0xc4, 0xe3, 0xa7, 0x10, 0x3a, 0x26, 0x9c, 0xbe, 0x9a, 0xb2, 0x10,
+	0x3f, 0x40, 0xeb, 0xef, 0xf8, 0x68, 0xc4, 0x68, 0x96, 0x63, 0x94, 0x61, 0x67, 0x2d, 0x05, 0xd3,
+	0xcd, 0x78, 0x86, 0xea, 0x57, 0x5c, 0xa9, 0x97, 0xf2, 0xac, 0xdc, 0x3d, 0xcf, 0xcd, 0x95, 0x53,
+	0x00, 0xc1, 0xbb, 0x08, 0xbf, 0x07, 0x66, 0x5e, 0x7f, 0xee, 0x25, 0x51, 0x22, 0x7c, 0x66, 0x54,
+	0x5b, 0x5a, 0xbb, 0xe2, 0x6e, 0xa6, 0x91, 0xce, 0xbc, 0x9b, 0xe1, 0xd6, 0xe7, 0x02, 0xaa, 0xc3,
+	0x18, 0x74, 0x97, 0x75, 0x92, 0x21, 0x6c, 0xa0, 0x0a, 0x5c, 0x15, 0xef, 0xef, 0x60, 0x94, 0x61,
+	0xdd, 0xa3, 0x78, 0x84, 0x74, 0xa0, 0x9d, 0x51, 0xca, 0xdf, 0x3d, 0x25, 0x04, 0xf5, 0x53, 0x32,
+	0x1f, 0x35, 0x54, 0x53, 0x52, 0x91, 0x91, 0x97, 0x76, 0x0e, 0x13, 0x57, 0xed, 0xf8, 0x49, 0xd1,
+	0xef, 0x8b, 0xe6, 0xe1, 0x4a, 0xd1, 0x6c, 0x06, 0xf7, 0x64, 0x18, 0x2c, 0xff, 0x9d, 0xe9, 0xa1,
+	0x13, 0x2b, 0x3e, 0x8a, 0x9c, 0x31, 0x51, 0x03, 0xfb, 0x38, 0x64, 0x7e, 0x97, 0xf9, 0xbf, 0x16,
+	0xcd, 0x6b, 0x35, 0x7f, 0x2f, 0x9a, 0x5b, 0x73, 0x32, 0x1e, 0x3d, 0xb1, 0x56, 0x51, 0xcb, 0xd5,
+	0x61, 0xf9, 0x06, 0x56, 0xb7, 0xfb, 0x59, 0xfc, 0x0f, 0x7e, 0x5a, 0x5f, 0x34, 0x54, 0x5f, 0x35,
+	0xe7, 0x44, 0x11, 0xc5, 0xf0, 0x11, 0x2a, 0x81, 0x23, 0x91, 0xa1, 0x41, 0x13, 0x0f, 0x6f, 0xbb,
+	0x83, 0x37, 0x8c, 0xcd, 0xae, 0x61, 0x96, 0x8a, 0x2d, 0xb4, 0x2e, 0xd8, 0x4c, 0x79, 0xf0, 0x22,
+	0x25, 0x5e, 0xe7, 0xc1, 0x6b, 0x3d, 0x01, 0x4f, 0x12, 0xac, 0x47, 0x93, 0x69, 0x07, 0x41, 0xb8,
+	0x08, 0x3c, 0x2a, 0x05, 0x03, 0x07, 0x2a, 0x6e, 0x6d, 0x09, 0x76, 0xa5, 0x60, 0x9d, 0x97, 0x67,
+	0x17, 0xa6, 0x76, 0x7e, 0x61, 0x6a, 0x3f, 0x2f, 0x4c, 0xed, 0xd3, 0xa5, 0x99, 0x3b, 0xbf, 0x34,
+	0x73, 0xdf, 0x2e, 0xcd, 0xdc, 0xdb, 0x7f, 0x70, 0x68, 0xb6, 0xfa, 0x38, 0x82, 0x16, 0xfd, 0x12,
+	0xbc, 0x8e, 0x8f, 0xff, 0x0c, 0x00, 0x2a, 0x2f, 0x23, 0xa1, 0xc4, 0x05, 0x00, 0x00,
+}
+
+func (m *QueryCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTick != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.EndTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGauge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightByDistance {
+		i--
+		if m.WeightByDistance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.FilledEpochs != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.FilledEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumEpochsPaidOver != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.NumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.DistributeTo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EpochCoins) > 0 {
+		for iNdEx := len(m.EpochCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeighingDone {
+		i--
+		if m.WeighingDone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextStakeId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.NextStakeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGauge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovGauge(uint64(m.StartTick))
+	}
+	if m.EndTick != 0 {
+		n += 1 + sovGauge(uint64(m.EndTick))
+	}
+	return n
+}
+
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGauge(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = m.DistributeTo.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGauge(uint64(m.StartHeight))
+	}
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovGauge(uint64(m.NumEpochsPaidOver))
+	}
+	if m.FilledEpochs != 0 {
+		n += 1 + sovGauge(uint64(m.FilledEpochs))
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.WeightByDistance {
+		n += 2
+	}
+	return n
+}
+
+func (m *GaugeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovGauge(uint64(m.GaugeId))
+	}
+	if len(m.EpochCoins) > 0 {
+		for _, e := range m.EpochCoins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.NextStakeId != 0 {
+		n += 1 + sovGauge(uint64(m.NextStakeId))
+	}
+	if m.WeighingDone {
+		n += 2
+	}
+	return n
+}
+
+func sovGauge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGauge(x uint64) (n int) {
+	return sovGauge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &types.PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTick", wireType)
+			}
+			m.EndTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributeTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+			}
+			m.NumEpochsPaidOver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			}
+			m.FilledEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightByDistance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WeightByDistance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochCoins = append(m.EpochCoins, types1.Coin{})
+			if err := m.EpochCoins[len(m.EpochCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types1.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, GaugeDistribution{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStakeId", wireType)
+			}
+			m.NextStakeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStakeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeighingDone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WeighingDone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGauge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGauge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGauge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGauge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGauge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGauge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGauge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default incentives genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Gauges:         []Gauge{},
+		Stakes:         []Stake{},
+		AccountRewards: []AccountRewards{},
+		StakeWeights:   []StakeWeight{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated ID in gauges
+	gaugeIDMap := make(map[uint64]bool)
+	for _, elem := range gs.Gauges {
+		if _, ok := gaugeIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for gauge")
+		}
+		if elem.Id >= gs.NextGaugeId {
+			return fmt.Errorf("gauge id should be lower than the next gauge id")
+		}
+		if err := elem.DistributeTo.Validate(); err != nil {
+			return fmt.Errorf("invalid gauge %d: %w", elem.Id, err)
+		}
+		if !elem.DistributedCoins.IsAllLTE(elem.Coins) {
+			return fmt.Errorf("gauge %d has distributed more coins than it holds", elem.Id)
+		}
+		gaugeIDMap[elem.Id] = true
+	}
+	// Check for duplicated ID in stakes
+	stakeIDMap := make(map[uint64]bool)
+	stakeOwnerDenomMap := make(map[string]bool)
+	for _, elem := range gs.Stakes {
+		if _, ok := stakeIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for stake")
+		}
+		if elem.Id >= gs.NextStakeId {
+			return fmt.Errorf("stake id should be lower than the next stake id")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Owner); err != nil {
+			return fmt.Errorf("invalid owner for stake %d: %w", elem.Id, err)
+		}
+		if err := ValidateStakeCoins(elem.Coins); err != nil {
+			return fmt.Errorf("invalid coins for stake %d: %w", elem.Id, err)
+		}
+		if len(elem.Coins) != 1 {
+			return fmt.Errorf("stake %d must hold a single denom", elem.Id)
+		}
+		ownerDenom := elem.Owner + "/" + elem.Denom()
+		if _, ok := stakeOwnerDenomMap[ownerDenom]; ok {
+			return fmt.Errorf("duplicated owner and denom for stake %d", elem.Id)
+		}
+		stakeIDMap[elem.Id] = true
+		stakeOwnerDenomMap[ownerDenom] = true
+	}
+	// Check that stake weights only exist for a distribution in progress
+	if gs.DistributionState == nil && len(gs.StakeWeights) > 0 {
+		return fmt.Errorf("stake weights without a distribution in progress")
+	}
+	distributionGaugeIDMap := make(map[uint64]bool)
+	if gs.DistributionState != nil {
+		for _, elem := range gs.DistributionState.Gauges {
+			if _, ok := gaugeIDMap[elem.GaugeId]; !ok {
+				return fmt.Errorf("distribution references missing gauge %d", elem.GaugeId)
+			}
+			distributionGaugeIDMap[elem.GaugeId] = true
+		}
+	}
+	stakeWeightIDMap := make(map[uint64]bool)
+	for _, elem := range gs.StakeWeights {
+		if _, ok := stakeWeightIDMap[elem.StakeId]; ok {
+			return fmt.Errorf("duplicated stake id for stake weight")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Owner); err != nil {
+			return fmt.Errorf("invalid owner for stake weight %d: %w", elem.StakeId, err)
+		}
+		for _, weight := range elem.Weights {
+			if _, ok := distributionGaugeIDMap[weight.GaugeId]; !ok {
+				return fmt.Errorf("stake weight %d references gauge %d outside of the distribution", elem.StakeId, weight.GaugeId)
+			}
+		}
+		stakeWeightIDMap[elem.StakeId] = true
+	}
+	// Check for duplicated index in accountRewards
+	accountRewardsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AccountRewards {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid accountRewards address: %w", err)
+		}
+		if err := elem.Coins.Validate(); err != nil {
+			return fmt.Errorf("invalid accountRewards coins for %s: %w", elem.Address, err)
+		}
+		if _, ok := accountRewardsIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for accountRewards")
+		}
+		accountRewardsIndexMap[elem.Address] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,713 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/incentives/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the incentives module's genesis state.
+type GenesisState struct {
+	Params         Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Gauges         []Gauge          `protobuf:"bytes,2,rep,name=gauges,proto3" json:"gauges"`
+	Stakes         []Stake          `protobuf:"bytes,3,rep,name=stakes,proto3" json:"stakes"`
+	AccountRewards []AccountRewards `protobuf:"bytes,4,rep,name=account_rewards,json=accountRewards,proto3" json:"account_rewards"`
+	NextGaugeId    uint64           `protobuf:"varint,5,opt,name=next_gauge_id,json=nextGaugeId,proto3" json:"next_gauge_id,omitempty"`
+	NextStakeId    uint64           `protobuf:"varint,6,opt,name=next_stake_id,json=nextStakeId,proto3" json:"next_stake_id,omitempty"`
+	// Distribution in progress, if any
+	DistributionState *DistributionState `protobuf:"bytes,7,opt,name=distribution_state,json=distributionState,proto3" json:"distribution_state,omitempty"`
+	StakeWeights      []StakeWeight      `protobuf:"bytes,8,rep,name=stake_weights,json=stakeWeights,proto3" json:"stake_weights"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98ecc78531d9ace2, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetGauges() []Gauge {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakes() []Stake {
+	if m != nil {
+		return m.Stakes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountRewards() []AccountRewards {
+	if m != nil {
+		return m.AccountRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextGaugeId() uint64 {
+	if m != nil {
+		return m.NextGaugeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextStakeId() uint64 {
+	if m != nil {
+		return m.NextStakeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDistributionState() *DistributionState {
+	if m != nil {
+		return m.DistributionState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeWeights() []StakeWeight {
+	if m != nil {
+		return m.StakeWeights
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "neutron.incentives.GenesisState")
+}
+
+func init() { proto.RegisterFile("neutron/incentives/genesis.proto", fileDescriptor_98ecc78531d9ace2) }
+
+var fileDescriptor_98ecc78531d9ace2 = []byte{
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x4f, 0xf2, 0x30,
+	0x1c, 0xc6, 0xb7, 0x17, 0xde, 0xbd, 0x6f, 0x0a, 0x68, 0x6c, 0x3c, 0x4c, 0x0e, 0x63, 0x21, 0x31,
+	0xe1, 0xe2, 0x96, 0x20, 0x89, 0x5e, 0x25, 0x26, 0x04, 0x0f, 0x46, 0x87, 0x89, 0x89, 0x17, 0x52,
+	0xb6, 0x66, 0x34, 0x86, 0x96, 0xac, 0x1d, 0xe0, 0xb7, 0xf0, 0x63, 0x71, 0xe4, 0xe8, 0xc9, 0x18,
+	0xf8, 0x10, 0x5e, 0xcd, 0xda, 0x22, 0xa8, 0xc3, 0x5b, 0xf3, 0xef, 0xef, 0x79, 0xf6, 0x6b, 0x57,
+	0xe0, 0x52, 0x9c, 0x8a, 0x84, 0x51, 0x9f, 0xd0, 0x10, 0x53, 0x41, 0x26, 0x98, 0xfb, 0x31, 0xa6,
+	0x98, 0x13, 0xee, 0x8d, 0x13, 0x26, 0x18, 0x84, 0x9a, 0xf0, 0x36, 0x44, 0xf5, 0x30, 0x66, 0x31,
+	0x93, 0xdb, 0x7e, 0xb6, 0x52, 0x64, 0xd5, 0xc9, 0xeb, 0x42, 0x69, 0x8c, 0xf5, 0x7e, 0x2d, 0x67,
+	0x7f, 0x8c, 0x12, 0x34, 0xe2, 0xbf, 0x14, 0x70, 0x81, 0x1e, 0x75, 0x41, 0xfd, 0xbd, 0x00, 0xca,
+	0x1d, 0x25, 0xd7, 0x13, 0x48, 0x60, 0x78, 0x0e, 0x2c, 0x55, 0x60, 0x9b, 0xae, 0xd9, 0x28, 0x35,
+	0xab, 0xde, 0x4f, 0x59, 0xef, 0x46, 0x12, 0xed, 0xe2, 0xfc, 0xb5, 0x66, 0x04, 0x9a, 0x87, 0x67,
+	0xc0, 0x92, 0x6a, 0xdc, 0xfe, 0xe3, 0x16, 0x1a, 0xa5, 0xe6, 0x51, 0x5e, 0xb2, 0x93, 0x11, 0xeb,
+	0xa0, 0xc2, 0xb3, 0xa0, 0x54, 0xe2, 0x76, 0x61, 0x77, 0xb0, 0x97, 0x11, 0xeb, 0xa0, 0xc2, 0xe1,
+	0x2d, 0xd8, 0x47, 0x61, 0xc8, 0x52, 0x2a, 0xfa, 0x09, 0x9e, 0xa2, 0x24, 0xe2, 0x76, 0x51, 0x36,
+	0xd4, 0xf3, 0x1a, 0x2e, 0x14, 0x1a, 0x28, 0x52, 0x57, 0xed, 0xa1, 0x2f, 0x53, 0x58, 0x07, 0x15,
+	0x8a, 0x67, 0xa2, 0x2f, 0xd5, 0xfa, 0x24, 0xb2, 0xff, 0xba, 0x66, 0xa3, 0x18, 0x94, 0xb2, 0xa1,
+	0x74, 0xef, 0x46, 0x9f, 0x8c, 0xb4, 0xc8, 0x18, 0x6b, 0xc3, 0x48, 0xcd, 0x6e, 0x04, 0xef, 0x00,
+	0x8c, 0x08, 0x17, 0x09, 0x19, 0xa4, 0x82, 0x30, 0x9a, 0xb1, 0x02, 0xdb, 0xff, 0xe4, 0x95, 0x1e,
+	0xe7, 0xd9, 0x5d, 0x6e, 0xd1, 0xf2, 0x4f, 0x04, 0x07, 0xd1, 0xf7, 0x11, 0xbc, 0x02, 0x15, 0xf5,
+	0xd1, 0x29, 0x26, 0xf1, 0x50, 0x70, 0xfb, 0xbf, 0x3c, 0x6e, 0x6d, 0xe7, 0x85, 0xdd, 0x4b, 0x4e,
+	0x9f, 0xb5, 0xcc, 0x37, 0x23, 0xde, 0xbe, 0x9e, 0x2f, 0x1d, 0x73, 0xb1, 0x74, 0xcc, 0xb7, 0xa5,
+	0x63, 0x3e, 0xaf, 0x1c, 0x63, 0xb1, 0x72, 0x8c, 0x97, 0x95, 0x63, 0x3c, 0xb4, 0x62, 0x22, 0x86,
+	0xe9, 0xc0, 0x0b, 0xd9, 0xc8, 0xd7, 0xc5, 0x27, 0x2c, 0x89, 0xd7, 0x6b, 0x7f, 0xd2, 0xf2, 0x67,
+	0xdb, 0xef, 0x49, 0x3c, 0x8d, 0x31, 0x1f, 0x58, 0xf2, 0x41, 0x9d, 0x7e, 0x0c, 0x00, 0xcf, 0xd6,
+	0x89, 0x8d, 0xff, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakeWeights) > 0 {
+		for iNdEx := len(m.StakeWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DistributionState != nil {
+		{
+			size, err := m.DistributionState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextStakeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextStakeId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextGaugeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextGaugeId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AccountRewards) > 0 {
+		for iNdEx := len(m.AccountRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountRewards) > 0 {
+		for _, e := range m.AccountRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextGaugeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextGaugeId))
+	}
+	if m.NextStakeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextStakeId))
+	}
+	if m.DistributionState != nil {
+		l = m.DistributionState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.StakeWeights) > 0 {
+		for _, e := range m.StakeWeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, Gauge{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, Stake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountRewards = append(m.AccountRewards, AccountRewards{})
+			if err := m.AccountRewards[len(m.AccountRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextGaugeId", wireType)
+			}
+			m.NextGaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextGaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStakeId", wireType)
+			}
+			m.NextStakeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStakeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DistributionState == nil {
+				m.DistributionState = &DistributionState{}
+			}
+			if err := m.DistributionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeWeights = append(m.StakeWeights, StakeWeight{})
+			if err := m.StakeWeights[len(m.StakeWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,241 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	math_utils "github.com/neutron-org/neutron/v4/utils/math"
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+	"github.com/neutron-org/neutron/v4/x/incentives/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice")).String()
+	validCondition := types.QueryCondition{
+		PairId:    &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"},
+		StartTick: -10,
+		EndTick:   10,
+	}
+	rewardCoins := sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(100)))
+	shareCoins := sdk.NewCoins(sdk.NewCoin(dextypes.NewPoolDenom(0), math.NewInt(10)))
+	otherShareCoins := sdk.NewCoins(sdk.NewCoin(dextypes.NewPoolDenom(1), math.NewInt(10)))
+	weight := []types.GaugeWeight{{GaugeId: 0, Weight: math_utils.OnePrecDec()}}
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Gauges: []types.Gauge{
+					{Id: 0, Creator: alice, DistributeTo: validCondition, Coins: rewardCoins, NumEpochsPaidOver: 1},
+					{Id: 1, Creator: alice, DistributeTo: validCondition, Coins: rewardCoins, NumEpochsPaidOver: 1},
+				},
+				NextGaugeId: 2,
+				Stakes: []types.Stake{
+					{Id: 0, Owner: alice, Coins: shareCoins},
+					{Id: 1, Owner: alice, Coins: otherShareCoins},
+				},
+				NextStakeId: 2,
+				AccountRewards: []types.AccountRewards{
+					{Address: alice, Coins: rewardCoins},
+				},
+				DistributionState: &types.DistributionState{
+					Gauges: []types.GaugeDistribution{{GaugeId: 0, EpochCoins: rewardCoins, TotalWeight: math_utils.OnePrecDec()}},
+				},
+				StakeWeights: []types.StakeWeight{
+					{StakeId: 0, Owner: alice, Weights: weight},
+				},
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated gauge",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Gauges: []types.Gauge{
+					{Id: 0, Creator: alice, DistributeTo: validCondition, Coins: rewardCoins},
+					{Id: 0, Creator: alice, DistributeTo: validCondition, Coins: rewardCoins},
+				},
+				NextGaugeId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid gauge id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Gauges: []types.Gauge{
+					{Id: 1, Creator: alice, DistributeTo: validCondition, Coins: rewardCoins},
+				},
+				NextGaugeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid gauge condition",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Gauges: []types.Gauge{
+					{
+						Id:      0,
+						Creator: alice,
+						DistributeTo: types.QueryCondition{
+							PairId:    &dextypes.PairID{Token0: "TokenA", Token1: "TokenB"},
+							StartTick: 10,
+							EndTick:   -10,
+						},
+						Coins: rewardCoins,
+					},
+				},
+				NextGaugeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "gauge distributed more than it holds",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Gauges: []types.Gauge{
+					{
+						Id:               0,
+						Creator:          alice,
+						DistributeTo:     validCondition,
+						Coins:            rewardCoins,
+						DistributedCoins: rewardCoins.Add(rewardCoins...),
+					},
+				},
+				NextGaugeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated stake",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stakes: []types.Stake{
+					{Id: 0, Owner: alice, Coins: shareCoins},
+					{Id: 0, Owner: alice, Coins: shareCoins},
+				},
+				NextStakeId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid stake id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stakes: []types.Stake{
+					{Id: 2, Owner: alice, Coins: shareCoins},
+				},
+				NextStakeId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated stake owner and denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stakes: []types.Stake{
+					{Id: 0, Owner: alice, Coins: shareCoins},
+					{Id: 1, Owner: alice, Coins: shareCoins},
+				},
+				NextStakeId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "stake of multiple denoms",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stakes: []types.Stake{
+					{Id: 0, Owner: alice, Coins: shareCoins.Add(otherShareCoins...)},
+				},
+				NextStakeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "stake weights without distribution",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Gauges: []types.Gauge{
+					{Id: 0, Creator: alice, DistributeTo: validCondition, Coins: rewardCoins, NumEpochsPaidOver: 1},
+				},
+				NextGaugeId: 1,
+				StakeWeights: []types.StakeWeight{
+					{StakeId: 0, Owner: alice, Weights: weight},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "distribution of missing gauge",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DistributionState: &types.DistributionState{
+					Gauges: []types.GaugeDistribution{{GaugeId: 0, EpochCoins: rewardCoins, TotalWeight: math_utils.OnePrecDec()}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "stake of non pool denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Stakes: []types.Stake{
+					{Id: 0, Owner: alice, Coins: rewardCoins},
+				},
+				NextStakeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid distribution allowance",
+			genState: &types.GenesisState{
+				Params: types.NewParams(10, 10, types.DefaultMinStakeAmount, 0),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated accountRewards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountRewards: []types.AccountRewards{
+					{Address: alice, Coins: rewardCoins},
+					{Address: alice, Coins: rewardCoins},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, 10, types.DefaultMinStakeAmount, types.DefaultDistributionAllowance),
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "incentives"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for incentives
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+const (
+	// ParamsKey is the prefix to retrieve params
+	ParamsKey = "Params/value/"
+
+	// GaugeKeyPrefix is the prefix to retrieve all Gauges
+	GaugeKeyPrefix = "Gauge/value/"
+
+	// GaugeCountKey is the key to retrieve the Gauge count
+	GaugeCountKey = "Gauge/count/"
+
+	// ActiveGaugeKeyPrefix is the prefix of the index of Gauges that have not finished distributing
+	ActiveGaugeKeyPrefix = "ActiveGauge/value/"
+
+	// StakeKeyPrefix is the prefix to retrieve all Stakes
+	StakeKeyPrefix = "Stake/value/"
+
+	// StakeCountKey is the key to retrieve the Stake count
+	StakeCountKey = "Stake/count/"
+
+	// StakeOwnerKeyPrefix is the prefix of the index of Stakes by owner and denom
+	StakeOwnerKeyPrefix = "StakeOwner/value/"
+
+	// StakeWeightKeyPrefix is the prefix to retrieve the StakeWeights of the distribution in progress
+	StakeWeightKeyPrefix = "StakeWeight/value/"
+
+	// DistributionStateKey is the key to retrieve the DistributionState of the distribution in progress
+	DistributionStateKey = "DistributionState/value/"
+
+	// AccountRewardsKeyPrefix is the prefix to retrieve all AccountRewards
+	AccountRewardsKeyPrefix = "AccountRewards/value/"
+)
+
+func KeyPrefix(p string) []byte {
+	key := []byte(p)
+	key = append(key, []byte("/")...)
+	return key
+}
+
+// GaugeKey returns the store key to retrieve a Gauge or ActiveGauge entry from its id
+func GaugeKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// StakeKey returns the store key to retrieve a Stake from its id
+func StakeKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// StakeOwnerPrefix returns the prefix for all StakeOwner index entries of owner
+func StakeOwnerPrefix(owner string) []byte {
+	key := KeyPrefix(StakeOwnerKeyPrefix)
+	key = append(key, []byte(owner)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StakeOwnerKey returns the StakeOwner index key for the Stake of denom, relative to StakeOwnerPrefix
+func StakeOwnerKey(denom string) []byte {
+	key := []byte(denom)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StakeWeightKey returns the store key to retrieve a StakeWeight from its stake id
+func StakeWeightKey(stakeID uint64) []byte {
+	return sdk.Uint64ToBigEndian(stakeID)
+}
+
+// AccountRewardsKey returns the store key to retrieve AccountRewards from an address
+func AccountRewardsKey(address string) []byte {
+	key := []byte(address)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// Event Attributes
+const (
+	CreateGaugeEventKey  = "CreateGauge"
+	StakeEventKey        = "Stake"
+	UnstakeEventKey      = "Unstake"
+	ClaimRewardsEventKey = "ClaimRewards"
+	DistributeEventKey   = "DistributeGauge"
+
+	EventAttributeGaugeID  = "GaugeID"
+	EventAttributeStakeID  = "StakeID"
+	EventAttributeCreator  = "Creator"
+	EventAttributeOwner    = "Owner"
+	EventAttributeCoins    = "Coins"
+	EventAttributeEpoch    = "Epoch"
+	EventAttributeFinished = "Finished"
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgClaimRewards = "claim_rewards"
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+func NewMsgClaimRewards(owner string) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner: owner,
+	}
+}
+
+func (msg *MsgClaimRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimRewards) Type() string {
+	return TypeMsgClaimRewards
+}
+
+func (msg *MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgClaimRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgClaimRewards) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return nil
+}
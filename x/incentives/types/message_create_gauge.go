@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCreateGauge = "create_gauge"
+
+var _ sdk.Msg = &MsgCreateGauge{}
+
+func NewMsgCreateGauge(
+	creator string,
+	distributeTo QueryCondition,
+	coins sdk.Coins,
+	startHeight int64,
+	numEpochsPaidOver uint64,
+	weightByDistance bool,
+) *MsgCreateGauge {
+	return &MsgCreateGauge{
+		Creator:           creator,
+		DistributeTo:      distributeTo,
+		Coins:             coins,
+		StartHeight:       startHeight,
+		NumEpochsPaidOver: numEpochsPaidOver,
+		WeightByDistance:  weightByDistance,
+	}
+}
+
+func (msg *MsgCreateGauge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateGauge) Type() string {
+	return TypeMsgCreateGauge
+}
+
+func (msg *MsgCreateGauge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateGauge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgCreateGauge) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := msg.DistributeTo.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Coins.Empty() {
+		return sdkerrors.Wrap(ErrInvalidGauge, "no reward coins provided")
+	}
+
+	if err := msg.Coins.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "invalid reward coins (%s)", err)
+	}
+
+	if msg.NumEpochsPaidOver == 0 {
+		return sdkerrors.Wrap(ErrInvalidGauge, "num epochs paid over must be positive")
+	}
+
+	if msg.StartHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidGauge, "start height cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgStake = "stake"
+
+var _ sdk.Msg = &MsgStake{}
+
+func NewMsgStake(owner string, coins sdk.Coins) *MsgStake {
+	return &MsgStake{
+		Owner: owner,
+		Coins: coins,
+	}
+}
+
+func (msg *MsgStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStake) Type() string {
+	return TypeMsgStake
+}
+
+func (msg *MsgStake) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgStake) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return ValidateStakeCoins(msg.Coins)
+}
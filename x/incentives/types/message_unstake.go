@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgUnstake = "unstake"
+
+var _ sdk.Msg = &MsgUnstake{}
+
+func NewMsgUnstake(owner string, id uint64) *MsgUnstake {
+	return &MsgUnstake{
+		Owner: owner,
+		Id:    id,
+	}
+}
+
+func (msg *MsgUnstake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnstake) Type() string {
+	return TypeMsgUnstake
+}
+
+func (msg *MsgUnstake) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgUnstake) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return nil
+}
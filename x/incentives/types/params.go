@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	DefaultEpochBlocks           uint64 = 43_200
+	DefaultMaxGauges             uint64 = 100
+	DefaultMinStakeAmount               = math.NewInt(1_000_000)
+	DefaultDistributionAllowance uint64 = 1_000_000
+)
+
+// NewParams creates a new Params instance
+func NewParams(epochBlocks, maxGauges uint64, minStakeAmount math.Int, distributionAllowance uint64) Params {
+	return Params{
+		EpochBlocks:           epochBlocks,
+		MaxGauges:             maxGauges,
+		MinStakeAmount:        minStakeAmount,
+		DistributionAllowance: distributionAllowance,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultEpochBlocks, DefaultMaxGauges, DefaultMinStakeAmount, DefaultDistributionAllowance)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.EpochBlocks == 0 {
+		return fmt.Errorf("epoch blocks must be positive")
+	}
+
+	if p.MinStakeAmount.IsNil() || p.MinStakeAmount.IsNegative() {
+		return fmt.Errorf("min stake amount must not be negative")
+	}
+
+	if p.DistributionAllowance == 0 {
+		return fmt.Errorf("distribution allowance must be positive")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
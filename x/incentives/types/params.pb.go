@@ -0,0 +1,434 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/incentives/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	cosmossdk_io_math "cosmossdk.io/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// Number of blocks between reward distributions
+	EpochBlocks uint64 `protobuf:"varint,1,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// Maximum number of gauges that can be active or upcoming at once
+	MaxGauges uint64 `protobuf:"varint,2,opt,name=max_gauges,json=maxGauges,proto3" json:"max_gauges,omitempty"`
+	// Minimum amount of each pool share that can be staked in a single MsgStake
+	MinStakeAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_stake_amount,json=minStakeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_stake_amount" yaml:"min_stake_amount"`
+	// Gas that can be spent distributing rewards in a single block; distributions that use more continue in the next
+	// block
+	DistributionAllowance uint64 `protobuf:"varint,4,opt,name=distribution_allowance,json=distributionAllowance,proto3" json:"distribution_allowance,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26b1e31ea29bccbb, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGauges() uint64 {
+	if m != nil {
+		return m.MaxGauges
+	}
+	return 0
+}
+
+func (m *Params) GetDistributionAllowance() uint64 {
+	if m != nil {
+		return m.DistributionAllowance
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "neutron.incentives.Params")
+}
+
+func init() { proto.RegisterFile("neutron/incentives/params.proto", fileDescriptor_26b1e31ea29bccbb) }
+
+var fileDescriptor_26b1e31ea29bccbb = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x31, 0x4b, 0xf3, 0x40,
+	0x18, 0x80, 0x73, 0xdf, 0x57, 0x0a, 0x3d, 0x45, 0x24, 0x58, 0x0d, 0x82, 0x49, 0xed, 0xd4, 0xc5,
+	0xdc, 0x60, 0x5d, 0xba, 0xb5, 0x8b, 0x08, 0x22, 0x52, 0x37, 0x97, 0x70, 0x49, 0x8f, 0xeb, 0xd1,
+	0xdc, 0xbd, 0x21, 0x77, 0xa9, 0xed, 0xbf, 0x70, 0x74, 0xf4, 0xe7, 0x74, 0xec, 0x28, 0x0e, 0x41,
+	0xda, 0xcd, 0xd1, 0x3f, 0xa0, 0xf4, 0x6c, 0xb1, 0xe8, 0xf6, 0xf2, 0x3c, 0xcf, 0x0b, 0xc7, 0x7b,
+	0x38, 0x50, 0xac, 0x30, 0x39, 0x28, 0x22, 0x54, 0xc2, 0x94, 0x11, 0x63, 0xa6, 0x49, 0x46, 0x73,
+	0x2a, 0x75, 0x98, 0xe5, 0x60, 0xc0, 0x75, 0xd7, 0x41, 0xf8, 0x13, 0x1c, 0x1f, 0x70, 0xe0, 0x60,
+	0x35, 0x59, 0x4d, 0xdf, 0x65, 0xf3, 0x13, 0xe1, 0xea, 0xad, 0x5d, 0x75, 0x4f, 0xf1, 0x2e, 0xcb,
+	0x20, 0x19, 0x46, 0x71, 0x0a, 0xc9, 0x48, 0x7b, 0xa8, 0x81, 0x5a, 0x95, 0xfe, 0x8e, 0x65, 0x3d,
+	0x8b, 0xdc, 0x13, 0x8c, 0x25, 0x9d, 0x44, 0x9c, 0x16, 0x9c, 0x69, 0xef, 0x9f, 0x0d, 0x6a, 0x92,
+	0x4e, 0x2e, 0x2d, 0x70, 0xc7, 0x78, 0x5f, 0x0a, 0x15, 0x69, 0x43, 0x47, 0x2c, 0xa2, 0x12, 0x0a,
+	0x65, 0xbc, 0xff, 0x0d, 0xd4, 0xaa, 0xf5, 0xae, 0x67, 0x65, 0xe0, 0xbc, 0x96, 0x41, 0x3d, 0x01,
+	0x2d, 0x41, 0xeb, 0xc1, 0x28, 0x14, 0x40, 0x24, 0x35, 0xc3, 0xf0, 0x4a, 0x99, 0xf7, 0x32, 0xf8,
+	0xb3, 0xf8, 0x51, 0x06, 0x47, 0x53, 0x2a, 0xd3, 0x4e, 0xf3, 0xb7, 0x69, 0xf6, 0xf7, 0xa4, 0x50,
+	0x77, 0x2b, 0xd2, 0xb5, 0xc0, 0xbd, 0xc0, 0x87, 0x03, 0xa1, 0x4d, 0x2e, 0xe2, 0xc2, 0x08, 0x50,
+	0x11, 0x4d, 0x53, 0x78, 0xa0, 0x2a, 0x61, 0x5e, 0xc5, 0x3e, 0xb1, 0xbe, 0x6d, 0xbb, 0x1b, 0xd9,
+	0xa9, 0x3c, 0x3d, 0x07, 0x4e, 0xef, 0x66, 0xb6, 0xf0, 0xd1, 0x7c, 0xe1, 0xa3, 0xb7, 0x85, 0x8f,
+	0x1e, 0x97, 0xbe, 0x33, 0x5f, 0xfa, 0xce, 0xcb, 0xd2, 0x77, 0xee, 0xdb, 0x5c, 0x98, 0x61, 0x11,
+	0x87, 0x09, 0x48, 0xb2, 0x3e, 0xe8, 0x19, 0xe4, 0x7c, 0x33, 0x93, 0x71, 0x9b, 0x4c, 0xb6, 0xbf,
+	0xc0, 0x4c, 0x33, 0xa6, 0xe3, 0xaa, 0x3d, 0xec, 0xf9, 0xd7, 0x00, 0x9e, 0xaf, 0xfa, 0xfa, 0xa5,
+	0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DistributionAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DistributionAllowance))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinStakeAmount.Size()
+		i -= size
+		if _, err := m.MinStakeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxGauges != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGauges))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.EpochBlocks))
+	}
+	if m.MaxGauges != 0 {
+		n += 1 + sovParams(uint64(m.MaxGauges))
+	}
+	l = m.MinStakeAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DistributionAllowance != 0 {
+		n += 1 + sovParams(uint64(m.DistributionAllowance))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGauges", wireType)
+			}
+			m.MaxGauges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGauges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionAllowance", wireType)
+			}
+			m.DistributionAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
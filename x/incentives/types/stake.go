@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/neutron-org/neutron/v4/x/dex/types"
+)
+
+// ValidateStakeCoins checks that coins is a valid, non-empty set of dex pool shares
+func ValidateStakeCoins(coins sdk.Coins) error {
+	if coins.Empty() {
+		return sdkerrors.Wrap(ErrInvalidStakeCoins, "no coins provided")
+	}
+
+	if err := coins.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidStakeCoins, "%s", err)
+	}
+
+	for _, coin := range coins {
+		if err := dextypes.ValidatePoolDenom(coin.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidStakeCoins, "%s is not a pool denom", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// Denom returns the denom of the pool shares held by the stake
+func (s Stake) Denom() string {
+	if len(s.Coins) == 0 {
+		return ""
+	}
+
+	return s.Coins[0].Denom
+}